@@ -9,6 +9,7 @@ const (
 	OFFICE_DOC_TYPE    = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument"
 	CORE_PROP_TYPE     = "http://schemas.openxmlformats.org/package/2006/relationships/metadata/core-properties"
 	EXTENDED_PROP_TYPE = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/extended-properties"
+	CUSTOM_PROP_TYPE   = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/custom-properties"
 	StylesType         = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles"
 )
 
@@ -40,6 +41,8 @@ const (
 	AltWMLNamespace = "http://purl.oclc.org/ooxml/wordprocessingml/main"
 
 	WMLDrawingNS = "http://schemas.openxmlformats.org/drawingml/2006/wordprocessingDrawing"
+	WMLShapeNS   = "http://schemas.microsoft.com/office/word/2010/wordprocessingShape"
+	WMLGroupNS   = "http://schemas.microsoft.com/office/word/2010/wordprocessingGroup"
 )
 
 const (
@@ -72,6 +75,38 @@ const (
 	SourceRelationshipImage            = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/image"
 	SourceRelationshipOfficeDocument   = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument"
 	SourceRelationshipHyperLink        = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/hyperlink"
+	SourceRelationshipNumbering        = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/numbering"
+	SourceRelationshipHeader           = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/header"
+	SourceRelationshipFooter           = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/footer"
+	SourceRelationshipFootnotes        = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/footnotes"
+	SourceRelationshipEndnotes         = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/endnotes"
+	SourceRelationshipFontTable        = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/fontTable"
+	SourceRelationshipFont             = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/font"
+	SourceRelationshipCustomXML        = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/customXml"
+	SourceRelationshipCustomXMLProps   = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/customXmlProps"
+
+	SourceRelationshipCommentsExtended = "http://schemas.microsoft.com/office/2011/relationships/commentsExtended"
+)
+
+// Content types of document parts
+const (
+	ContentTypeNumbering = "application/vnd.openxmlformats-officedocument.wordprocessingml.numbering+xml"
+	ContentTypeHeader    = "application/vnd.openxmlformats-officedocument.wordprocessingml.header+xml"
+	ContentTypeFooter    = "application/vnd.openxmlformats-officedocument.wordprocessingml.footer+xml"
+	ContentTypeStyles    = "application/vnd.openxmlformats-officedocument.wordprocessingml.styles+xml"
+	ContentTypeFootnotes = "application/vnd.openxmlformats-officedocument.wordprocessingml.footnotes+xml"
+	ContentTypeEndnotes  = "application/vnd.openxmlformats-officedocument.wordprocessingml.endnotes+xml"
+	ContentTypeComments  = "application/vnd.openxmlformats-officedocument.wordprocessingml.comments+xml"
+	ContentTypeFontTable = "application/vnd.openxmlformats-officedocument.wordprocessingml.fontTable+xml"
+
+	ContentTypeCommentsExtended = "application/vnd.openxmlformats-officedocument.wordprocessingml.commentsExtended+xml"
+
+	ContentTypeObfuscatedFont = "application/vnd.openxmlformats-officedocument.obfuscatedFont"
+
+	ContentTypeCustomXMLProperties = "application/vnd.openxmlformats-officedocument.customXmlProperties+xml"
+
+	ContentTypeCoreProperties   = "application/vnd.openxmlformats-package.core-properties+xml"
+	ContentTypeCustomProperties = "application/vnd.openxmlformats-officedocument.custom-properties+xml"
 )
 
 const (
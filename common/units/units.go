@@ -10,3 +10,19 @@ type Emu int64
 func (i Inch) ToEmu() Emu {
 	return Emu(i * 914400)
 }
+
+// Cm represents a dimension in centimeters.
+type Cm float64
+
+// ToEmu converts centimeters to EMUs.
+func (c Cm) ToEmu() Emu {
+	return Emu(c * 360000)
+}
+
+// Pt represents a dimension in points (1/72 inch).
+type Pt float64
+
+// ToEmu converts points to EMUs.
+func (p Pt) ToEmu() Emu {
+	return Emu(p * 12700)
+}
@@ -58,6 +58,25 @@ func TestMarshalAnchor(t *testing.T) {
 			expectedXML: `<wp:anchor behindDoc="9" distT="2" distB="3" distL="4" distR="5" simplePos="1" locked="10" layoutInCell="6" allowOverlap="7" relativeHeight="8"><wp:simplePos x="0" y="0"></wp:simplePos><wp:positionH relativeFrom="column"><wp:posOffset>0</wp:posOffset></wp:positionH><wp:positionV relativeFrom="line"><wp:posOffset>0</wp:posOffset></wp:positionV><wp:extent cx="100" cy="200"></wp:extent><wp:effectExtent l="1" t="2" r="3" b="4"></wp:effectExtent><wp:wrapNone></wp:wrapNone><wp:docPr id="1" name="test"></wp:docPr><a:graphic xmlns:a="http://schemas.openxmlformats.org/drawingml/2006/main"></a:graphic></wp:anchor>`,
 			xmlName:     "wp:anchor",
 		},
+		{
+			anchor: &Anchor{
+				WrapTight: &WrapTight{
+					WrapText: dmlst.WrapTextBothSides,
+					WrapPolygon: WrapPolygon{
+						Start:  dmlct.NewPoint2D(0, 0),
+						LineTo: []dmlct.Point2D{dmlct.NewPoint2D(21600, 0), dmlct.NewPoint2D(0, 0)},
+					},
+				},
+				PositionH: PoistionH{RelativeFrom: dmlst.RelFromHPage, PosOffset: 10},
+				PositionV: PoistionV{RelativeFrom: dmlst.RelFromVMargin, PosOffset: -20},
+				DocProp:   DocProp{ID: 2, Name: "tight"},
+			},
+			expectedXML: `<wp:anchor behindDoc="0" distT="0" distB="0" distL="0" distR="0" locked="0" layoutInCell="0" allowOverlap="0" relativeHeight="0"><wp:simplePos x="0" y="0"></wp:simplePos>` +
+				`<wp:positionH relativeFrom="page"><wp:posOffset>10</wp:posOffset></wp:positionH><wp:positionV relativeFrom="margin"><wp:posOffset>-20</wp:posOffset></wp:positionV>` +
+				`<wp:extent cx="0" cy="0"></wp:extent><wp:wrapTight wrapText="bothSides"><wp:wrapPolygon><wp:start x="0" y="0"></wp:start><wp:lineTo x="21600" y="0"></wp:lineTo><wp:lineTo x="0" y="0"></wp:lineTo></wp:wrapPolygon></wp:wrapTight>` +
+				`<wp:docPr id="2" name="tight"></wp:docPr><a:graphic xmlns:a="http://schemas.openxmlformats.org/drawingml/2006/main"></a:graphic></wp:anchor>`,
+			xmlName: "wp:anchor with tight wrapping",
+		},
 	}
 
 	for _, tt := range tests {
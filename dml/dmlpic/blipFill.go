@@ -58,7 +58,7 @@ func (b BlipFill) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 
 	// 2. SrcRect
 	if b.SrcRect != nil {
-		if err = b.SrcRect.MarshalXML(e, xml.StartElement{Name: xml.Name{Local: "a:SrcRect"}}); err != nil {
+		if err = b.SrcRect.MarshalXML(e, xml.StartElement{Name: xml.Name{Local: "a:srcRect"}}); err != nil {
 			return err
 		}
 	}
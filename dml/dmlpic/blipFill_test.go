@@ -1 +1,46 @@
 package dmlpic
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/MamaShip/godocx/dml/dmlct"
+	"github.com/MamaShip/godocx/dml/shapes"
+)
+
+func TestBlipFill_MarshalXML(t *testing.T) {
+	left, right := 25000, 10000
+
+	tests := []struct {
+		name     string
+		input    BlipFill
+		expected string
+	}{
+		{
+			name:     "Stretched",
+			input:    BlipFill{Blip: &Blip{EmbedID: "rId1"}, FillModeProps: FillModeProps{Stretch: &shapes.Stretch{FillRect: &dmlct.RelativeRect{}}}},
+			expected: `<pic:blipFill><a:blip r:embed="rId1"></a:blip><a:stretch><a:fillRect></a:fillRect></a:stretch></pic:blipFill>`,
+		},
+		{
+			name: "Cropped",
+			input: BlipFill{
+				Blip:          &Blip{EmbedID: "rId2"},
+				SrcRect:       &dmlct.RelativeRect{Left: &left, Right: &right},
+				FillModeProps: FillModeProps{Stretch: &shapes.Stretch{FillRect: &dmlct.RelativeRect{}}},
+			},
+			expected: `<pic:blipFill><a:blip r:embed="rId2"></a:blip><a:srcRect l="25000" r="10000"></a:srcRect><a:stretch><a:fillRect></a:fillRect></a:stretch></pic:blipFill>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := xml.Marshal(tt.input)
+			if err != nil {
+				t.Fatalf("Error marshaling BlipFill: %v", err)
+			}
+			if string(output) != tt.expected {
+				t.Errorf("Expected XML:\n%s\nGot:\n%s", tt.expected, output)
+			}
+		})
+	}
+}
@@ -87,6 +87,9 @@ func (p Pic) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 type TransformGroup struct {
 	Extent *dmlct.PSize2D `xml:"ext,omitempty"`
 	Offset *Offset        `xml:"off,omitempty"`
+
+	// Rotation is the clockwise rotation in 60000ths of a degree
+	Rotation *int `xml:"rot,attr,omitempty"`
 }
 
 type TFGroupOption func(*TransformGroup)
@@ -110,6 +113,10 @@ func WithTFExtent(width units.Emu, height units.Emu) TFGroupOption {
 func (t TransformGroup) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	start.Name.Local = "a:xfrm"
 
+	if t.Rotation != nil {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "rot"}, Value: strconv.Itoa(*t.Rotation)})
+	}
+
 	err := e.EncodeToken(start)
 	if err != nil {
 		return err
@@ -106,3 +106,27 @@ func TestPicUnmarshalXML(t *testing.T) {
 	checkNotNil("BlipFill", pic.BlipFill)
 	checkNotNil("PicShapeProp", pic.PicShapeProp)
 }
+
+func TestTransformGroup_Rotation(t *testing.T) {
+	rot := 5400000
+	tf := NewTransformGroup(WithTFExtent(100, 200))
+	tf.Rotation = &rot
+
+	output, err := xml.Marshal(tf)
+	if err != nil {
+		t.Fatalf("Error marshaling TransformGroup: %v", err)
+	}
+
+	expected := `<a:xfrm rot="5400000"><a:ext cx="100" cy="200"></a:ext></a:xfrm>`
+	if string(output) != expected {
+		t.Errorf("Expected XML:\n%s\nGot:\n%s", expected, output)
+	}
+
+	var decoded TransformGroup
+	if err := xml.Unmarshal(output, &decoded); err != nil {
+		t.Fatalf("Error unmarshaling TransformGroup: %v", err)
+	}
+	if decoded.Rotation == nil || *decoded.Rotation != rot {
+		t.Errorf("Expected rotation %d, got %v", rot, decoded.Rotation)
+	}
+}
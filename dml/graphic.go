@@ -20,8 +20,9 @@ func DefaultGraphic() *Graphic {
 }
 
 type GraphicData struct {
-	URI string      `xml:"uri,attr,omitempty"`
-	Pic *dmlpic.Pic `xml:"pic,omitempty"`
+	URI   string               `xml:"uri,attr,omitempty"`
+	Pic   *dmlpic.Pic          `xml:"pic,omitempty"`
+	Group *WordprocessingGroup `xml:"wgp,omitempty"`
 }
 
 func NewPicGraphic(pic *dmlpic.Pic) *Graphic {
@@ -33,6 +34,15 @@ func NewPicGraphic(pic *dmlpic.Pic) *Graphic {
 	}
 }
 
+func NewGroupGraphic(group *WordprocessingGroup) *Graphic {
+	return &Graphic{
+		Data: &GraphicData{
+			URI:   constants.WMLGroupNS,
+			Group: group,
+		},
+	}
+}
+
 func (g Graphic) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	start.Name.Local = "a:graphic"
 	start.Attr = []xml.Attr{
@@ -55,8 +65,13 @@ func (g Graphic) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 
 func (gd GraphicData) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	start.Name.Local = "a:graphicData"
+
+	uri := gd.URI
+	if uri == "" {
+		uri = constants.DrawingMLPicNS
+	}
 	start.Attr = []xml.Attr{
-		{Name: xml.Name{Local: "uri"}, Value: constants.DrawingMLPicNS},
+		{Name: xml.Name{Local: "uri"}, Value: uri},
 	}
 
 	err := e.EncodeToken(start)
@@ -70,5 +85,11 @@ func (gd GraphicData) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 		}
 	}
 
+	if gd.Group != nil {
+		if err := gd.Group.MarshalXML(e, xml.StartElement{}); err != nil {
+			return err
+		}
+	}
+
 	return e.EncodeToken(xml.EndElement{Name: start.Name})
 }
@@ -0,0 +1,117 @@
+package dml
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/MamaShip/godocx/dml/dmlct"
+)
+
+// WordprocessingGroup represents a group of shapes (wpg:wgp) that are moved and resized together.
+type WordprocessingGroup struct {
+	// 1. Group Shape Properties
+	GroupShapeProp GroupShapeProp `xml:"grpSpPr"`
+
+	// 2. Child shapes of the group
+	Shapes []*WordprocessingShape `xml:"wsp,omitempty"`
+}
+
+// GroupShapeProp represents the properties of a group shape (wpg:grpSpPr).
+type GroupShapeProp struct {
+	TransformGroup GroupTransform `xml:"xfrm"`
+}
+
+// GroupTransform represents the 2D transform of a group (a:xfrm).
+//
+// Offset and Extent place the group inside its parent drawing, while
+// ChildOffset and ChildExtent define the coordinate space used by the child shapes.
+type GroupTransform struct {
+	Offset      dmlct.Point2D `xml:"off"`
+	Extent      dmlct.PSize2D `xml:"ext"`
+	ChildOffset dmlct.Point2D `xml:"chOff"`
+	ChildExtent dmlct.PSize2D `xml:"chExt"`
+}
+
+// NewWordprocessingGroup creates an empty shape group.
+func NewWordprocessingGroup() *WordprocessingGroup {
+	return &WordprocessingGroup{}
+}
+
+// AddShape appends a shape to the group and returns it.
+func (g *WordprocessingGroup) AddShape(shape *WordprocessingShape) *WordprocessingShape {
+	g.Shapes = append(g.Shapes, shape)
+	return shape
+}
+
+func (g WordprocessingGroup) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name.Local = "wpg:wgp"
+
+	err := e.EncodeToken(start)
+	if err != nil {
+		return err
+	}
+
+	// 1. cNvGrpSpPr
+	if err = e.EncodeElement("", xml.StartElement{Name: xml.Name{Local: "wpg:cNvGrpSpPr"}}); err != nil {
+		return err
+	}
+
+	// 2. grpSpPr
+	if err = g.GroupShapeProp.MarshalXML(e, xml.StartElement{}); err != nil {
+		return fmt.Errorf("marshalling GroupShapeProp: %w", err)
+	}
+
+	// 3. Shapes
+	for _, shape := range g.Shapes {
+		if shape == nil {
+			continue
+		}
+		if err = shape.MarshalXML(e, xml.StartElement{}); err != nil {
+			return err
+		}
+	}
+
+	return e.EncodeToken(xml.EndElement{Name: start.Name})
+}
+
+func (p GroupShapeProp) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name.Local = "wpg:grpSpPr"
+
+	err := e.EncodeToken(start)
+	if err != nil {
+		return err
+	}
+
+	if err = p.TransformGroup.MarshalXML(e, xml.StartElement{}); err != nil {
+		return err
+	}
+
+	return e.EncodeToken(xml.EndElement{Name: start.Name})
+}
+
+func (t GroupTransform) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name.Local = "a:xfrm"
+
+	err := e.EncodeToken(start)
+	if err != nil {
+		return err
+	}
+
+	if err = t.Offset.MarshalXML(e, xml.StartElement{Name: xml.Name{Local: "a:off"}}); err != nil {
+		return err
+	}
+
+	if err = t.Extent.MarshalXML(e, xml.StartElement{Name: xml.Name{Local: "a:ext"}}); err != nil {
+		return err
+	}
+
+	if err = t.ChildOffset.MarshalXML(e, xml.StartElement{Name: xml.Name{Local: "a:chOff"}}); err != nil {
+		return err
+	}
+
+	if err = t.ChildExtent.MarshalXML(e, xml.StartElement{Name: xml.Name{Local: "a:chExt"}}); err != nil {
+		return err
+	}
+
+	return e.EncodeToken(xml.EndElement{Name: start.Name})
+}
@@ -0,0 +1,34 @@
+package dml
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/MamaShip/godocx/dml/dmlct"
+)
+
+func TestMarshalWordprocessingGroup(t *testing.T) {
+	fill := "FF0000"
+
+	group := NewWordprocessingGroup()
+	group.GroupShapeProp.TransformGroup = GroupTransform{
+		Extent:      dmlct.PSize2D{Width: 200, Height: 100},
+		ChildExtent: dmlct.PSize2D{Width: 200, Height: 100},
+	}
+	group.AddShape(NewWordprocessingShape(2, "Shape 2", "rect", 0, 0, 100, 100))
+	shape := group.AddShape(NewWordprocessingShape(3, "Shape 3", "ellipse", 100, 0, 100, 100))
+	shape.ShapeProp.FillColor = &fill
+
+	expectedXML := `<wpg:wgp><wpg:cNvGrpSpPr></wpg:cNvGrpSpPr><wpg:grpSpPr><a:xfrm><a:off x="0" y="0"></a:off><a:ext cx="200" cy="100"></a:ext><a:chOff x="0" y="0"></a:chOff><a:chExt cx="200" cy="100"></a:chExt></a:xfrm></wpg:grpSpPr>` +
+		`<wps:wsp><wps:cNvPr id="2" name="Shape 2" descr=""></wps:cNvPr><wps:cNvSpPr></wps:cNvSpPr><wps:spPr><a:xfrm><a:off x="0" y="0"></a:off><a:ext cx="100" cy="100"></a:ext></a:xfrm><a:prstGeom prst="rect"></a:prstGeom><a:noFill></a:noFill><a:ln><a:noFill></a:noFill></a:ln></wps:spPr><wps:bodyPr></wps:bodyPr></wps:wsp>` +
+		`<wps:wsp><wps:cNvPr id="3" name="Shape 3" descr=""></wps:cNvPr><wps:cNvSpPr></wps:cNvSpPr><wps:spPr><a:xfrm><a:off x="100" y="0"></a:off><a:ext cx="100" cy="100"></a:ext></a:xfrm><a:prstGeom prst="ellipse"></a:prstGeom><a:solidFill><a:srgbClr val="FF0000"></a:srgbClr></a:solidFill><a:ln><a:noFill></a:noFill></a:ln></wps:spPr><wps:bodyPr></wps:bodyPr></wps:wsp></wpg:wgp>`
+
+	generatedXML, err := xml.Marshal(group)
+	if err != nil {
+		t.Fatalf("Error marshaling XML: %v", err)
+	}
+
+	if string(generatedXML) != expectedXML {
+		t.Errorf("Expected XML:\n%s\nBut got:\n%s", expectedXML, generatedXML)
+	}
+}
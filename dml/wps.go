@@ -0,0 +1,197 @@
+package dml
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/MamaShip/godocx/dml/dmlct"
+	"github.com/MamaShip/godocx/dml/dmlpic"
+)
+
+// WordprocessingShape represents a single shape (wps:wsp) such as a rectangle or an ellipse.
+type WordprocessingShape struct {
+	// 1. Non-Visual Drawing Properties (required when the shape is part of a group)
+	CNvPr *dmlct.CNvPr `xml:"cNvPr,omitempty"`
+
+	// 2. Shape Properties
+	ShapeProp ShapeProp `xml:"spPr,omitempty"`
+}
+
+// ShapeProp represents the visual properties of a shape (wps:spPr).
+type ShapeProp struct {
+	// 1. 2D Transform for Individual Objects
+	TransformGroup *dmlpic.TransformGroup `xml:"xfrm,omitempty"`
+
+	// 2. Preset geometry (e.g. "rect", "ellipse", "line")
+	PresetGeometry *dmlpic.PresetGeometry `xml:"prstGeom,omitempty"`
+
+	// 3. Solid fill color in hex format (e.g. "FF0000"); no fill when nil
+	FillColor *string `xml:"-"`
+
+	// 4. Outline color in hex format (e.g. "000000"); no outline when nil
+	LineColor *string `xml:"-"`
+}
+
+// NewWordprocessingShape creates a new shape with the given preset geometry, offset and extent.
+// Offset and extent are expressed in EMUs within the coordinate space of the parent.
+func NewWordprocessingShape(id uint, name string, preset string, x, y, width, height uint64) *WordprocessingShape {
+	return &WordprocessingShape{
+		CNvPr: dmlct.NewNonVisProp(id, name),
+		ShapeProp: ShapeProp{
+			TransformGroup: &dmlpic.TransformGroup{
+				Offset: &dmlpic.Offset{X: x, Y: y},
+				Extent: &dmlct.PSize2D{Width: width, Height: height},
+			},
+			PresetGeometry: dmlpic.NewPresetGeom(preset),
+		},
+	}
+}
+
+func (s WordprocessingShape) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name.Local = "wps:wsp"
+
+	err := e.EncodeToken(start)
+	if err != nil {
+		return err
+	}
+
+	// 1. cNvPr
+	if s.CNvPr != nil {
+		if err = s.CNvPr.MarshalXML(e, xml.StartElement{
+			Name: xml.Name{Local: "wps:cNvPr"},
+		}); err != nil {
+			return fmt.Errorf("marshalling CNvPr: %w", err)
+		}
+	}
+
+	// 2. cNvSpPr
+	if err = e.EncodeElement("", xml.StartElement{Name: xml.Name{Local: "wps:cNvSpPr"}}); err != nil {
+		return err
+	}
+
+	// 3. spPr
+	if err = s.ShapeProp.MarshalXML(e, xml.StartElement{}); err != nil {
+		return fmt.Errorf("marshalling ShapeProp: %w", err)
+	}
+
+	// 4. bodyPr
+	if err = e.EncodeElement("", xml.StartElement{Name: xml.Name{Local: "wps:bodyPr"}}); err != nil {
+		return err
+	}
+
+	return e.EncodeToken(xml.EndElement{Name: start.Name})
+}
+
+func (p ShapeProp) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name.Local = "wps:spPr"
+
+	err := e.EncodeToken(start)
+	if err != nil {
+		return err
+	}
+
+	//1. Transform
+	if p.TransformGroup != nil {
+		if err = p.TransformGroup.MarshalXML(e, xml.StartElement{}); err != nil {
+			return fmt.Errorf("marshalling TransformGroup: %w", err)
+		}
+	}
+
+	//2. Geometry
+	if p.PresetGeometry != nil {
+		if err = p.PresetGeometry.MarshalXML(e, xml.StartElement{
+			Name: xml.Name{Local: "a:prstGeom"},
+		}); err != nil {
+			return fmt.Errorf("marshalling PresetGeometry: %w", err)
+		}
+	}
+
+	//3. Fill
+	if p.FillColor != nil {
+		if err = marshalSolidFill(e, *p.FillColor); err != nil {
+			return err
+		}
+	} else {
+		if err = e.EncodeElement("", xml.StartElement{Name: xml.Name{Local: "a:noFill"}}); err != nil {
+			return err
+		}
+	}
+
+	//4. Outline
+	lnElem := xml.StartElement{Name: xml.Name{Local: "a:ln"}}
+	if err = e.EncodeToken(lnElem); err != nil {
+		return err
+	}
+	if p.LineColor != nil {
+		if err = marshalSolidFill(e, *p.LineColor); err != nil {
+			return err
+		}
+	} else {
+		if err = e.EncodeElement("", xml.StartElement{Name: xml.Name{Local: "a:noFill"}}); err != nil {
+			return err
+		}
+	}
+	if err = e.EncodeToken(lnElem.End()); err != nil {
+		return err
+	}
+
+	return e.EncodeToken(xml.EndElement{Name: start.Name})
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface for the ShapeProp type.
+// The colors are read from the a:solidFill of the shape and of its a:ln outline.
+func (p *ShapeProp) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var aux struct {
+		TransformGroup *dmlpic.TransformGroup `xml:"xfrm"`
+		PresetGeometry *dmlpic.PresetGeometry `xml:"prstGeom"`
+		SolidFill      *solidFill             `xml:"solidFill"`
+		Line           *struct {
+			SolidFill *solidFill `xml:"solidFill"`
+		} `xml:"ln"`
+	}
+	if err := d.DecodeElement(&aux, &start); err != nil {
+		return err
+	}
+
+	p.TransformGroup = aux.TransformGroup
+	p.PresetGeometry = aux.PresetGeometry
+	p.FillColor = aux.SolidFill.color()
+	if aux.Line != nil {
+		p.LineColor = aux.Line.SolidFill.color()
+	}
+	return nil
+}
+
+// solidFill is the a:solidFill element read by ShapeProp.UnmarshalXML.
+type solidFill struct {
+	SrgbClr *struct {
+		Val string `xml:"val,attr"`
+	} `xml:"srgbClr"`
+}
+
+// color returns the RGB color of the fill, nil if there is no fill or it has another color model.
+func (f *solidFill) color() *string {
+	if f == nil || f.SrgbClr == nil {
+		return nil
+	}
+	color := f.SrgbClr.Val
+	return &color
+}
+
+// marshalSolidFill writes <a:solidFill><a:srgbClr val="color"/></a:solidFill>.
+func marshalSolidFill(e *xml.Encoder, color string) error {
+	fillElem := xml.StartElement{Name: xml.Name{Local: "a:solidFill"}}
+	if err := e.EncodeToken(fillElem); err != nil {
+		return err
+	}
+
+	clrElem := xml.StartElement{
+		Name: xml.Name{Local: "a:srgbClr"},
+		Attr: []xml.Attr{{Name: xml.Name{Local: "val"}, Value: color}},
+	}
+	if err := e.EncodeElement("", clrElem); err != nil {
+		return err
+	}
+
+	return e.EncodeToken(fillElem.End())
+}
@@ -2,6 +2,7 @@ package godocx
 
 import (
 	_ "embed"
+	"io"
 	"os"
 	"path/filepath"
 
@@ -19,9 +20,38 @@ func NewDocument() (*docx.RootDoc, error) {
 
 // OpenDocument opens a document from the given file name.
 func OpenDocument(fileName string) (*docx.RootDoc, error) {
-	docxContent, err := os.ReadFile(filepath.Clean(fileName))
+	return OpenDocumentWithLogger(fileName, nil)
+}
+
+// OpenDocumentWithLogger opens a document from the given file name, reporting the parts
+// that were parsed and the unknown elements that were skipped to logger.
+// A *slog.Logger can be passed directly.
+func OpenDocumentWithLogger(fileName string, logger docx.Logger) (*docx.RootDoc, error) {
+	file, err := os.Open(filepath.Clean(fileName))
 	if err != nil {
 		return nil, err
 	}
-	return packager.Unpack(&docxContent)
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	return packager.UnpackReaderAt(file, info.Size(), logger)
+}
+
+// ReadDocx reads a document of the given size from r, such as a multipart.File of an
+// uploaded form or a bytes.Reader, without touching the disk.
+//
+// Example:
+//
+//	file, header, err := req.FormFile("document")
+//	if err != nil {
+//		return err
+//	}
+//	defer file.Close()
+//	document, err := godocx.ReadDocx(file, header.Size)
+func ReadDocx(r io.ReaderAt, size int64) (*docx.RootDoc, error) {
+	return packager.UnpackReaderAt(r, size, nil)
 }
@@ -2,6 +2,8 @@ package docx
 
 import (
 	"encoding/xml"
+	"errors"
+	"strings"
 
 	"github.com/MamaShip/godocx/wml/ctypes"
 )
@@ -18,6 +20,8 @@ type Body struct {
 type DocumentChild struct {
 	Para  *Paragraph
 	Table *Table
+	Raw   *ctypes.RawXML  // Block level content added with AddRawXML, written as is
+	Sdt   *ContentControl // Block level content control, see RootDoc.AddContentControl
 }
 
 // Use this function to initialize a new Body before adding content to it.
@@ -27,6 +31,188 @@ func NewBody(root *RootDoc) *Body {
 	}
 }
 
+// ErrChildIndexOutOfRange is returned when a child is inserted at an index outside of the body.
+var ErrChildIndexOutOfRange = errors.New("child index out of range")
+
+// InsertAt inserts the child, a paragraph or a table, at the given index of the body children,
+// shifting the children from that index on. An index equal to the number of children appends it.
+//
+// Returns:
+//   - error: ErrChildIndexOutOfRange if the index is negative or past the end of the body.
+//
+// Example:
+//
+//	err := document.Document.Body.InsertAt(0, docx.DocumentChild{Para: cover})
+func (b *Body) InsertAt(index int, child DocumentChild) error {
+	if index < 0 || index > len(b.Children) {
+		return ErrChildIndexOutOfRange
+	}
+
+	b.Children = append(b.Children, DocumentChild{})
+	copy(b.Children[index+1:], b.Children[index:])
+	b.Children[index] = child
+	return nil
+}
+
+// InsertParagraphBefore inserts a new paragraph with the given text right before the
+// paragraph ref, and returns it.
+//
+// Returns nil if ref is not a child of the body, e.g. a paragraph of a table cell.
+func (b *Body) InsertParagraphBefore(ref *Paragraph, text string) *Paragraph {
+	return b.insertParagraph(ref, 0, text)
+}
+
+// InsertParagraphAfter inserts a new paragraph with the given text right after the
+// paragraph ref, and returns it.
+//
+// Returns nil if ref is not a child of the body, e.g. a paragraph of a table cell.
+//
+// Example:
+//
+//	body := document.Document.Body
+//	for _, child := range append([]docx.DocumentChild(nil), body.Children...) {
+//		if child.Para == nil {
+//			continue
+//		}
+//		if pPr := child.Para.GetCT().Property; pPr != nil && pPr.Style != nil && pPr.Style.Val == "Heading1" {
+//			body.InsertParagraphAfter(child.Para, "").BottomBorder(stypes.BorderStyleSingle, 6, "auto")
+//		}
+//	}
+func (b *Body) InsertParagraphAfter(ref *Paragraph, text string) *Paragraph {
+	return b.insertParagraph(ref, 1, text)
+}
+
+// insertParagraph inserts a new paragraph at the given offset from the index of ref.
+func (b *Body) insertParagraph(ref *Paragraph, offset int, text string) *Paragraph {
+	if ref == nil {
+		return nil
+	}
+
+	for i, child := range b.Children {
+		if child.Para != ref {
+			continue
+		}
+
+		p := newParagraph(ref.root)
+		p.AddText(text)
+		_ = b.InsertAt(i+offset, DocumentChild{Para: p})
+		return p
+	}
+
+	return nil
+}
+
+// Paragraphs returns the paragraphs that are direct children of the body, in document order.
+// Paragraphs of table cells are not included.
+func (b *Body) Paragraphs() []*Paragraph {
+	var paras []*Paragraph
+	for _, child := range b.Children {
+		if child.Para != nil {
+			paras = append(paras, child.Para)
+		}
+	}
+	return paras
+}
+
+// Tables returns the tables that are direct children of the body, in document order.
+// Nested tables are not included.
+func (b *Body) Tables() []*Table {
+	var tables []*Table
+	for _, child := range b.Children {
+		if child.Table != nil {
+			tables = append(tables, child.Table)
+		}
+	}
+	return tables
+}
+
+// RemoveParagraph removes the paragraph p from the body and reports whether it was found.
+// The paragraph is matched by identity, not by content.
+//
+// Example:
+//
+//	for _, p := range document.Document.Body.Paragraphs() {
+//		if strings.TrimSpace(p.Text()) == "" {
+//			document.Document.Body.RemoveParagraph(p)
+//		}
+//	}
+func (b *Body) RemoveParagraph(p *Paragraph) bool {
+	return p != nil && b.removeChild(func(child DocumentChild) bool { return child.Para == p })
+}
+
+// RemoveTable removes the table t from the body and reports whether it was found.
+// The table is matched by identity, not by content.
+func (b *Body) RemoveTable(t *Table) bool {
+	return t != nil && b.removeChild(func(child DocumentChild) bool { return child.Table == t })
+}
+
+// ReplaceParagraph puts the child, a paragraph or a table, in place of the paragraph old
+// and reports whether old was found.
+func (b *Body) ReplaceParagraph(old *Paragraph, child DocumentChild) bool {
+	if old == nil {
+		return false
+	}
+
+	for i := range b.Children {
+		if b.Children[i].Para == old {
+			b.Children[i] = child
+			return true
+		}
+	}
+	return false
+}
+
+// removeChild removes the first child matching the predicate and reports whether one was found.
+func (b *Body) removeChild(match func(child DocumentChild) bool) bool {
+	for i, child := range b.Children {
+		if match(child) {
+			b.Children = append(b.Children[:i], b.Children[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// AppendParagraph adds the paragraph at the end of the body, e.g. a copy made with
+// Paragraph.Clone. A paragraph must not be added twice.
+func (b *Body) AppendParagraph(p *Paragraph) {
+	b.Children = append(b.Children, DocumentChild{Para: p})
+}
+
+// AddRawXML appends a fragment of WordprocessingML, such as one or more w:p or w:tbl elements,
+// to the body. The fragment is written as is, as an escape hatch for content the typed API
+// does not cover; it is not visible to the other methods, e.g. PlainText.
+//
+// Namespace prefixes must be declared on the document element, like w: and r:, or within
+// the fragment.
+//
+// Returns:
+//   - error: ctypes.ErrInvalidRawXML if the fragment is not well-formed or uses an undeclared prefix.
+//
+// Example:
+//
+//	err := document.Document.Body.AddRawXML(`<w:p><w:r><w:sym w:font="Wingdings" w:char="F04A"/></w:r></w:p>`)
+func (b *Body) AddRawXML(fragment string) error {
+	raw, err := ctypes.NewRawXML(fragment, documentPrefixes())
+	if err != nil {
+		return err
+	}
+
+	b.Children = append(b.Children, DocumentChild{Raw: raw})
+	return nil
+}
+
+// documentPrefixes returns the namespace prefixes declared on the document element.
+func documentPrefixes() []string {
+	prefixes := make([]string, 0, len(docAttrs))
+	for _, attr := range docAttrs {
+		if strings.HasPrefix(attr.Name.Local, "xmlns:") {
+			prefixes = append(prefixes, strings.TrimPrefix(attr.Name.Local, "xmlns:"))
+		}
+	}
+	return prefixes
+}
+
 // MarshalXML implements the xml.Marshaler interface for the Body type.
 // It encodes the Body to its corresponding XML representation.
 func (b Body) MarshalXML(e *xml.Encoder, start xml.StartElement) (err error) {
@@ -37,20 +223,8 @@ func (b Body) MarshalXML(e *xml.Encoder, start xml.StartElement) (err error) {
 		return err
 	}
 
-	if b.Children != nil {
-		for _, child := range b.Children {
-			if child.Para != nil {
-				if err = child.Para.ct.MarshalXML(e, xml.StartElement{}); err != nil {
-					return err
-				}
-			}
-
-			if child.Table != nil {
-				if err = child.Table.ct.MarshalXML(e, xml.StartElement{}); err != nil {
-					return err
-				}
-			}
-		}
+	if err = marshalChildren(e, b.Children); err != nil {
+		return err
 	}
 
 	if b.SectPr != nil {
@@ -62,6 +236,37 @@ func (b Body) MarshalXML(e *xml.Encoder, start xml.StartElement) (err error) {
 	return e.EncodeToken(xml.EndElement{Name: start.Name})
 }
 
+// marshalChildren encodes the paragraphs, tables, raw fragments and block level content
+// controls of a body, header or footer, in order.
+func marshalChildren(e *xml.Encoder, children []DocumentChild) (err error) {
+	for _, child := range children {
+		if child.Para != nil {
+			if err = child.Para.ct.MarshalXML(e, xml.StartElement{}); err != nil {
+				return err
+			}
+		}
+
+		if child.Table != nil {
+			if err = child.Table.ct.MarshalXML(e, xml.StartElement{}); err != nil {
+				return err
+			}
+		}
+
+		if child.Raw != nil {
+			if err = child.Raw.MarshalXML(e, xml.StartElement{}); err != nil {
+				return err
+			}
+		}
+
+		if child.Sdt != nil {
+			if err = child.Sdt.marshalBlock(e); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // UnmarshalXML implements the xml.Unmarshaler interface for the Body type.
 // It decodes the XML representation of the Body.
 func (body *Body) UnmarshalXML(d *xml.Decoder, start xml.StartElement) (err error) {
@@ -87,12 +292,19 @@ func (body *Body) UnmarshalXML(d *xml.Decoder, start xml.StartElement) (err erro
 					return err
 				}
 				body.Children = append(body.Children, DocumentChild{Table: tbl})
+			case "sdt":
+				control, err := unmarshalBlockContentControl(body.root, d)
+				if err != nil {
+					return err
+				}
+				body.Children = append(body.Children, DocumentChild{Sdt: control})
 			case "sectPr":
 				body.SectPr = ctypes.NewSectionProper()
 				if err := d.DecodeElement(body.SectPr, &elem); err != nil {
 					return err
 				}
 			default:
+				body.root.LogDebug("skipped unknown element", "element", elem.Name.Local, "parent", "body")
 				if err = d.Skip(); err != nil {
 					return err
 				}
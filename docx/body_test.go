@@ -0,0 +1,109 @@
+package docx
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/MamaShip/godocx/wml/stypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func bodyTexts(body *Body) []string {
+	texts := make([]string, 0, len(body.Children))
+	for _, child := range body.Children {
+		switch {
+		case child.Para != nil:
+			texts = append(texts, child.Para.Text())
+		case child.Table != nil:
+			texts = append(texts, "<table>")
+		}
+	}
+	return texts
+}
+
+func TestBody_InsertAt(t *testing.T) {
+	rd := setupRootDoc(t)
+	body := rd.Document.Body
+
+	rd.AddParagraph("second")
+	rd.AddParagraph("fourth")
+
+	first := newParagraph(rd)
+	first.AddText("first")
+	require.NoError(t, body.InsertAt(0, DocumentChild{Para: first}))
+	require.NoError(t, body.InsertAt(2, DocumentChild{Table: NewTable(rd)}))
+
+	last := newParagraph(rd)
+	last.AddText("last")
+	require.NoError(t, body.InsertAt(len(body.Children), DocumentChild{Para: last}))
+
+	assert.ErrorIs(t, body.InsertAt(-1, DocumentChild{Para: last}), ErrChildIndexOutOfRange)
+	assert.ErrorIs(t, body.InsertAt(len(body.Children)+1, DocumentChild{Para: last}), ErrChildIndexOutOfRange)
+
+	assert.Equal(t, []string{"first", "second", "<table>", "fourth", "last"}, bodyTexts(body))
+}
+
+func TestBody_InsertParagraph(t *testing.T) {
+	rd := setupRootDoc(t)
+	body := rd.Document.Body
+
+	heading := rd.AddParagraph("Heading")
+	rd.AddParagraph("Text")
+
+	before := body.InsertParagraphBefore(heading, "Preface")
+	require.NotNil(t, before)
+	after := body.InsertParagraphAfter(heading, "")
+	require.NotNil(t, after)
+	after.BottomBorder(stypes.BorderStyleSingle, 6, "auto")
+
+	assert.Nil(t, body.InsertParagraphAfter(newParagraph(rd), "orphan"))
+	assert.Nil(t, body.InsertParagraphBefore(nil, "orphan"))
+
+	assert.Equal(t, []string{"Preface", "Heading", "", "Text"}, bodyTexts(body))
+
+	// The new order is kept when the body is written
+	out, err := xml.Marshal(body)
+	require.NoError(t, err)
+	content := string(out)
+	assert.Less(t, strings.Index(content, "Preface"), strings.Index(content, "Heading"))
+	assert.Less(t, strings.Index(content, "Heading"), strings.Index(content, "<w:pBdr>"))
+	assert.Less(t, strings.Index(content, "<w:pBdr>"), strings.Index(content, "Text"))
+}
+
+func TestBody_RemoveAndReplace(t *testing.T) {
+	rd := setupRootDoc(t)
+	body := rd.Document.Body
+
+	rd.AddParagraph("one")
+	empty := rd.AddEmptyParagraph()
+	tbl := rd.AddTable()
+	two := rd.AddParagraph("two")
+	rd.AddParagraph(" ")
+
+	assert.Len(t, body.Paragraphs(), 4)
+	assert.Equal(t, []*Table{tbl}, body.Tables())
+
+	// Cleanup pass removing the blank paragraphs
+	for _, p := range body.Paragraphs() {
+		if strings.TrimSpace(p.Text()) == "" {
+			assert.True(t, body.RemoveParagraph(p))
+		}
+	}
+	assert.Equal(t, []string{"one", "<table>", "two"}, bodyTexts(body))
+
+	assert.False(t, body.RemoveParagraph(empty))
+	assert.False(t, body.RemoveParagraph(nil))
+
+	three := newParagraph(rd)
+	three.AddText("three")
+	assert.True(t, body.ReplaceParagraph(two, DocumentChild{Para: three}))
+	assert.False(t, body.ReplaceParagraph(two, DocumentChild{Para: three}))
+	assert.Equal(t, []string{"one", "<table>", "three"}, bodyTexts(body))
+
+	assert.True(t, body.RemoveTable(tbl))
+	assert.False(t, body.RemoveTable(tbl))
+	assert.Empty(t, body.Tables())
+	assert.Equal(t, []string{"one", "three"}, bodyTexts(body))
+}
@@ -0,0 +1,118 @@
+package docx
+
+import (
+	"github.com/MamaShip/godocx/common/constants"
+	"github.com/MamaShip/godocx/wml/ctypes"
+	"github.com/MamaShip/godocx/wml/stypes"
+)
+
+// AddBookmark marks the paragraph with a bookmark of the given name.
+//
+// The bookmark spans the current content of the paragraph and can be targeted
+// by internal links (see AddInternalLink) and cross-reference fields.
+// Bookmark names should be unique within the document.
+//
+// Example:
+//
+//	h, _ := document.AddHeading("Introduction", 1)
+//	h.AddBookmark("Intro")
+//
+//	p := document.AddParagraph("See the ")
+//	p.AddInternalLink("introduction", "Intro")
+func (p *Paragraph) AddBookmark(name string) *Paragraph {
+	id := p.root.nextBookmarkID()
+
+	children := make([]ctypes.ParagraphChild, 0, len(p.ct.Children)+2)
+	children = append(children, ctypes.ParagraphChild{BookmarkStart: &ctypes.BookmarkStart{ID: id, Name: name}})
+	children = append(children, p.ct.Children...)
+	children = append(children, ctypes.ParagraphChild{BookmarkEnd: &ctypes.BookmarkEnd{ID: id}})
+	p.ct.Children = children
+
+	return p
+}
+
+// AddBookmarkStart starts a bookmark of the given name at the end of the paragraph, for a
+// bookmark spanning several paragraphs; it is ended by AddBookmarkEnd with the same name.
+// Bookmark names should be unique within the document.
+//
+// Example:
+//
+//	document.AddParagraph("Terms").AddBookmarkStart("Terms")
+//	document.AddParagraph("1. Payment is due within 30 days.")
+//	document.AddParagraph("2. Goods remain our property until paid.").AddBookmarkEnd("Terms")
+func (p *Paragraph) AddBookmarkStart(name string) *Paragraph {
+	start := &ctypes.BookmarkStart{ID: p.root.nextBookmarkID(), Name: name}
+	p.ct.Children = append(p.ct.Children, ctypes.ParagraphChild{BookmarkStart: start})
+	return p
+}
+
+// AddBookmarkEnd ends the bookmark of the given name, started with AddBookmarkStart, at the
+// end of the paragraph. Nothing is done if the document has no bookmark of that name.
+func (p *Paragraph) AddBookmarkEnd(name string) *Paragraph {
+	id, ok := p.root.bookmarkStartID(name)
+	if ok {
+		p.ct.Children = append(p.ct.Children, ctypes.ParagraphChild{BookmarkEnd: &ctypes.BookmarkEnd{ID: id}})
+	}
+	return p
+}
+
+// AddInternalLink adds a link to the bookmark with the given name. Word also recognizes the
+// name "_top" as the start of the document, e.g. for "back to top" links.
+//
+// Parameters:
+//   - text: The text displayed for the link.
+//   - bookmarkName: The name of the target bookmark.
+//
+// Returns:
+//   - *Run: The run holding the link text, for further formatting.
+func (p *Paragraph) AddInternalLink(text string, bookmarkName string) *Run {
+	run := &ctypes.Run{
+		Children: []ctypes.RunChild{{Text: ctypes.TextFromString(text)}},
+		Property: &ctypes.RunProperty{
+			Style: &ctypes.CTString{Val: constants.HyperLinkStyle},
+		},
+	}
+
+	link := &ctypes.Hyperlink{
+		Anchor: bookmarkName,
+		Run:    run,
+	}
+
+	p.ct.Children = append(p.ct.Children, ctypes.ParagraphChild{Link: link})
+	p.root.ensureStyle(constants.HyperLinkStyle, stypes.StyleTypeCharacter)
+
+	return newRun(p.root, run)
+}
+
+// bookmarkStartID returns the ID of the last bookmark of the document body with the given
+// name, and whether there is one.
+func (rd *RootDoc) bookmarkStartID(name string) (int, bool) {
+	id, found := 0, false
+	rd.walkParagraphs(func(p *ctypes.Paragraph) {
+		for _, child := range p.Children {
+			if child.BookmarkStart != nil && child.BookmarkStart.Name == name {
+				id, found = child.BookmarkStart.ID, true
+			}
+		}
+	})
+	return id, found
+}
+
+// nextBookmarkID returns a bookmark ID not used anywhere else in the document.
+// On first use the IDs of existing bookmarks, e.g. from a loaded document, are taken into account.
+func (rd *RootDoc) nextBookmarkID() int {
+	if !rd.bookmarkIDInit {
+		rd.bookmarkIDInit = true
+		rd.walkParagraphs(func(p *ctypes.Paragraph) {
+			for _, child := range p.Children {
+				if child.BookmarkStart != nil && child.BookmarkStart.ID >= rd.bookmarkID {
+					rd.bookmarkID = child.BookmarkStart.ID + 1
+				}
+			}
+		})
+	}
+
+	id := rd.bookmarkID
+	rd.bookmarkID++
+	return id
+}
@@ -0,0 +1,90 @@
+package docx
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParagraph_AddBookmark(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	p1 := rd.AddParagraph("Introduction").AddBookmark("Intro")
+	p2 := rd.AddParagraph("Details").AddBookmark("Details")
+
+	out, err := xml.Marshal(p1.ct)
+	if err != nil {
+		t.Fatalf("marshal paragraph: %v", err)
+	}
+	assert.Equal(t, `<w:p><w:bookmarkStart w:id="0" w:name="Intro"></w:bookmarkStart><w:r><w:t>Introduction</w:t></w:r><w:bookmarkEnd w:id="0"></w:bookmarkEnd></w:p>`, string(out))
+
+	assert.Equal(t, 1, p2.ct.Children[0].BookmarkStart.ID)
+	assert.Equal(t, 1, p2.ct.Children[2].BookmarkEnd.ID)
+}
+
+func TestParagraph_AddInternalLink(t *testing.T) {
+	rd := setupRootDoc(t)
+	rd.DocStyles.RelativePath = "word/styles.xml"
+
+	p := rd.AddParagraph("See ")
+	p.AddInternalLink("the introduction", "Intro").Italic(true)
+
+	out, err := xml.Marshal(p.ct)
+	if err != nil {
+		t.Fatalf("marshal paragraph: %v", err)
+	}
+	assert.True(t, strings.Contains(string(out), `<w:hyperlink w:anchor="Intro"><w:r><w:rPr><w:rStyle w:val="Hyperlink"></w:rStyle><w:i w:val="true"></w:i></w:rPr><w:t>the introduction</w:t></w:r></w:hyperlink>`), string(out))
+
+	// Internal links do not need a relationship
+	assert.Empty(t, rd.Document.DocRels.Relationships)
+}
+
+func TestParagraph_AddBookmarkStartEnd(t *testing.T) {
+	rd := setupRootDoc(t)
+	rd.AddParagraph("Title").AddBookmark("Top")
+
+	first := rd.AddParagraph("Terms").AddBookmarkStart("Terms")
+	rd.AddParagraph("1. Payment is due within 30 days.")
+	last := rd.AddParagraph("2. Goods remain ours until paid.").AddBookmarkEnd("Terms")
+	rd.AddParagraph("Back").AddBookmarkEnd("Missing")
+
+	start := first.ct.Children[1].BookmarkStart
+	assert.Equal(t, "Terms", start.Name)
+	assert.Equal(t, 1, start.ID)
+	assert.Equal(t, start.ID, last.ct.Children[1].BookmarkEnd.ID)
+	assert.Len(t, rd.Document.Body.Children[4].Para.ct.Children, 1)
+
+	out, err := xml.Marshal(last.ct)
+	if err != nil {
+		t.Fatalf("marshal paragraph: %v", err)
+	}
+	assert.Equal(t, `<w:p><w:r><w:t>2. Goods remain ours until paid.</w:t></w:r><w:bookmarkEnd w:id="1"></w:bookmarkEnd></w:p>`, string(out))
+}
+
+func TestBookmarkIDsUniqueAfterLoad(t *testing.T) {
+	rd := setupRootDoc(t)
+	rd.AddParagraph("Intro").AddBookmark("Intro")
+	rd.AddParagraph("Body").AddBookmark("Body")
+
+	out, err := xml.Marshal(rd.Document)
+	if err != nil {
+		t.Fatalf("marshal document: %v", err)
+	}
+
+	loaded := NewRootDoc()
+	doc, err := LoadDocXml(loaded, "word/document.xml", out)
+	if err != nil {
+		t.Fatalf("load document: %v", err)
+	}
+	loaded.Document = doc
+
+	paras := doc.Body.Children
+	assert.Len(t, paras, 2)
+	assert.Equal(t, "Body", paras[1].Para.ct.Children[0].BookmarkStart.Name)
+	assert.Equal(t, 1, paras[1].Para.ct.Children[0].BookmarkStart.ID)
+
+	p := loaded.AddParagraph("New").AddBookmark("New")
+	assert.Equal(t, 2, p.ct.Children[0].BookmarkStart.ID)
+}
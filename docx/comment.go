@@ -0,0 +1,291 @@
+package docx
+
+import (
+	"encoding/xml"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MamaShip/godocx/common/constants"
+	"github.com/MamaShip/godocx/wml/ctypes"
+)
+
+// Comment is a review comment attached to a range of the document (w:comment).
+type Comment struct {
+	root *RootDoc
+
+	ID       int       // Unique ID linking the comment to its range in the document
+	Author   string    // Name of the author
+	Initials string    // Initials of the author, shown in the comment marker
+	Date     time.Time // Time the comment was written; the zero time omits it
+
+	Children []DocumentChild // Content of the comment
+
+	resolved bool     // Whether the comment is marked as done, from the extended comments
+	parent   *Comment // Comment answered by the comment, from the extended comments
+}
+
+// commentsPart holds the comments of the document, stored in the comments part.
+type commentsPart struct {
+	path     string
+	extPath  string // Path of the extended comments part, empty if the document has none
+	comments []*Comment
+}
+
+// AddParagraph adds a new paragraph with the specified text to the comment, using the
+// CommentText paragraph style.
+//
+// Example:
+//
+//	comment := document.AddComment("Jane Doe", "JD", run)
+//	comment.AddParagraph("Please double check this figure.")
+func (c *Comment) AddParagraph(text string) *Paragraph {
+	p := newParagraph(c.root)
+	p.Style("CommentText")
+	p.AddText(text)
+	c.Children = append(c.Children, DocumentChild{Para: p})
+	return p
+}
+
+// Text returns the text of the comment, one line per paragraph.
+func (c *Comment) Text() string {
+	lines := make([]string, 0, len(c.Children))
+	for _, child := range c.Children {
+		if child.Para != nil {
+			lines = append(lines, child.Para.Text())
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// AddComment creates a comment by the given author on the range from the first to the last
+// of the given runs, which are expected in document order, and returns it so that its text
+// can be added with AddParagraph.
+//
+// The range is marked with w:commentRangeStart and w:commentRangeEnd, followed by a run
+// holding the comment reference. The comment is dated with the current time and is given
+// an ID not used by the other comments of the document.
+//
+// Returns nil if none of the runs is part of a paragraph of the document body, or if the
+// comments part of a loaded document cannot be parsed.
+//
+// Example:
+//
+//	p := document.AddParagraph("")
+//	figure := p.AddText("Revenue grew 40%")
+//	document.AddComment("Jane Doe", "JD", figure).AddParagraph("Source?")
+func (rd *RootDoc) AddComment(author, initials string, runs ...*Run) *Comment {
+	var first, last *Run
+	for _, run := range runs {
+		if run == nil {
+			continue
+		}
+		if p, _ := rd.findRun(run.ct); p == nil {
+			continue
+		}
+		if first == nil {
+			first = run
+		}
+		last = run
+	}
+	if first == nil {
+		return nil
+	}
+
+	part := rd.commentsPart(true)
+	if part == nil {
+		return nil
+	}
+	id := part.nextID()
+
+	comment := &Comment{
+		root:     rd,
+		ID:       id,
+		Author:   author,
+		Initials: initials,
+		Date:     time.Now().Truncate(time.Second),
+	}
+	part.comments = append(part.comments, comment)
+
+	p, idx := rd.findRun(first.ct)
+	insertParagraphChild(p, idx, ctypes.ParagraphChild{CommentRangeStart: &ctypes.Markup{ID: id}})
+
+	refRun := &ctypes.Run{Children: []ctypes.RunChild{{CmntRef: &ctypes.Markup{ID: id}}}}
+	newRun(rd, refRun).Style("CommentReference")
+
+	p, idx = rd.findRun(last.ct)
+	insertParagraphChild(p, idx+1, ctypes.ParagraphChild{CommentRangeEnd: &ctypes.Markup{ID: id}})
+	insertParagraphChild(p, idx+2, ctypes.ParagraphChild{Run: refRun})
+
+	return comment
+}
+
+// Comments returns the comments of the document, including those of a loaded document,
+// in the order of the comments part. The replies are listed with the other comments, see
+// Comment.Parent and Comment.Resolved for the threads and resolved state of Word 2013 and later.
+// A comments part that cannot be parsed is left as loaded and no comments are returned.
+func (rd *RootDoc) Comments() []*Comment {
+	part := rd.commentsPart(false)
+	if part == nil {
+		return nil
+	}
+	return append([]*Comment(nil), part.comments...)
+}
+
+// commentsPart returns the comments part of the document, parsing it from the file map the
+// first time it is requested. If the document has none, a new part is registered when create
+// is set and nil is returned otherwise. Nil is returned too if the part cannot be parsed,
+// which is then left as loaded.
+func (rd *RootDoc) commentsPart(create bool) *commentsPart {
+	if rd.comments != nil {
+		return rd.comments
+	}
+
+	for _, rel := range rd.Document.DocRels.Relationships {
+		if rel.Type != constants.SourceRelationshipComments {
+			continue
+		}
+
+		path := "word/" + rel.Target
+		if strings.HasPrefix(rel.Target, "/") {
+			path = strings.TrimPrefix(rel.Target, "/")
+		}
+
+		part := &commentsPart{path: path}
+		if content, ok := rd.FileMap.Load(path); ok {
+			comments, err := rd.parseComments(content.([]byte))
+			if err != nil {
+				// The part is left unparsed, so that it is saved as loaded
+				rd.LogDebug("kept unparsable comments part", "path", path, "error", err)
+				return nil
+			}
+			part.comments = comments
+		}
+		rd.loadCommentsExtended(part)
+
+		rd.comments = part
+		return part
+	}
+
+	if !create {
+		return nil
+	}
+
+	const path = "word/comments.xml"
+	rd.Document.addRelation(constants.SourceRelationshipComments, strings.TrimPrefix(path, "word/"))
+	_ = rd.ContentType.AddOverride("/"+path, constants.ContentTypeComments)
+
+	rd.comments = &commentsPart{path: path}
+	return rd.comments
+}
+
+// parseComments decodes the w:comment elements of a comments part.
+func (rd *RootDoc) parseComments(content []byte) ([]*Comment, error) {
+	var comments []*Comment
+
+	d, release := rd.newDecoder(content)
+	defer release()
+	for {
+		token, err := d.Token()
+		if err != nil {
+			if err == io.EOF {
+				return comments, nil
+			}
+			return comments, err
+		}
+
+		elem, ok := token.(xml.StartElement)
+		if !ok || elem.Name.Local != "comment" {
+			continue
+		}
+
+		comment := &Comment{root: rd}
+		for _, attr := range elem.Attr {
+			switch attr.Name.Local {
+			case "id":
+				comment.ID, _ = strconv.Atoi(attr.Value)
+			case "author":
+				comment.Author = attr.Value
+			case "initials":
+				comment.Initials = attr.Value
+			case "date":
+				comment.Date, _ = time.Parse(time.RFC3339, attr.Value)
+			}
+		}
+
+		body := NewBody(rd)
+		if err := body.UnmarshalXML(d, elem); err != nil {
+			return comments, err
+		}
+		comment.Children = body.Children
+		comments = append(comments, comment)
+	}
+}
+
+// MarshalXML implements the xml.Marshaler interface for the comments part.
+func (cp commentsPart) MarshalXML(e *xml.Encoder, start xml.StartElement) (err error) {
+	start.Name.Local = "w:comments"
+	start.Attr = append(start.Attr, docAttrs...)
+
+	if err = e.EncodeToken(start); err != nil {
+		return err
+	}
+
+	for _, comment := range cp.comments {
+		if err = comment.MarshalXML(e, xml.StartElement{}); err != nil {
+			return err
+		}
+	}
+
+	return e.EncodeToken(xml.EndElement{Name: start.Name})
+}
+
+// MarshalXML implements the xml.Marshaler interface for the Comment type.
+func (c Comment) MarshalXML(e *xml.Encoder, start xml.StartElement) (err error) {
+	start.Name.Local = "w:comment"
+	start.Attr = []xml.Attr{
+		{Name: xml.Name{Local: "w:id"}, Value: strconv.Itoa(c.ID)},
+		{Name: xml.Name{Local: "w:author"}, Value: c.Author},
+	}
+	if !c.Date.IsZero() {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "w:date"}, Value: FormatCoreTime(c.Date)})
+	}
+	if c.Initials != "" {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "w:initials"}, Value: c.Initials})
+	}
+
+	if err = e.EncodeToken(start); err != nil {
+		return err
+	}
+
+	// A comment holds at least one paragraph
+	if len(c.Children) == 0 {
+		if err = (&ctypes.Paragraph{}).MarshalXML(e, xml.StartElement{}); err != nil {
+			return err
+		}
+	}
+
+	for _, child := range c.Children {
+		if child.Para != nil {
+			if err = child.Para.ct.MarshalXML(e, xml.StartElement{}); err != nil {
+				return err
+			}
+		}
+
+		if child.Table != nil {
+			if err = child.Table.ct.MarshalXML(e, xml.StartElement{}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return e.EncodeToken(xml.EndElement{Name: start.Name})
+}
+
+// insertParagraphChild inserts the child at the given index of the paragraph children.
+func insertParagraphChild(p *ctypes.Paragraph, idx int, child ctypes.ParagraphChild) {
+	p.Children = append(p.Children, ctypes.ParagraphChild{})
+	copy(p.Children[idx+1:], p.Children[idx:])
+	p.Children[idx] = child
+}
@@ -0,0 +1,184 @@
+package docx_test
+
+import (
+	"strings"
+	"testing"
+
+	godocx "github.com/MamaShip/godocx"
+	"github.com/MamaShip/godocx/docx"
+	"github.com/MamaShip/godocx/packager"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddComment(t *testing.T) {
+	rd, err := godocx.NewDocument()
+	require.NoError(t, err)
+
+	p := rd.AddParagraph("")
+	p.AddText("Revenue ")
+	first := p.AddText("grew ")
+	last := p.AddText("40%")
+	p.AddText(" this year")
+
+	comment := rd.AddComment("Jane Doe", "JD", first, last)
+	require.NotNil(t, comment)
+	comment.AddParagraph("Source?")
+	assert.Equal(t, 0, comment.ID)
+	assert.False(t, comment.Date.IsZero())
+
+	files, _ := writeParts(t, rd)
+
+	document := string(files["word/document.xml"])
+	assert.Contains(t, document, `<w:t xml:space="preserve">Revenue </w:t></w:r><w:commentRangeStart w:id="0"></w:commentRangeStart><w:r><w:t xml:space="preserve">grew </w:t></w:r>`+
+		`<w:r><w:t>40%</w:t></w:r><w:commentRangeEnd w:id="0"></w:commentRangeEnd>`+
+		`<w:r><w:rPr><w:rStyle w:val="CommentReference"></w:rStyle></w:rPr><w:commentReference w:id="0"></w:commentReference></w:r>`)
+
+	comments := string(files["word/comments.xml"])
+	assert.Regexp(t, `<w:comment w:id="0" w:author="Jane Doe" w:date="\d{4}-\d\d-\d\dT\d\d:\d\d:\d\dZ" w:initials="JD">`, comments)
+	assert.Contains(t, comments, `<w:pStyle w:val="CommentText"></w:pStyle>`)
+	assert.Contains(t, comments, `<w:t>Source?</w:t>`)
+
+	assert.Contains(t, string(files["word/_rels/document.xml.rels"]), `Target="comments.xml"`)
+	assert.Contains(t, string(files["[Content_Types].xml"]), `PartName="/word/comments.xml"`)
+	assert.Contains(t, string(files["word/styles.xml"]), `w:styleId="CommentReference"`)
+}
+
+func TestComments_RoundTrip(t *testing.T) {
+	rd, err := godocx.NewDocument()
+	require.NoError(t, err)
+	assert.Empty(t, rd.Comments())
+
+	rd.AddComment("Jane Doe", "JD", rd.AddParagraph("").AddText("First")).AddParagraph("Check")
+
+	_, content := writeParts(t, rd)
+	reopened, err := packager.Unpack(&content)
+	require.NoError(t, err)
+
+	comments := reopened.Comments()
+	require.Len(t, comments, 1)
+	assert.Equal(t, "Jane Doe", comments[0].Author)
+	assert.Equal(t, "JD", comments[0].Initials)
+	assert.Equal(t, "Check", comments[0].Text())
+	assert.False(t, comments[0].Date.IsZero())
+
+	second := reopened.AddComment("John Roe", "", reopened.AddParagraph("").AddText("Second"))
+	second.AddParagraph("Agreed")
+	assert.Equal(t, 1, second.ID)
+
+	files, _ := writeParts(t, reopened)
+	xml := string(files["word/comments.xml"])
+	assert.Equal(t, 2, strings.Count(xml, "<w:comment "))
+	assert.Contains(t, xml, `<w:t>Check</w:t>`)
+	assert.Contains(t, xml, `<w:comment w:id="1" w:author="John Roe"`)
+	assert.Equal(t, 1, strings.Count(string(files["word/_rels/document.xml.rels"]), `Target="comments.xml"`))
+
+	document := string(files["word/document.xml"])
+	assert.Contains(t, document, `<w:commentRangeStart w:id="0"></w:commentRangeStart>`)
+	assert.Contains(t, document, `<w:commentReference w:id="1"></w:commentReference>`)
+}
+
+func TestComments_UnparsablePartIsKept(t *testing.T) {
+	rd, err := godocx.NewDocument()
+	require.NoError(t, err)
+	rd.AddComment("Jane Doe", "JD", rd.AddParagraph("").AddText("First")).AddParagraph("Check")
+	rd.AddComment("John Roe", "JR", rd.AddParagraph("").AddText("Second")).AddParagraph("Agreed")
+
+	files, _ := writeParts(t, rd)
+	comments := strings.Replace(string(files["word/comments.xml"]),
+		`<w:pStyle w:val="CommentText"></w:pStyle>`, `<w:pStyle w:val="CommentText"></w:pStyle><w:ind w:left="1cm"/>`, 1)
+	files["word/comments.xml"] = []byte(comments)
+	content := zipParts(t, files)
+
+	reopened, err := packager.Unpack(&content)
+	require.NoError(t, err)
+	assert.Empty(t, reopened.Comments())
+	assert.Nil(t, reopened.AddComment("Max Poe", "MP", reopened.AddParagraph("").AddText("Third")))
+
+	// The comments are saved as loaded instead of being lost
+	files, _ = writeParts(t, reopened)
+	assert.Equal(t, comments, string(files["word/comments.xml"]))
+	assert.Equal(t, 1, strings.Count(string(files["word/_rels/document.xml.rels"]), `Target="comments.xml"`))
+	assert.Equal(t, 2, strings.Count(string(files["word/document.xml"]), "<w:commentReference "))
+}
+
+func TestAddComment_RunNotInDocument(t *testing.T) {
+	rd, err := godocx.NewDocument()
+	require.NoError(t, err)
+
+	assert.Nil(t, rd.AddComment("Jane Doe", "JD"))
+	assert.Empty(t, rd.Comments())
+}
+
+func TestComment_RepliesAndResolved(t *testing.T) {
+	rd, err := godocx.NewDocument()
+	require.NoError(t, err)
+
+	comment := rd.AddComment("Jane Doe", "JD", rd.AddParagraph("").AddText("Revenue grew 40%"))
+	comment.AddParagraph("Source?")
+	reply := comment.AddReply("John Roe", "JR")
+	reply.AddParagraph("Annual report, page 12.")
+	nested := reply.AddReply("Jane Doe", "JD")
+	nested.AddParagraph("Thanks")
+	comment.SetResolved(true)
+
+	assert.Equal(t, 1, reply.ID)
+	assert.Same(t, comment, reply.Parent())
+	assert.Same(t, comment, nested.Parent(), "replies are threaded to the first comment")
+	assert.Equal(t, []*docx.Comment{reply, nested}, comment.Replies())
+
+	files, content := writeParts(t, rd)
+	document := string(files["word/document.xml"])
+	assert.Contains(t, document, `<w:commentRangeStart w:id="0"></w:commentRangeStart><w:commentRangeStart w:id="1"></w:commentRangeStart><w:commentRangeStart w:id="2"></w:commentRangeStart>`)
+	assert.Contains(t, document, `<w:commentRangeEnd w:id="0"></w:commentRangeEnd><w:commentRangeEnd w:id="1"></w:commentRangeEnd><w:commentRangeEnd w:id="2"></w:commentRangeEnd>`)
+	assert.Equal(t, 3, strings.Count(document, `<w:rStyle w:val="CommentReference">`))
+
+	assert.Contains(t, string(files["word/comments.xml"]), `<w:p w14:paraId="00000001">`)
+	assert.Contains(t, string(files["word/commentsExtended.xml"]), `<w15:commentsEx xmlns:w15="http://schemas.microsoft.com/office/word/2012/wordml" `+
+		`xmlns:mc="http://schemas.openxmlformats.org/markup-compatibility/2006" mc:Ignorable="w15">`+
+		`<w15:commentEx w15:paraId="00000001" w15:done="1"></w15:commentEx>`+
+		`<w15:commentEx w15:paraId="00000002" w15:paraIdParent="00000001" w15:done="0"></w15:commentEx>`+
+		`<w15:commentEx w15:paraId="00000003" w15:paraIdParent="00000001" w15:done="0"></w15:commentEx>`+
+		`</w15:commentsEx>`)
+	assert.Contains(t, string(files["word/_rels/document.xml.rels"]), `Target="commentsExtended.xml"`)
+	assert.Contains(t, string(files["[Content_Types].xml"]), `PartName="/word/commentsExtended.xml"`)
+
+	reopened, err := packager.Unpack(&content)
+	require.NoError(t, err)
+	comments := reopened.Comments()
+	require.Len(t, comments, 3)
+	assert.True(t, comments[0].Resolved())
+	assert.Nil(t, comments[0].Parent())
+	assert.False(t, comments[1].Resolved())
+	assert.Same(t, comments[0], comments[1].Parent())
+	assert.Same(t, comments[0], comments[2].Parent())
+	assert.Equal(t, "Annual report, page 12.", comments[1].Text())
+
+	comments[0].SetResolved(false)
+	files, _ = writeParts(t, reopened)
+	assert.Contains(t, string(files["word/commentsExtended.xml"]), `<w15:commentEx w15:paraId="00000001" w15:done="0">`)
+	assert.Equal(t, 1, strings.Count(string(files["word/_rels/document.xml.rels"]), `Target="commentsExtended.xml"`))
+}
+
+func TestComment_Delete(t *testing.T) {
+	rd, err := godocx.NewDocument()
+	require.NoError(t, err)
+
+	p := rd.AddParagraph("")
+	first := rd.AddComment("Jane Doe", "JD", p.AddText("Revenue "))
+	first.AddParagraph("Source?")
+	first.AddReply("John Roe", "JR").AddParagraph("Annual report")
+	second := rd.AddComment("John Roe", "JR", p.AddText("grew"))
+	second.AddParagraph("By how much?")
+
+	first.Delete()
+	assert.Equal(t, []*docx.Comment{second}, rd.Comments())
+
+	files, _ := writeParts(t, rd)
+	document := string(files["word/document.xml"])
+	assert.NotContains(t, document, `w:id="0"`)
+	assert.NotContains(t, document, `w:id="1"`)
+	assert.Contains(t, document, `<w:t xml:space="preserve">Revenue </w:t>`)
+	assert.Contains(t, document, `<w:commentReference w:id="2"></w:commentReference>`)
+	assert.Equal(t, 1, strings.Count(string(files["word/comments.xml"]), "<w:comment "))
+}
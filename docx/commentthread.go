@@ -0,0 +1,354 @@
+package docx
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MamaShip/godocx/common/constants"
+	"github.com/MamaShip/godocx/internal"
+	"github.com/MamaShip/godocx/wml/ctypes"
+	"github.com/MamaShip/godocx/wml/stypes"
+)
+
+// w15Namespace is the namespace of the Word 2012 extensions, holding the extended comments.
+const w15Namespace = "http://schemas.microsoft.com/office/word/2012/wordml"
+
+// commentsExtended is the extended comments part (w15:commentsEx), which links the replies to
+// the comment they answer and records the comments marked as done. Comments are identified
+// by the paragraph ID (w14:paraId) of their last paragraph.
+type commentsExtended struct {
+	part *commentsPart
+}
+
+// Parent returns the comment the comment replies to, or nil if it starts a thread.
+func (c *Comment) Parent() *Comment {
+	return c.parent
+}
+
+// Replies returns the replies to the comment, in the order of the comments part.
+func (c *Comment) Replies() []*Comment {
+	part := c.root.commentsPart(false)
+	if part == nil {
+		return nil
+	}
+
+	var replies []*Comment
+	for _, comment := range part.comments {
+		if comment.parent == c {
+			replies = append(replies, comment)
+		}
+	}
+	return replies
+}
+
+// Resolved reports whether the comment is marked as done.
+func (c *Comment) Resolved() bool {
+	return c.resolved
+}
+
+// SetResolved marks the comment as done, or as open again, as the "Resolve" button of Word.
+//
+// Returns:
+//   - *Comment: The comment, for chaining.
+func (c *Comment) SetResolved(resolved bool) *Comment {
+	c.resolved = resolved
+	if part := c.root.commentsPart(true); part != nil {
+		c.root.ensureCommentsExtended(part)
+	}
+	return c
+}
+
+// AddReply adds a reply by the given author to the comment, on the same range of the
+// document, and returns it so that its text can be added with AddParagraph. A reply to a
+// reply is added to the thread of the comment that started it, as in Word.
+//
+// Example:
+//
+//	comment := document.AddComment("Jane Doe", "JD", run)
+//	comment.AddParagraph("Source?")
+//	comment.AddReply("John Smith", "JS").AddParagraph("Annual report, page 12.")
+func (c *Comment) AddReply(author, initials string) *Comment {
+	parent := c
+	for parent.parent != nil {
+		parent = parent.parent
+	}
+
+	part := c.root.commentsPart(true)
+	if part == nil {
+		return nil
+	}
+	c.root.ensureCommentsExtended(part)
+
+	reply := &Comment{
+		root:     c.root,
+		ID:       part.nextID(),
+		Author:   author,
+		Initials: initials,
+		Date:     time.Now().Truncate(time.Second),
+		parent:   parent,
+	}
+	part.comments = append(part.comments, reply)
+
+	// The range of the reply starts and ends right after the ones of the comment it answers
+	refRun := &ctypes.Run{Children: []ctypes.RunChild{{CmntRef: &ctypes.Markup{ID: reply.ID}}}}
+	newRun(c.root, refRun).Style("CommentReference")
+
+	c.root.walkParagraphs(func(p *ctypes.Paragraph) {
+		for i := 0; i < len(p.Children); i++ {
+			child := p.Children[i]
+			switch {
+			case child.CommentRangeStart != nil && child.CommentRangeStart.ID == c.ID:
+				i++
+				insertParagraphChild(p, i, ctypes.ParagraphChild{CommentRangeStart: &ctypes.Markup{ID: reply.ID}})
+			case child.CommentRangeEnd != nil && child.CommentRangeEnd.ID == c.ID:
+				i++
+				insertParagraphChild(p, i, ctypes.ParagraphChild{CommentRangeEnd: &ctypes.Markup{ID: reply.ID}})
+			case isCommentReference(child, map[int]bool{c.ID: true}):
+				i++
+				insertParagraphChild(p, i, ctypes.ParagraphChild{Run: refRun})
+			}
+		}
+	})
+
+	return reply
+}
+
+// Delete removes the comment and its replies from the document, together with their ranges
+// and reference marks in the document body. The commented text is kept.
+func (c *Comment) Delete() {
+	part := c.root.commentsPart(false)
+	if part == nil {
+		return
+	}
+
+	deleted := map[int]bool{}
+	kept := part.comments[:0]
+	for _, comment := range part.comments {
+		if comment == c || comment.threadRoot() == c || comment.parent == c {
+			deleted[comment.ID] = true
+			continue
+		}
+		kept = append(kept, comment)
+	}
+	part.comments = kept
+
+	c.root.walkParagraphs(func(p *ctypes.Paragraph) {
+		children := p.Children[:0]
+		for _, child := range p.Children {
+			switch {
+			case child.CommentRangeStart != nil && deleted[child.CommentRangeStart.ID]:
+			case child.CommentRangeEnd != nil && deleted[child.CommentRangeEnd.ID]:
+			case isCommentReference(child, deleted):
+			default:
+				children = append(children, child)
+			}
+		}
+		p.Children = children
+	})
+}
+
+// threadRoot returns the comment starting the thread of the comment.
+func (c *Comment) threadRoot() *Comment {
+	root := c
+	for root.parent != nil {
+		root = root.parent
+	}
+	return root
+}
+
+// isCommentReference reports whether the paragraph child is a run holding only the reference
+// mark of one of the comments.
+func isCommentReference(child ctypes.ParagraphChild, ids map[int]bool) bool {
+	if child.Run == nil || len(child.Run.Children) != 1 {
+		return false
+	}
+	ref := child.Run.Children[0].CmntRef
+	return ref != nil && ids[ref.ID]
+}
+
+// nextID returns a comment ID not used by the other comments of the part.
+func (cp *commentsPart) nextID() int {
+	id := 0
+	for _, existing := range cp.comments {
+		if existing.ID >= id {
+			id = existing.ID + 1
+		}
+	}
+	return id
+}
+
+// ensureCommentsExtended registers the extended comments part of the comments, if the
+// document has none.
+func (rd *RootDoc) ensureCommentsExtended(part *commentsPart) {
+	if part.extPath != "" {
+		return
+	}
+
+	const path = "word/commentsExtended.xml"
+	rd.Document.addRelation(constants.SourceRelationshipCommentsExtended, strings.TrimPrefix(path, "word/"))
+	_ = rd.ContentType.AddOverride("/"+path, constants.ContentTypeCommentsExtended)
+	part.extPath = path
+}
+
+// loadCommentsExtended reads the replies and the resolved state of the comments from the
+// extended comments part of a loaded document.
+func (rd *RootDoc) loadCommentsExtended(part *commentsPart) {
+	for _, rel := range rd.Document.DocRels.Relationships {
+		if rel.Type != constants.SourceRelationshipCommentsExtended {
+			continue
+		}
+
+		part.extPath = "word/" + rel.Target
+		if strings.HasPrefix(rel.Target, "/") {
+			part.extPath = strings.TrimPrefix(rel.Target, "/")
+		}
+
+		content, ok := rd.FileMap.Load(part.extPath)
+		if !ok {
+			return
+		}
+		if err := part.parseExtended(content.([]byte)); err != nil {
+			rd.LogDebug("skipped invalid extended comments part", "path", part.extPath, "error", err)
+		}
+		return
+	}
+}
+
+// parseExtended applies the w15:commentEx elements of an extended comments part to the
+// comments.
+func (cp *commentsPart) parseExtended(content []byte) error {
+	byParaID := map[string]*Comment{}
+	for _, comment := range cp.comments {
+		if p := comment.lastParagraph(); p != nil && p.ParaID != nil {
+			byParaID[strings.ToUpper(string(*p.ParaID))] = comment
+		}
+	}
+
+	d := xml.NewDecoder(bytes.NewReader(content))
+	for {
+		token, err := d.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		elem, ok := token.(xml.StartElement)
+		if !ok || elem.Name.Local != "commentEx" {
+			continue
+		}
+
+		var paraID, parentID, done string
+		for _, attr := range elem.Attr {
+			switch attr.Name.Local {
+			case "paraId":
+				paraID = strings.ToUpper(attr.Value)
+			case "paraIdParent":
+				parentID = strings.ToUpper(attr.Value)
+			case "done":
+				done = attr.Value
+			}
+		}
+
+		comment := byParaID[paraID]
+		if comment == nil {
+			continue
+		}
+		comment.resolved = done == "1" || done == "true"
+		if parent := byParaID[parentID]; parent != nil && parent != comment {
+			comment.parent = parent
+		}
+	}
+}
+
+// assignParaIDs gives a paragraph ID to the last paragraph of every comment that has none,
+// so that the extended comments part can refer to it.
+func (cp *commentsPart) assignParaIDs() {
+	next := uint64(1)
+	for _, comment := range cp.comments {
+		for _, child := range comment.Children {
+			if child.Para == nil || child.Para.ct.ParaID == nil {
+				continue
+			}
+			if id, err := strconv.ParseUint(string(*child.Para.ct.ParaID), 16, 32); err == nil && id >= next {
+				next = id + 1
+			}
+		}
+	}
+
+	for _, comment := range cp.comments {
+		p := comment.lastParagraph()
+		if p == nil {
+			// A comment holds at least one paragraph
+			para := newParagraph(comment.root)
+			comment.Children = append(comment.Children, DocumentChild{Para: para})
+			p = &para.ct
+		}
+		if p.ParaID == nil {
+			// Word requires paragraph IDs below 0x80000000
+			p.ParaID = internal.ToPtr(stypes.LongHexNum(fmt.Sprintf("%08X", next%0x80000000)))
+			next++
+		}
+	}
+}
+
+// lastParagraph returns the last paragraph of the comment, or nil if it has none.
+func (c *Comment) lastParagraph() *ctypes.Paragraph {
+	for i := len(c.Children) - 1; i >= 0; i-- {
+		if c.Children[i].Para != nil {
+			return &c.Children[i].Para.ct
+		}
+	}
+	return nil
+}
+
+// MarshalXML implements the xml.Marshaler interface for the extended comments part. The
+// paragraph IDs are expected to be assigned with assignParaIDs.
+func (ce commentsExtended) MarshalXML(e *xml.Encoder, start xml.StartElement) (err error) {
+	start.Name.Local = "w15:commentsEx"
+	start.Attr = []xml.Attr{
+		{Name: xml.Name{Local: "xmlns:w15"}, Value: w15Namespace},
+		{Name: xml.Name{Local: "xmlns:mc"}, Value: "http://schemas.openxmlformats.org/markup-compatibility/2006"},
+		{Name: xml.Name{Local: "mc:Ignorable"}, Value: "w15"},
+	}
+
+	if err = e.EncodeToken(start); err != nil {
+		return err
+	}
+
+	for _, comment := range ce.part.comments {
+		p := comment.lastParagraph()
+		if p == nil || p.ParaID == nil {
+			continue
+		}
+
+		elem := xml.StartElement{Name: xml.Name{Local: "w15:commentEx"}, Attr: []xml.Attr{
+			{Name: xml.Name{Local: "w15:paraId"}, Value: string(*p.ParaID)},
+		}}
+		if comment.parent != nil {
+			if parent := comment.parent.lastParagraph(); parent != nil && parent.ParaID != nil {
+				elem.Attr = append(elem.Attr, xml.Attr{Name: xml.Name{Local: "w15:paraIdParent"}, Value: string(*parent.ParaID)})
+			}
+		}
+		done := "0"
+		if comment.resolved {
+			done = "1"
+		}
+		elem.Attr = append(elem.Attr, xml.Attr{Name: xml.Name{Local: "w15:done"}, Value: done})
+
+		if err = e.EncodeToken(elem); err != nil {
+			return err
+		}
+		if err = e.EncodeToken(elem.End()); err != nil {
+			return err
+		}
+	}
+
+	return e.EncodeToken(xml.EndElement{Name: start.Name})
+}
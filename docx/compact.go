@@ -0,0 +1,173 @@
+package docx
+
+import (
+	"reflect"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/MamaShip/godocx/wml/ctypes"
+)
+
+// CompactRuns merges adjacent runs that have identical run properties and contain
+// only text, in every paragraph of the document body including table cells.
+//
+// Documents edited over time often split text into many runs with the same formatting;
+// merging them keeps the XML smaller and easier to process. Runs holding anything else
+// than text (breaks, tabs, fields, drawings, ...) are never merged, and runs inside
+// hyperlinks are left untouched.
+//
+// Returns:
+//   - int: The number of merges performed.
+//
+// Example:
+//
+//	merged := document.CompactRuns()
+func (rd *RootDoc) CompactRuns() int {
+	count := 0
+	rd.walkParagraphs(func(p *ctypes.Paragraph) {
+		var merged int
+		p.Children, merged = mergeParagraphRuns(p.Children, nil)
+		count += merged
+	})
+	return count
+}
+
+// NormalizeRuns merges runs that split a word in two, in every paragraph of the document
+// body including table cells, so that searching, replacing and spell checking see whole words.
+//
+// Two adjacent runs are merged only when they have identical run properties, contain only
+// text, and the boundary between them falls inside a word. Words whose parts are formatted
+// differently stay split. Unlike CompactRuns, runs split between words are kept as they are.
+//
+// Returns:
+//   - int: The number of merges performed.
+//
+// Example:
+//
+//	document.NormalizeRuns()
+//	document.ReplaceText("hello", "goodbye")
+func (rd *RootDoc) NormalizeRuns() int {
+	count := 0
+	rd.walkParagraphs(func(p *ctypes.Paragraph) {
+		var merged int
+		p.Children, merged = mergeParagraphRuns(p.Children, splitsWord)
+		count += merged
+	})
+	return count
+}
+
+// RemoveEmptyRuns deletes runs that have no content, or only empty text, in every paragraph
+// of the document body including table cells.
+//
+// Such runs accumulate when documents are edited and can carry formatting that subtly
+// affects spacing. Runs holding anything else than text, such as breaks, tabs, fields
+// or drawings, are always kept.
+//
+// Returns:
+//   - int: The number of runs removed.
+func (rd *RootDoc) RemoveEmptyRuns() int {
+	count := 0
+	rd.walkParagraphs(func(p *ctypes.Paragraph) {
+		out := p.Children[:0]
+		for _, child := range p.Children {
+			if child.Run != nil && isEmptyRun(child.Run) {
+				count++
+				continue
+			}
+			out = append(out, child)
+		}
+		p.Children = out
+	})
+	return count
+}
+
+// isEmptyRun reports whether the run holds nothing but empty text elements.
+func isEmptyRun(r *ctypes.Run) bool {
+	for _, child := range r.Children {
+		// Only the Text field may be set on the child
+		if child != (ctypes.RunChild{Text: child.Text}) {
+			return false
+		}
+		if child.Text != nil && child.Text.Text != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// mergeParagraphRuns merges each mergeable run into the run preceding it.
+// If accept is not nil, runs are only merged when it returns true for them.
+func mergeParagraphRuns(children []ctypes.ParagraphChild, accept func(a, b *ctypes.Run) bool) ([]ctypes.ParagraphChild, int) {
+	merged := 0
+	out := children[:0]
+
+	for _, child := range children {
+		if len(out) > 0 {
+			prev := out[len(out)-1]
+			if canMergeRuns(prev.Run, child.Run) && (accept == nil || accept(prev.Run, child.Run)) {
+				mergeRuns(prev.Run, child.Run)
+				merged++
+				continue
+			}
+		}
+		out = append(out, child)
+	}
+
+	return out, merged
+}
+
+// canMergeRuns reports whether b can be appended to a without changing the rendered text.
+func canMergeRuns(a, b *ctypes.Run) bool {
+	if a == nil || b == nil || !isTextOnlyRun(a) || !isTextOnlyRun(b) {
+		return false
+	}
+
+	return reflect.DeepEqual(a.Property, b.Property)
+}
+
+// isTextOnlyRun reports whether every child of the run is a w:t element.
+func isTextOnlyRun(r *ctypes.Run) bool {
+	if len(r.Children) == 0 {
+		return false
+	}
+
+	for _, child := range r.Children {
+		// Only the Text field may be set on the child
+		if child.Text == nil || child != (ctypes.RunChild{Text: child.Text}) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// mergeRuns replaces the content of dst with a single text element holding the text of dst followed by src.
+func mergeRuns(dst, src *ctypes.Run) {
+	var sb strings.Builder
+	for _, child := range dst.Children {
+		sb.WriteString(child.Text.Text)
+	}
+	for _, child := range src.Children {
+		sb.WriteString(child.Text.Text)
+	}
+
+	text := ctypes.TextFromString(sb.String())
+	updateTextSpace(text)
+	dst.Children = []ctypes.RunChild{{Text: text}}
+}
+
+// splitsWord reports whether the boundary between the text-only runs a and b falls inside a word.
+func splitsWord(a, b *ctypes.Run) bool {
+	before := a.Children[len(a.Children)-1].Text.Text
+	after := b.Children[0].Text.Text
+
+	last, _ := utf8.DecodeLastRuneInString(before)
+	first, _ := utf8.DecodeRuneInString(after)
+
+	return isWordRune(last) && isWordRune(first)
+}
+
+func isWordRune(r rune) bool {
+	return r != utf8.RuneError && (unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r))
+}
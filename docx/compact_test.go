@@ -0,0 +1,90 @@
+package docx
+
+import (
+	"testing"
+
+	"github.com/MamaShip/godocx/internal"
+	"github.com/MamaShip/godocx/wml/stypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompactRuns_MergesIdenticalFormatting(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	p := rd.AddEmptyParagraph()
+	p.AddText("Hello").Bold(true)
+	p.AddText(", ").Bold(true)
+	p.AddText("world").Bold(true)
+
+	assert.Equal(t, 2, rd.CompactRuns())
+	assert.Len(t, p.ct.Children, 1)
+
+	run := p.ct.Children[0].Run
+	assert.Len(t, run.Children, 1)
+	assert.Equal(t, "Hello, world", run.Children[0].Text.Text)
+	assert.NotNil(t, run.Property.Bold)
+
+	assert.Equal(t, 0, rd.CompactRuns())
+}
+
+func TestCompactRuns_KeepsDifferentRuns(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	p := rd.AddEmptyParagraph()
+	p.AddText("bold").Bold(true)
+	p.AddText("plain ")
+	p.AddText("text ")
+	p.AddRun().AddBreak(internal.ToPtr(stypes.BreakTypeTextWrapping))
+	p.AddText("after")
+
+	assert.Equal(t, 1, rd.CompactRuns())
+	assert.Len(t, p.ct.Children, 4)
+	assert.Equal(t, "plain text ", p.ct.Children[1].Run.Children[0].Text.Text)
+	assert.NotNil(t, p.ct.Children[1].Run.Children[0].Text.Space, "trailing space should be preserved")
+	assert.Equal(t, "boldplain text \nafter", p.Text())
+}
+
+func TestNormalizeRuns_MergesSplitWords(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	p := rd.AddEmptyParagraph()
+	p.AddText("Say hel")
+	p.AddText("lo ")
+	p.AddText("world")
+
+	// Only the boundary inside "hello" is merged
+	assert.Equal(t, 1, rd.NormalizeRuns())
+	assert.Len(t, p.ct.Children, 2)
+	assert.Equal(t, "Say hello ", p.ct.Children[0].Run.Children[0].Text.Text)
+	assert.Equal(t, "world", p.ct.Children[1].Run.Children[0].Text.Text)
+}
+
+func TestNormalizeRuns_KeepsDifferentFormatting(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	p := rd.AddEmptyParagraph()
+	p.AddText("hel").Bold(true)
+	p.AddText("lo")
+
+	assert.Equal(t, 0, rd.NormalizeRuns())
+	assert.Len(t, p.ct.Children, 2)
+	assert.Equal(t, "hello", p.Text())
+}
+
+func TestRemoveEmptyRuns(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	p := rd.AddEmptyParagraph()
+	p.AddText("before")
+	p.AddRun().Bold(true)
+	p.AddText("")
+	p.AddRun().AddBreak(nil)
+	p.AddText("after")
+
+	assert.Equal(t, 2, rd.RemoveEmptyRuns())
+	assert.Len(t, p.ct.Children, 3)
+	assert.NotNil(t, p.ct.Children[1].Run.Children[0].Break, "run with only a break should be kept")
+	assert.Equal(t, "before\nafter", p.Text())
+
+	assert.Equal(t, 0, rd.RemoveEmptyRuns())
+}
@@ -25,7 +25,15 @@ type Override struct {
 	ContentType string `xml:"ContentType,attr"`
 }
 
+// AddExtension registers the content type of parts with the given extension.
+// Extensions that are already registered are left unchanged.
 func (c *ContentTypes) AddExtension(extension, contentType string) error {
+	for _, d := range c.Default {
+		if strings.EqualFold(d.Extension, extension) {
+			return nil
+		}
+	}
+
 	c.Default = append(c.Default, Default{
 		Extension:   extension,
 		ContentType: contentType,
@@ -34,7 +42,16 @@ func (c *ContentTypes) AddExtension(extension, contentType string) error {
 	return nil
 }
 
+// AddOverride registers the content type of a single part.
+// An existing override for the same part is replaced.
 func (c *ContentTypes) AddOverride(partName, contentType string) error {
+	for i := range c.Override {
+		if c.Override[i].PartName == partName {
+			c.Override[i].ContentType = contentType
+			return nil
+		}
+	}
+
 	c.Override = append(c.Override, Override{
 		PartName:    partName,
 		ContentType: contentType,
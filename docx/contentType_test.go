@@ -138,3 +138,19 @@ func TestAddOverride(t *testing.T) {
 		t.Errorf("AddOverride did not add correctly. Got: %+v, Expected: %+v", types.Override, expected.Override)
 	}
 }
+
+func TestAddExtensionAndOverrideDeduplicate(t *testing.T) {
+	types := ContentTypes{}
+
+	_ = types.AddExtension("png", "image/png")
+	_ = types.AddExtension("PNG", "image/png")
+	_ = types.AddOverride("/word/numbering.xml", "application/xml")
+	_ = types.AddOverride("/word/numbering.xml", "application/vnd.openxmlformats-officedocument.wordprocessingml.numbering+xml")
+
+	if len(types.Default) != 1 {
+		t.Errorf("Expected 1 default, got %d", len(types.Default))
+	}
+	if len(types.Override) != 1 || types.Override[0].ContentType != "application/vnd.openxmlformats-officedocument.wordprocessingml.numbering+xml" {
+		t.Errorf("Expected the override to be replaced, got %+v", types.Override)
+	}
+}
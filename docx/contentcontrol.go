@@ -0,0 +1,737 @@
+package docx
+
+import (
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/MamaShip/godocx/internal"
+	"github.com/MamaShip/godocx/wml/ctypes"
+	"github.com/MamaShip/godocx/wml/stypes"
+)
+
+// ContentControl is a content control (structured document tag), i.e. a field of a template
+// that is filled in Word or programmatically: plain or rich text, a check box, a list, a date
+// picker or a picture. An inline content control is part of a paragraph, while a block level
+// one holds whole paragraphs and tables of the body, and a content control of table rows
+// holds rows of a table.
+type ContentControl struct {
+	root *RootDoc
+	ct   *ctypes.SdtRun // Only the properties are used by block level content controls and those of table rows
+	hf   *headerFooter  // hf is the header or footer holding the content control, nil for the main document
+
+	block    bool
+	children []DocumentChild // Content of a block level content control
+	rows     *ctypes.SdtRow  // Content control of table rows, nil for the other ones
+}
+
+// ContentControlType is the type of a content control, see ContentControlOptions.
+type ContentControlType int
+
+const (
+	ContentControlText     ContentControlType = iota // Plain text
+	ContentControlRichText                           // Formatted text, possibly several paragraphs at block level
+	ContentControlCheckbox                           // Check box
+	ContentControlDropDown                           // Fixed list of values
+	ContentControlComboBox                           // List of values, also accepting other text
+	ContentControlDate                               // Date picker
+	ContentControlPicture                            // Picture
+)
+
+// ContentControlItem is an item of a drop-down list or combo box content control.
+type ContentControlItem struct {
+	Text  string // Text shown in the list and in the document; the value if empty
+	Value string // Value of the item
+}
+
+// ContentControlOptions are the options of a content control added with
+// Paragraph.AddContentControl or RootDoc.AddContentControl.
+type ContentControlOptions struct {
+	Type  ContentControlType
+	Tag   string // Name used to find the content control, e.g. with ContentControlsByTag
+	Alias string // Friendly name displayed by Word
+
+	// Placeholder is the text displayed until the content control is filled. Defaults to the
+	// placeholder text of Word for the type, e.g. "Choose an item." for a list.
+	Placeholder string
+
+	Lock stypes.Lock // Locking of the content control; unlocked if empty
+
+	Items      []ContentControlItem // Items of a drop-down list or combo box
+	DateFormat string               // Display format of a date picker, as in Word, e.g. "dd/MM/yyyy"; "M/d/yyyy" if empty
+	Date       time.Time            // Selected date of a date picker; the placeholder is displayed if zero
+	Checked    bool                 // State of a check box
+}
+
+const (
+	// defaultDateFormat is the display format of a date picker content control without one, as in Word.
+	defaultDateFormat = "M/d/yyyy"
+
+	// checkboxFont is the font of the symbols of a check box content control, as in Word.
+	checkboxFont = "MS Gothic"
+
+	checkedSymbol   = "\u2612" // Ballot box with X
+	uncheckedSymbol = "\u2610" // Ballot box
+)
+
+// AddTextContentControl appends a plain text content control to the paragraph, showing the
+// placeholder text until it is filled.
+//
+// Parameters:
+//   - tag: The name used to find the content control, e.g. with ContentControlsByTag.
+//   - alias: The friendly name displayed by Word, may be empty.
+//   - placeholder: The text displayed until the content control is filled.
+//
+// Returns:
+//   - *Run: The run holding the placeholder text, whose formatting is kept when the content
+//     control is filled with ContentControl.SetText.
+//
+// Example:
+//
+//	p := document.AddParagraph("Dear ")
+//	p.AddTextContentControl("customer", "Customer name", "Click to enter the name").Bold(true)
+func (p *Paragraph) AddTextContentControl(tag, alias, placeholder string) *Run {
+	control := p.AddContentControl(ContentControlOptions{Type: ContentControlText, Tag: tag, Alias: alias, Placeholder: placeholder})
+	return newRun(p.root, firstContentRun(control.ct.Content))
+}
+
+// AddContentControl appends an inline content control of the given type to the paragraph.
+//
+// Example:
+//
+//	p := document.AddParagraph("Priority: ")
+//	p.AddContentControl(docx.ContentControlOptions{
+//		Type:  docx.ContentControlDropDown,
+//		Tag:   "priority",
+//		Items: []docx.ContentControlItem{{Text: "High", Value: "1"}, {Text: "Low", Value: "2"}},
+//	})
+func (p *Paragraph) AddContentControl(opts ContentControlOptions) *ContentControl {
+	control := &ContentControl{root: p.root, hf: p.hf, ct: &ctypes.SdtRun{Property: p.root.newContentControlProperty(opts)}}
+	for _, run := range p.root.contentControlRuns(opts) {
+		control.ct.Content = append(control.ct.Content, ctypes.ParagraphChild{Run: run})
+	}
+	p.ct.Children = append(p.ct.Children, ctypes.ParagraphChild{Sdt: control.ct})
+	return control
+}
+
+// AddContentControl appends a block level content control of the given type to the body,
+// holding a paragraph with the placeholder text. The content of a rich text one can then be
+// built with ContentControl.AddParagraph.
+//
+// Example:
+//
+//	terms := document.AddContentControl(docx.ContentControlOptions{
+//		Type: docx.ContentControlRichText,
+//		Tag:  "terms",
+//		Lock: stypes.LockSdtLocked,
+//	})
+//	terms.AddParagraph("1. Payment is due within 30 days.")
+func (rd *RootDoc) AddContentControl(opts ContentControlOptions) *ContentControl {
+	p := newParagraph(rd)
+	for _, run := range rd.contentControlRuns(opts) {
+		p.ct.Children = append(p.ct.Children, ctypes.ParagraphChild{Run: run})
+	}
+
+	control := &ContentControl{
+		root:     rd,
+		ct:       &ctypes.SdtRun{Property: rd.newContentControlProperty(opts)},
+		block:    true,
+		children: []DocumentChild{{Para: p}},
+	}
+	rd.Document.Body.Children = append(rd.Document.Body.Children, DocumentChild{Sdt: control})
+	return control
+}
+
+// newContentControlProperty returns the properties of a new content control.
+func (rd *RootDoc) newContentControlProperty(opts ContentControlOptions) *ctypes.SdtProperty {
+	prop := &ctypes.SdtProperty{
+		ID:                 ctypes.NewDecimalNum(rd.nextContentControlID()),
+		ShowingPlaceholder: &ctypes.OnOff{},
+	}
+	if opts.Tag != "" {
+		prop.Tag = ctypes.NewCTString(opts.Tag)
+	}
+	if opts.Alias != "" {
+		prop.Alias = ctypes.NewCTString(opts.Alias)
+	}
+	if opts.Lock != "" {
+		prop.Lock = ctypes.NewGenSingleStrVal(opts.Lock)
+	}
+
+	switch opts.Type {
+	case ContentControlText:
+		prop.Text = &ctypes.SdtText{}
+	case ContentControlCheckbox:
+		prop.ShowingPlaceholder = nil
+		prop.Checkbox = &ctypes.SdtCheckbox{
+			Checked:        opts.Checked,
+			CheckedState:   &ctypes.SdtCheckboxState{Val: "2612", Font: checkboxFont},
+			UncheckedState: &ctypes.SdtCheckboxState{Val: "2610", Font: checkboxFont},
+		}
+	case ContentControlDropDown, ContentControlComboBox:
+		list := &ctypes.SdtList{}
+		for _, item := range opts.Items {
+			list.Items = append(list.Items, ctypes.SdtListItem{DisplayText: item.Text, Value: item.Value})
+		}
+		if opts.Type == ContentControlDropDown {
+			prop.DropDownList = list
+		} else {
+			prop.ComboBox = list
+		}
+	case ContentControlDate:
+		format := opts.DateFormat
+		if format == "" {
+			format = defaultDateFormat
+		}
+		prop.Date = &ctypes.SdtDate{
+			Format:            ctypes.NewCTString(format),
+			Lid:               ctypes.NewCTString("en-US"),
+			StoreMappedDataAs: ctypes.NewCTString("dateTime"),
+			Calendar:          ctypes.NewCTString("gregorian"),
+		}
+		if !opts.Date.IsZero() {
+			prop.Date.FullDate = internal.ToPtr(formatFullDate(opts.Date))
+			prop.ShowingPlaceholder = nil
+		}
+	case ContentControlPicture:
+		prop.Picture = &ctypes.Empty{}
+	}
+	return prop
+}
+
+// contentControlRuns returns the initial content of a new content control: the symbol of a
+// check box, the selected date of a date picker, or the placeholder text.
+func (rd *RootDoc) contentControlRuns(opts ContentControlOptions) []*ctypes.Run {
+	text := opts.Placeholder
+	switch {
+	case opts.Type == ContentControlCheckbox:
+		run := &ctypes.Run{Children: []ctypes.RunChild{{Text: ctypes.TextFromString(checkboxSymbol(opts.Checked))}}}
+		newRun(rd, run).Font(checkboxFont)
+		return []*ctypes.Run{run}
+	case opts.Type == ContentControlPicture:
+		return []*ctypes.Run{{}}
+	case opts.Type == ContentControlDate && !opts.Date.IsZero():
+		format := opts.DateFormat
+		if format == "" {
+			format = defaultDateFormat
+		}
+		text = opts.Date.Format(goDateLayout(format))
+	case text == "" && (opts.Type == ContentControlDropDown || opts.Type == ContentControlComboBox):
+		text = "Choose an item."
+	case text == "" && opts.Type == ContentControlDate:
+		text = "Click or tap to enter a date."
+	case text == "":
+		text = "Click or tap here to enter text."
+	}
+	return []*ctypes.Run{{Children: []ctypes.RunChild{{Text: ctypes.TextFromString(rd.applyRunOptions(text))}}}}
+}
+
+// ContentControls returns the content controls of the document body, including those in
+// tables and the block level ones, in document order. The content controls nested in a
+// content control follow it.
+func (rd *RootDoc) ContentControls() []*ContentControl {
+	if rd.Document == nil || rd.Document.Body == nil {
+		return nil
+	}
+	return appendBlockContentControls(nil, rd, rd.Document.Body.Children)
+}
+
+// ContentControlsByTag returns the content controls of the document body with the given tag,
+// in document order.
+//
+// Example:
+//
+//	for _, field := range document.ContentControlsByTag("customer") {
+//		field.SetText("Jane Doe")
+//	}
+func (rd *RootDoc) ContentControlsByTag(tag string) []*ContentControl {
+	var controls []*ContentControl
+	for _, control := range rd.ContentControls() {
+		if control.Tag() == tag {
+			controls = append(controls, control)
+		}
+	}
+	return controls
+}
+
+func appendBlockContentControls(controls []*ContentControl, root *RootDoc, children []DocumentChild) []*ContentControl {
+	for _, child := range children {
+		switch {
+		case child.Para != nil:
+			controls = appendContentControls(controls, root, child.Para.ct.Children)
+		case child.Table != nil:
+			controls = appendRowContentControls(controls, root, child.Table.ct.RowContents)
+		case child.Sdt != nil:
+			controls = append(controls, child.Sdt)
+			controls = appendBlockContentControls(controls, root, child.Sdt.children)
+		}
+	}
+	return controls
+}
+
+func appendRowContentControls(controls []*ContentControl, root *RootDoc, rows []ctypes.RowContent) []*ContentControl {
+	for _, rowContent := range rows {
+		if sdt := rowContent.Sdt; sdt != nil {
+			// The properties are shared with the wrapper, so that it can change them
+			if sdt.Property == nil {
+				sdt.Property = &ctypes.SdtProperty{}
+			}
+			controls = append(controls, &ContentControl{root: root, ct: &ctypes.SdtRun{Property: sdt.Property}, rows: sdt})
+			controls = appendRowContentControls(controls, root, sdt.Rows)
+		}
+		if rowContent.Row == nil {
+			continue
+		}
+
+		for _, cellContent := range rowContent.Row.Contents {
+			if cellContent.Cell == nil {
+				continue
+			}
+			for _, block := range cellContent.Cell.Contents {
+				if block.Paragraph != nil {
+					controls = appendContentControls(controls, root, block.Paragraph.Children)
+				}
+				if block.Table != nil {
+					controls = appendRowContentControls(controls, root, block.Table.RowContents)
+				}
+			}
+		}
+	}
+	return controls
+}
+
+func appendContentControls(controls []*ContentControl, root *RootDoc, children []ctypes.ParagraphChild) []*ContentControl {
+	for _, child := range children {
+		if child.Sdt != nil {
+			controls = append(controls, &ContentControl{root: root, ct: child.Sdt})
+			controls = appendContentControls(controls, root, child.Sdt.Content)
+		}
+	}
+	return controls
+}
+
+// Tag returns the tag of the content control, or an empty string if it has none.
+func (c *ContentControl) Tag() string {
+	if c.ct.Property == nil || c.ct.Property.Tag == nil {
+		return ""
+	}
+	return c.ct.Property.Tag.Val
+}
+
+// Alias returns the friendly name of the content control, or an empty string if it has none.
+func (c *ContentControl) Alias() string {
+	if c.ct.Property == nil || c.ct.Property.Alias == nil {
+		return ""
+	}
+	return c.ct.Property.Alias.Val
+}
+
+// ID returns the ID of the content control, or 0 if it has none.
+func (c *ContentControl) ID() int {
+	if c.ct.Property == nil || c.ct.Property.ID == nil {
+		return 0
+	}
+	return c.ct.Property.ID.Val
+}
+
+// ShowingPlaceholder reports whether the content control displays its placeholder text,
+// i.e. has not been filled.
+func (c *ContentControl) ShowingPlaceholder() bool {
+	return c.ct.Property != nil && isOn(c.ct.Property.ShowingPlaceholder)
+}
+
+// Text returns the text of the content control. The paragraphs of a block level content
+// control are separated by "\n", as in RootDoc.PlainText, and the rows of a content control of
+// table rows are written as in a table.
+func (c *ContentControl) Text() string {
+	if c.block {
+		return childrenText(c.children)
+	}
+	if c.rows != nil {
+		return tableText(&ctypes.Table{RowContents: c.rows.Rows})
+	}
+
+	var sb strings.Builder
+	writeParagraphChildrenText(&sb, c.ct.Content)
+	return sb.String()
+}
+
+// SetText replaces the content of the content control with the text, formatted as the first
+// run of the current content, and marks it as filled. The content control is kept, so that
+// it can be filled again. A block level content control keeps its first paragraph only.
+// Nothing is done for a content control of table rows.
+//
+// Returns:
+//   - *ContentControl: The content control, for chaining.
+func (c *ContentControl) SetText(text string) *ContentControl {
+	if c.rows != nil {
+		return c
+	}
+
+	if c.block {
+		for _, child := range c.children {
+			if child.Para != nil {
+				c.children = []DocumentChild{child}
+				break
+			}
+		}
+	}
+
+	content := c.content()
+	run := &ctypes.Run{Children: []ctypes.RunChild{{Text: ctypes.TextFromString(c.root.applyRunOptions(text))}}}
+	if first := firstContentRun(*content); first != nil && first.Property != nil {
+		run.Property = internal.DeepCopy(first.Property)
+	}
+
+	*content = []ctypes.ParagraphChild{{Run: run}}
+	if c.ct.Property != nil {
+		c.ct.Property.ShowingPlaceholder = nil
+	}
+	return c
+}
+
+// content returns the runs of the content control: its content for an inline one, the
+// children of the first paragraph for a block level one, which is added if there is none.
+func (c *ContentControl) content() *[]ctypes.ParagraphChild {
+	if !c.block {
+		return &c.ct.Content
+	}
+
+	for _, child := range c.children {
+		if child.Para != nil {
+			return &child.Para.ct.Children
+		}
+	}
+	p := newParagraph(c.root)
+	p.hf = c.hf
+	c.children = append(c.children, DocumentChild{Para: p})
+	return &p.ct.Children
+}
+
+// firstContentRun returns the first run of the content, or nil if there is none.
+func firstContentRun(children []ctypes.ParagraphChild) *ctypes.Run {
+	for _, child := range children {
+		switch {
+		case child.Run != nil:
+			return child.Run
+		case child.Link != nil && child.Link.Run != nil:
+			return child.Link.Run
+		case child.Sdt != nil:
+			if run := firstContentRun(child.Sdt.Content); run != nil {
+				return run
+			}
+		}
+	}
+	return nil
+}
+
+// nextContentControlID returns a content control ID not used anywhere else in the document.
+// On first use the IDs of existing content controls, e.g. from a loaded document, are taken
+// into account.
+func (rd *RootDoc) nextContentControlID() int {
+	if !rd.sdtIDInit {
+		rd.sdtIDInit = true
+		rd.sdtID = 1
+		for _, control := range rd.ContentControls() {
+			if id := control.ID(); id >= rd.sdtID {
+				rd.sdtID = id + 1
+			}
+		}
+	}
+
+	id := rd.sdtID
+	rd.sdtID++
+	return id
+}
+
+// Type returns the type of the content control, from its properties. A content control
+// without a type, e.g. a repeating section loaded from a document, is a rich text one.
+func (c *ContentControl) Type() ContentControlType {
+	prop := c.ct.Property
+	switch {
+	case prop == nil:
+		return ContentControlRichText
+	case prop.Text != nil:
+		return ContentControlText
+	case prop.Checkbox != nil:
+		return ContentControlCheckbox
+	case prop.DropDownList != nil:
+		return ContentControlDropDown
+	case prop.ComboBox != nil:
+		return ContentControlComboBox
+	case prop.Date != nil:
+		return ContentControlDate
+	case prop.Picture != nil:
+		return ContentControlPicture
+	}
+	return ContentControlRichText
+}
+
+// Lock returns the locking of the content control, or an empty string if it has none.
+func (c *ContentControl) Lock() stypes.Lock {
+	if c.ct.Property == nil || c.ct.Property.Lock == nil {
+		return ""
+	}
+	return c.ct.Property.Lock.Val
+}
+
+// SetLock sets the locking of the content control, e.g. stypes.LockSdtContentLocked so that
+// it can neither be deleted nor edited in Word. An empty lock removes the locking.
+//
+// Returns:
+//   - *ContentControl: The content control, for chaining.
+func (c *ContentControl) SetLock(lock stypes.Lock) *ContentControl {
+	prop := c.property()
+	prop.Lock = nil
+	if lock != "" {
+		prop.Lock = ctypes.NewGenSingleStrVal(lock)
+	}
+	return c
+}
+
+// Checked reports whether the check box content control is checked; false for the other types.
+func (c *ContentControl) Checked() bool {
+	return c.ct.Property != nil && c.ct.Property.Checkbox != nil && c.ct.Property.Checkbox.Checked
+}
+
+// SetChecked checks or clears the check box content control, updating the displayed symbol.
+// Nothing is done for the other types.
+//
+// Returns:
+//   - *ContentControl: The content control, for chaining.
+func (c *ContentControl) SetChecked(checked bool) *ContentControl {
+	if c.ct.Property == nil || c.ct.Property.Checkbox == nil {
+		return c
+	}
+
+	box := c.ct.Property.Checkbox
+	box.Checked = checked
+	state := box.UncheckedState
+	if checked {
+		state = box.CheckedState
+	}
+
+	symbol := checkboxSymbol(checked)
+	if state != nil {
+		symbol = stateSymbol(state, symbol)
+	}
+	return c.SetText(symbol)
+}
+
+// Items returns the items of the drop-down list or combo box content control, or nil for the
+// other types.
+func (c *ContentControl) Items() []ContentControlItem {
+	list := c.list()
+	if list == nil {
+		return nil
+	}
+
+	items := make([]ContentControlItem, 0, len(list.Items))
+	for _, item := range list.Items {
+		items = append(items, ContentControlItem{Text: item.DisplayText, Value: item.Value})
+	}
+	return items
+}
+
+// Select selects the item with the given value of the drop-down list or combo box content
+// control, displaying its text.
+//
+// Returns false if the content control is not a list or has no item with the value.
+//
+// Example:
+//
+//	for _, field := range document.ContentControlsByTag("priority") {
+//		field.Select("1")
+//	}
+func (c *ContentControl) Select(value string) bool {
+	list := c.list()
+	if list == nil {
+		return false
+	}
+
+	for _, item := range list.Items {
+		if item.Value != value {
+			continue
+		}
+
+		list.LastValue = internal.ToPtr(value)
+		text := item.DisplayText
+		if text == "" {
+			text = item.Value
+		}
+		c.SetText(text)
+		return true
+	}
+	return false
+}
+
+// Date returns the selected date of the date picker content control, and false if no date is
+// selected or the content control is not a date picker.
+func (c *ContentControl) Date() (time.Time, bool) {
+	if c.ct.Property == nil || c.ct.Property.Date == nil || c.ct.Property.Date.FullDate == nil {
+		return time.Time{}, false
+	}
+
+	date, err := time.Parse(time.RFC3339, *c.ct.Property.Date.FullDate)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return date, true
+}
+
+// SetDate selects the date of the date picker content control, displaying it in the format
+// of the content control. Nothing is done for the other types.
+//
+// Returns:
+//   - *ContentControl: The content control, for chaining.
+func (c *ContentControl) SetDate(date time.Time) *ContentControl {
+	if c.ct.Property == nil || c.ct.Property.Date == nil {
+		return c
+	}
+
+	prop := c.ct.Property.Date
+	prop.FullDate = internal.ToPtr(formatFullDate(date))
+	format := defaultDateFormat
+	if prop.Format != nil && prop.Format.Val != "" {
+		format = prop.Format.Val
+	}
+	return c.SetText(date.Format(goDateLayout(format)))
+}
+
+// SetImage replaces the content of the content control with the image read from r, inline
+// with the text, and marks it as filled. It is meant for picture content controls, but any
+// type is accepted.
+//
+// Returns:
+//   - *Drawing: The image, whose size can be changed.
+//   - error: An error if the image cannot be read or its format is not supported, or if the
+//     content control holds table rows; the content control is left unchanged then.
+func (c *ContentControl) SetImage(r io.Reader, format ImageFormat) (*Drawing, error) {
+	if c.rows != nil {
+		return nil, errors.New("cannot set the image of a content control of table rows")
+	}
+
+	if c.block {
+		p := newParagraph(c.root)
+		p.hf = c.hf
+		drawing, err := p.AddInlineImage(r, format)
+		if err != nil {
+			return nil, err
+		}
+		c.children = []DocumentChild{{Para: p}}
+		c.property().ShowingPlaceholder = nil
+		return drawing, nil
+	}
+
+	// The image is added to a paragraph outside of the document, and its runs moved to the content
+	tmp := newParagraph(c.root)
+	tmp.hf = c.hf
+	drawing, err := tmp.AddInlineImage(r, format)
+	if err != nil {
+		return nil, err
+	}
+	c.ct.Content = tmp.ct.Children
+	c.property().ShowingPlaceholder = nil
+	return drawing, nil
+}
+
+// property returns the properties of the content control, adding them if it has none.
+func (c *ContentControl) property() *ctypes.SdtProperty {
+	if c.ct.Property == nil {
+		c.ct.Property = &ctypes.SdtProperty{}
+	}
+	return c.ct.Property
+}
+
+// list returns the items of a drop-down list or combo box content control, or nil for the
+// other types.
+func (c *ContentControl) list() *ctypes.SdtList {
+	switch {
+	case c.ct.Property == nil:
+		return nil
+	case c.ct.Property.DropDownList != nil:
+		return c.ct.Property.DropDownList
+	case c.ct.Property.ComboBox != nil:
+		return c.ct.Property.ComboBox
+	}
+	return nil
+}
+
+// checkboxSymbol returns the symbol of a check box in the given state, as in Word.
+func checkboxSymbol(checked bool) string {
+	if checked {
+		return checkedSymbol
+	}
+	return uncheckedSymbol
+}
+
+// stateSymbol returns the symbol of a check box state, or def if its character code is invalid.
+func stateSymbol(state *ctypes.SdtCheckboxState, def string) string {
+	code, err := strconv.ParseUint(state.Val, 16, 32)
+	if err != nil || !utf8.ValidRune(rune(code)) {
+		return def
+	}
+	return string(rune(code))
+}
+
+// formatFullDate returns the date as stored in the properties of a date picker, at midnight
+// as in Word.
+func formatFullDate(date time.Time) string {
+	return date.Format("2006-01-02") + "T00:00:00Z"
+}
+
+// goDateLayout converts a date format of Word, e.g. "dddd, MMMM d, yyyy", to a layout of the
+// time package. Text between quotes is kept as is, as are the characters Word does not
+// interpret.
+func goDateLayout(format string) string {
+	tokens := map[string]string{
+		"yyyy": "2006", "yyy": "2006", "yy": "06", "y": "06",
+		"MMMM": "January", "MMM": "Jan", "MM": "01", "M": "1",
+		"dddd": "Monday", "ddd": "Mon", "dd": "02", "d": "2",
+		"HH": "15", "H": "15", "hh": "03", "h": "3",
+		"mm": "04", "m": "4", "ss": "05", "s": "5",
+	}
+
+	var sb strings.Builder
+	for i := 0; i < len(format); {
+		ch := format[i]
+		if ch == '\'' || ch == '"' {
+			end := strings.IndexByte(format[i+1:], ch)
+			if end < 0 {
+				sb.WriteString(format[i+1:])
+				break
+			}
+			sb.WriteString(format[i+1 : i+1+end])
+			i += end + 2
+			continue
+		}
+		if strings.HasPrefix(strings.ToLower(format[i:]), "am/pm") {
+			sb.WriteString("PM")
+			i += len("am/pm")
+			continue
+		}
+
+		j := i
+		for j < len(format) && format[j] == ch {
+			j++
+		}
+		if layout, ok := tokens[format[i:j]]; ok {
+			sb.WriteString(layout)
+		} else if _, ok := tokens[string(ch)]; ok {
+			// Longer runs than Word supports, e.g. "yyyyy", are written as the longest token
+			for n := 4; n > 0; n-- {
+				if layout, ok := tokens[strings.Repeat(string(ch), n)]; ok {
+					sb.WriteString(layout)
+					break
+				}
+			}
+		} else {
+			sb.WriteString(format[i:j])
+		}
+		i = j
+	}
+	return sb.String()
+}
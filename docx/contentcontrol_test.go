@@ -0,0 +1,200 @@
+package docx_test
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"strings"
+	"testing"
+	"time"
+
+	godocx "github.com/MamaShip/godocx"
+	"github.com/MamaShip/godocx/docx"
+	"github.com/MamaShip/godocx/wml/stypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContentControls(t *testing.T) {
+	rd, err := godocx.NewDocument()
+	require.NoError(t, err)
+
+	p := rd.AddParagraph("Dear ")
+	p.AddTextContentControl("customer", "Customer name", "Enter the name").Bold(true)
+	p.AddText(", your order ")
+	p.AddTextContentControl("order", "", "Order number")
+	rd.AddTable(1, 1).Cell(0, 0).AddParagraph("Signed: ").AddTextContentControl("customer", "Customer name", "Enter the name")
+
+	controls := rd.ContentControls()
+	require.Len(t, controls, 3)
+	assert.Equal(t, "Customer name", controls[0].Alias())
+	assert.Equal(t, "", controls[1].Alias())
+	assert.NotEqual(t, controls[0].ID(), controls[1].ID())
+	assert.NotEqual(t, controls[1].ID(), controls[2].ID())
+	assert.True(t, controls[0].ShowingPlaceholder())
+	assert.Equal(t, "Dear Enter the name, your order Order number", p.Text())
+
+	files, content := writeParts(t, rd)
+	document := string(files["word/document.xml"])
+	assert.Contains(t, document, `<w:sdt><w:sdtPr><w:alias w:val="Customer name"></w:alias><w:tag w:val="customer"></w:tag>`)
+	assert.Contains(t, document, `<w:showingPlcHdr></w:showingPlcHdr><w:text></w:text></w:sdtPr><w:sdtContent><w:r><w:rPr><w:b w:val="true"></w:b></w:rPr><w:t>Enter the name</w:t></w:r></w:sdtContent></w:sdt>`)
+
+	// Fill the loaded template, twice: the content controls are kept
+	for pass, name := range []string{"Jane Doe", "John Smith"} {
+		loaded, err := godocx.ReadDocx(bytes.NewReader(content), int64(len(content)))
+		require.NoError(t, err)
+
+		customers := loaded.ContentControlsByTag("customer")
+		require.Len(t, customers, 2)
+		for _, control := range customers {
+			control.SetText(name)
+			assert.False(t, control.ShowingPlaceholder())
+		}
+		assert.Equal(t, name, customers[0].Text())
+		assert.Empty(t, loaded.ContentControlsByTag("missing"))
+
+		// New content controls do not reuse the IDs of the loaded ones
+		added := loaded.AddParagraph("").AddTextContentControl("note", "", "Note")
+		require.NotNil(t, added)
+		ids := map[int]bool{}
+		for _, control := range loaded.ContentControls() {
+			assert.False(t, ids[control.ID()], "duplicate ID %d", control.ID())
+			ids[control.ID()] = true
+		}
+
+		files, content = writeParts(t, loaded)
+		document = string(files["word/document.xml"])
+		assert.Contains(t, document, `<w:r><w:rPr><w:b w:val="true"></w:b></w:rPr><w:t>`+name+`</w:t></w:r>`)
+		assert.Equal(t, 2, strings.Count(document, `<w:t>`+name+`</w:t>`))
+		assert.Equal(t, 4+pass, strings.Count(document, "<w:sdt>"))
+
+		// The order number and the notes still show their placeholder
+		assert.Equal(t, 2+pass, strings.Count(document, "<w:showingPlcHdr>"))
+	}
+}
+
+func TestContentControlIDsAfterAppend(t *testing.T) {
+	rd, err := godocx.NewDocument()
+	require.NoError(t, err)
+	rd.AddParagraph("").AddTextContentControl("a", "", "A")
+
+	other, err := godocx.NewDocument()
+	require.NoError(t, err)
+	other.AddParagraph("").AddTextContentControl("b", "", "B")
+
+	require.NoError(t, rd.AppendDocumentWith(other, docx.AppendOptions{}))
+	controls := rd.ContentControls()
+	require.Len(t, controls, 2)
+	assert.NotEqual(t, controls[0].ID(), controls[1].ID())
+	assert.Equal(t, "b", controls[1].Tag())
+}
+
+func TestAddContentControl_Types(t *testing.T) {
+	rd, err := godocx.NewDocument()
+	require.NoError(t, err)
+
+	p := rd.AddParagraph("")
+	box := p.AddContentControl(docx.ContentControlOptions{Type: docx.ContentControlCheckbox, Tag: "agree"})
+	list := p.AddContentControl(docx.ContentControlOptions{
+		Type:  docx.ContentControlDropDown,
+		Tag:   "priority",
+		Lock:  stypes.LockSdtLocked,
+		Items: []docx.ContentControlItem{{Text: "High", Value: "1"}, {Text: "Low", Value: "2"}},
+	})
+	date := p.AddContentControl(docx.ContentControlOptions{Type: docx.ContentControlDate, Tag: "due", DateFormat: "dddd d MMMM yyyy"})
+	picture := p.AddContentControl(docx.ContentControlOptions{Type: docx.ContentControlPicture, Tag: "logo"})
+	rich := p.AddContentControl(docx.ContentControlOptions{Type: docx.ContentControlRichText, Tag: "note"})
+
+	assert.Equal(t, docx.ContentControlCheckbox, box.Type())
+	assert.Equal(t, docx.ContentControlDropDown, list.Type())
+	assert.Equal(t, docx.ContentControlDate, date.Type())
+	assert.Equal(t, docx.ContentControlPicture, picture.Type())
+	assert.Equal(t, docx.ContentControlRichText, rich.Type())
+	assert.False(t, box.IsBlock())
+
+	assert.Equal(t, "\u2610", box.Text())
+	assert.Equal(t, "Choose an item.", list.Text())
+	assert.Equal(t, "Click or tap to enter a date.", date.Text())
+	assert.Equal(t, "Click or tap here to enter text.", rich.Text())
+	assert.Equal(t, stypes.LockSdtLocked, list.Lock())
+
+	box.SetChecked(true)
+	assert.True(t, box.Checked())
+	assert.Equal(t, "\u2612", box.Text())
+
+	assert.True(t, list.Select("2"))
+	assert.False(t, list.Select("3"))
+	assert.Equal(t, "Low", list.Text())
+	assert.Equal(t, []docx.ContentControlItem{{Text: "High", Value: "1"}, {Text: "Low", Value: "2"}}, list.Items())
+
+	date.SetDate(time.Date(2024, 3, 1, 15, 30, 0, 0, time.UTC))
+	assert.Equal(t, "Friday 1 March 2024", date.Text())
+	assert.False(t, date.ShowingPlaceholder())
+
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewGray(image.Rect(0, 0, 4, 4))))
+	drawing, err := picture.SetImage(&buf, docx.ImageFormatPNG)
+	require.NoError(t, err)
+	require.NotNil(t, drawing)
+	rich.SetLock(stypes.LockContentLocked).SetLock("")
+	assert.Equal(t, stypes.Lock(""), rich.Lock())
+
+	files, content := writeParts(t, rd)
+	document := string(files["word/document.xml"])
+	assert.Contains(t, document, `<w14:checkbox><w14:checked w14:val="1"></w14:checked>`)
+	assert.Contains(t, document, `<w:rFonts w:eastAsia="MS Gothic" w:ascii="MS Gothic"`)
+	assert.Contains(t, document, `<w:lock w:val="sdtLocked"></w:lock>`)
+	assert.Contains(t, document, `<w:dropDownList w:lastValue="2">`)
+	assert.Contains(t, document, `<w:date w:fullDate="2024-03-01T00:00:00Z"><w:dateFormat w:val="dddd d MMMM yyyy"></w:dateFormat>`)
+	assert.Contains(t, document, `<w:picture></w:picture></w:sdtPr><w:sdtContent><w:r><w:drawing>`)
+
+	loaded, err := godocx.ReadDocx(bytes.NewReader(content), int64(len(content)))
+	require.NoError(t, err)
+	controls := loaded.ContentControls()
+	require.Len(t, controls, 5)
+	assert.True(t, controls[0].Checked())
+	assert.Equal(t, "Low", controls[1].Text())
+	due, ok := controls[2].Date()
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), due)
+	assert.Equal(t, docx.ContentControlPicture, controls[3].Type())
+}
+
+func TestAddContentControl_Block(t *testing.T) {
+	rd, err := godocx.NewDocument()
+	require.NoError(t, err)
+
+	rd.AddParagraph("Terms")
+	terms := rd.AddContentControl(docx.ContentControlOptions{Type: docx.ContentControlRichText, Tag: "terms", Alias: "Terms"})
+	assert.True(t, terms.IsBlock())
+	assert.True(t, terms.ShowingPlaceholder())
+	assert.Equal(t, "Click or tap here to enter text.", terms.Text())
+
+	terms.AddParagraph("1. Payment is due within 30 days.")
+	terms.AddParagraph("2. Prices exclude VAT.").AddTextContentControl("vat", "", "VAT")
+	assert.False(t, terms.ShowingPlaceholder())
+	assert.Len(t, terms.Paragraphs(), 2)
+	rd.AddParagraph("End")
+
+	assert.Equal(t, "Terms\n1. Payment is due within 30 days.\n2. Prices exclude VAT.VAT\nEnd", rd.PlainText())
+	assert.Nil(t, rd.AddParagraph("").AddContentControl(docx.ContentControlOptions{}).AddParagraph("x"))
+
+	files, content := writeParts(t, rd)
+	document := string(files["word/document.xml"])
+	assert.Contains(t, document, `<w:sdt><w:sdtPr><w:alias w:val="Terms"></w:alias><w:tag w:val="terms"></w:tag>`)
+	assert.Contains(t, document, `</w:sdtPr><w:sdtContent><w:p><w:r><w:t>1. Payment is due within 30 days.</w:t></w:r></w:p>`)
+
+	loaded, err := godocx.ReadDocx(bytes.NewReader(content), int64(len(content)))
+	require.NoError(t, err)
+	controls := loaded.ContentControlsByTag("terms")
+	require.Len(t, controls, 1)
+	assert.True(t, controls[0].IsBlock())
+	assert.Equal(t, rd.PlainText(), loaded.PlainText())
+	require.Len(t, loaded.ContentControlsByTag("vat"), 1)
+
+	// Paragraphs of block level content controls are visited by the other features
+	assert.Equal(t, 2, loaded.ReplaceText("VAT", "taxes"))
+	controls[0].SetText("No terms.")
+	assert.Equal(t, "No terms.", controls[0].Text())
+	assert.Empty(t, loaded.ContentControlsByTag("vat"))
+}
@@ -0,0 +1,132 @@
+package docx
+
+import (
+	"encoding/xml"
+
+	"github.com/MamaShip/godocx/wml/ctypes"
+)
+
+// IsBlock reports whether the content control is a block level one, holding whole paragraphs
+// and tables instead of runs.
+func (c *ContentControl) IsBlock() bool {
+	return c.block
+}
+
+// IsTableRows reports whether the content control holds rows of a table, e.g. a repeating
+// section of table rows.
+func (c *ContentControl) IsTableRows() bool {
+	return c.rows != nil
+}
+
+// AddParagraph appends a paragraph with the text to the block level content control, and
+// marks it as filled: the placeholder paragraph is removed first.
+//
+// Returns:
+//   - *Paragraph: The paragraph, or nil for an inline content control.
+//
+// Example:
+//
+//	terms := document.AddContentControl(docx.ContentControlOptions{Type: docx.ContentControlRichText, Tag: "terms"})
+//	terms.AddParagraph("1. Payment is due within 30 days.")
+//	terms.AddParagraph("2. Prices exclude VAT.")
+func (c *ContentControl) AddParagraph(text string) *Paragraph {
+	if !c.block {
+		return nil
+	}
+
+	if c.ShowingPlaceholder() {
+		c.children = nil
+		c.ct.Property.ShowingPlaceholder = nil
+	}
+
+	p := newParagraph(c.root)
+	p.hf = c.hf
+	p.AddText(text)
+	c.children = append(c.children, DocumentChild{Para: p})
+	return p
+}
+
+// Paragraphs returns the paragraphs of the block level content control, not including those
+// of its tables, or nil for an inline content control.
+func (c *ContentControl) Paragraphs() []*Paragraph {
+	var paras []*Paragraph
+	for _, child := range c.children {
+		if child.Para != nil {
+			paras = append(paras, child.Para)
+		}
+	}
+	return paras
+}
+
+// marshalBlock encodes the block level content control as a w:sdt element of the body.
+func (c *ContentControl) marshalBlock(e *xml.Encoder) (err error) {
+	start := xml.StartElement{Name: xml.Name{Local: "w:sdt"}}
+	if err = e.EncodeToken(start); err != nil {
+		return err
+	}
+
+	if c.ct.Property != nil {
+		if err = c.ct.Property.MarshalXML(e, xml.StartElement{}); err != nil {
+			return err
+		}
+	}
+
+	if c.ct.EndProperty != nil {
+		if err = c.ct.EndProperty.MarshalXML(e, xml.StartElement{}); err != nil {
+			return err
+		}
+	}
+
+	content := xml.StartElement{Name: xml.Name{Local: "w:sdtContent"}}
+	if err = e.EncodeToken(content); err != nil {
+		return err
+	}
+	if err = marshalChildren(e, c.children); err != nil {
+		return err
+	}
+	if err = e.EncodeToken(content.End()); err != nil {
+		return err
+	}
+
+	return e.EncodeToken(start.End())
+}
+
+// unmarshalBlockContentControl decodes a w:sdt element of the body.
+func unmarshalBlockContentControl(root *RootDoc, d *xml.Decoder) (*ContentControl, error) {
+	control := &ContentControl{root: root, ct: &ctypes.SdtRun{}, block: true}
+
+	for {
+		currentToken, err := d.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		switch elem := currentToken.(type) {
+		case xml.StartElement:
+			switch elem.Name.Local {
+			case "sdtPr":
+				control.ct.Property = &ctypes.SdtProperty{}
+				if err = d.DecodeElement(control.ct.Property, &elem); err != nil {
+					return nil, err
+				}
+			case "sdtEndPr":
+				control.ct.EndProperty = &ctypes.RawXML{}
+				if err = d.DecodeElement(control.ct.EndProperty, &elem); err != nil {
+					return nil, err
+				}
+			case "sdtContent":
+				content := NewBody(root)
+				if err = content.UnmarshalXML(d, elem); err != nil {
+					return nil, err
+				}
+				control.children = content.Children
+			default:
+				if err = d.Skip(); err != nil {
+					return nil, err
+				}
+			}
+		case xml.EndElement:
+			return control, nil
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package docx
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/MamaShip/godocx/wml/stypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// wordContentControlsXML is a document body with content controls as saved by Word: a table
+// of contents, a text content control showing its placeholder and a content control of
+// table rows, with properties that are not modeled.
+const wordContentControlsXML = `<w:body>` +
+	`<w:sdt><w:sdtPr><w:rPr><w:b></w:b></w:rPr><w:id w:val="-1950919498"></w:id><w:docPartObj><w:docPartGallery w:val="Table of Contents"></w:docPartGallery><w:docPartUnique></w:docPartUnique></w:docPartObj></w:sdtPr>` +
+	`<w:sdtEndPr><w:rPr><w:b></w:b><w:bCs></w:bCs><w:noProof></w:noProof></w:rPr></w:sdtEndPr>` +
+	`<w:sdtContent><w:p><w:r><w:t>Contents</w:t></w:r></w:p></w:sdtContent></w:sdt>` +
+	`<w:p><w:sdt><w:sdtPr><w:alias w:val="Customer"></w:alias><w:tag w:val="customer"></w:tag><w:id w:val="12"></w:id>` +
+	`<w:placeholder><w:docPart w:val="DefaultPlaceholder_-1854013440"></w:docPart></w:placeholder><w:temporary></w:temporary><w:showingPlcHdr></w:showingPlcHdr>` +
+	`<w15:appearance w15:val="hidden"></w15:appearance><w:text></w:text></w:sdtPr><w:sdtEndPr></w:sdtEndPr>` +
+	`<w:sdtContent><w:r><w:t>Click here</w:t></w:r></w:sdtContent></w:sdt></w:p>` +
+	`<w:tbl><w:tblPr></w:tblPr><w:tblGrid><w:gridCol w:w="4000"></w:gridCol></w:tblGrid>` +
+	`<w:sdt><w:sdtPr><w:id w:val="20"></w:id><w:placeholder><w:docPart w:val="C1A2"></w:docPart></w:placeholder></w:sdtPr><w:sdtEndPr></w:sdtEndPr>` +
+	`<w:sdtContent><w:tr><w:tc><w:p><w:r><w:t>Row</w:t></w:r></w:p></w:tc></w:tr></w:sdtContent></w:sdt>` +
+	`</w:tbl>` +
+	`</w:body>`
+
+func TestContentControls_RoundTrip(t *testing.T) {
+	rd := setupRootDoc(t)
+	doc, err := LoadDocXml(rd, "word/document.xml", []byte(`<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main" xmlns:w15="http://schemas.microsoft.com/office/word/2012/wordml">`+
+		wordContentControlsXML+`</w:document>`))
+	require.NoError(t, err)
+	rd.Document = doc
+
+	output, err := xml.Marshal(rd.Document.Body)
+	require.NoError(t, err)
+	assert.Equal(t, wordContentControlsXML, string(output))
+
+	// The properties that are not modeled keep their place in the schema order
+	controls := rd.ContentControlsByTag("customer")
+	require.Len(t, controls, 1)
+	controls[0].SetLock(stypes.LockSdtLocked)
+	output, err = xml.Marshal(rd.Document.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(output),
+		`<w:id w:val="12"></w:id><w:lock w:val="sdtLocked"></w:lock><w:placeholder><w:docPart w:val="DefaultPlaceholder_-1854013440"></w:docPart></w:placeholder><w:temporary></w:temporary>`)
+}
@@ -0,0 +1,270 @@
+package docx
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MamaShip/godocx/common/constants"
+)
+
+const (
+	// customPropsPath is the part used for the custom properties when the package does not reference one.
+	customPropsPath = "docProps/custom.xml"
+
+	// customPropFmtID is the format ID of the custom properties, as written by Word.
+	customPropFmtID = "{D5CDD505-2E9C-101B-9397-08002B2CF9AE}"
+
+	// firstCustomPropID is the property ID of the first custom property; lower IDs are reserved.
+	firstCustomPropID = 2
+)
+
+// CustomProperty is a custom property of the document (docProps/custom.xml), as listed in the
+// Custom tab of the document properties in Word and usable in DOCPROPERTY fields.
+type CustomProperty struct {
+	Name string
+
+	// Value is a string, an int, a float64, a bool or a time.Time; an integer out of the range
+	// of int is an int64, or a uint64 above math.MaxInt64. When setting properties, the other
+	// integer and floating-point types are accepted too.
+	Value any
+}
+
+// ctCustomProperties is the structure used for encoding custom properties data to XML.
+type ctCustomProperties struct {
+	XMLName    xml.Name           `xml:"Properties"`
+	Xmlns      string             `xml:"xmlns,attr"`
+	Vt         string             `xml:"xmlns:vt,attr"`
+	Properties []ctCustomProperty `xml:"property"`
+}
+
+// ctCustomProperty is a custom property, holding a single typed value such as vt:lpwstr.
+type ctCustomProperty struct {
+	FmtID string `xml:"fmtid,attr"`
+	PID   int    `xml:"pid,attr"`
+	Name  string `xml:"name,attr"`
+	Value struct {
+		XMLName xml.Name
+		Text    string `xml:",chardata"`
+	} `xml:",any"`
+}
+
+// CustomProperties returns the custom properties of the document, parsed from the custom
+// properties part (docProps/custom.xml), in the order of the part. A document without the part
+// has no custom properties.
+//
+// Values of a type other than text, number, boolean or date are returned as their text.
+//
+// Example:
+//
+//	props, err := document.CustomProperties()
+//	for _, prop := range props {
+//		fmt.Println(prop.Name, prop.Value)
+//	}
+func (rd *RootDoc) CustomProperties() ([]CustomProperty, error) {
+	content, ok := rd.FileMap.Load(rd.customPropsPath())
+	if !ok {
+		return nil, nil
+	}
+
+	var src ctCustomProperties
+	err := xmlNewDecoder(bytes.NewReader(content.([]byte))).Decode(&src)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	props := make([]CustomProperty, 0, len(src.Properties))
+	for _, prop := range src.Properties {
+		value, err := parseCustomValue(prop.Value.XMLName.Local, strings.TrimSpace(prop.Value.Text))
+		if err != nil {
+			return nil, fmt.Errorf("custom property %q: %w", prop.Name, err)
+		}
+		props = append(props, CustomProperty{Name: prop.Name, Value: value})
+	}
+	return props, nil
+}
+
+// SetCustomProperties replaces the custom properties of the document (docProps/custom.xml),
+// registering the part in the content types and the package relationships if needed.
+//
+// Returns:
+//   - error: An error if a property has no name, if two properties have the same name, or if
+//     a value has an unsupported type; the document is left unchanged then.
+//
+// Example:
+//
+//	document.SetCustomProperties([]docx.CustomProperty{
+//		{Name: "Project", Value: "Apollo"},
+//		{Name: "Approved", Value: true},
+//		{Name: "Due", Value: time.Date(2024, 9, 30, 0, 0, 0, 0, time.UTC)},
+//	})
+func (rd *RootDoc) SetCustomProperties(props []CustomProperty) error {
+	custom := ctCustomProperties{
+		Xmlns: "http://schemas.openxmlformats.org/officeDocument/2006/custom-properties",
+		Vt:    "http://schemas.openxmlformats.org/officeDocument/2006/docPropsVTypes",
+	}
+
+	names := map[string]bool{}
+	for i, prop := range props {
+		if prop.Name == "" {
+			return errors.New("custom property without a name")
+		}
+		if names[strings.ToLower(prop.Name)] {
+			return fmt.Errorf("duplicate custom property %q", prop.Name)
+		}
+		names[strings.ToLower(prop.Name)] = true
+
+		vt, text, err := formatCustomValue(prop.Value)
+		if err != nil {
+			return fmt.Errorf("custom property %q: %w", prop.Name, err)
+		}
+
+		ct := ctCustomProperty{FmtID: customPropFmtID, PID: firstCustomPropID + i, Name: prop.Name}
+		ct.Value.XMLName = xml.Name{Local: "vt:" + vt}
+		ct.Value.Text = text
+		custom.Properties = append(custom.Properties, ct)
+	}
+
+	content, err := marshal(custom)
+	if err != nil {
+		return err
+	}
+
+	path := rd.customPropsPath()
+	rd.FileMap.Store(path, content)
+	_ = rd.ContentType.AddOverride("/"+path, constants.ContentTypeCustomProperties)
+	rd.RootRels.ensureRelation(constants.CUSTOM_PROP_TYPE, path)
+
+	return nil
+}
+
+// SetCustomProperty sets the value of a custom property of the document, adding the property
+// if the document has none with that name (names are case-insensitive, as in Word).
+//
+// See SetCustomProperties for the errors returned.
+//
+// Example:
+//
+//	document.SetCustomProperty("Version", 3)
+func (rd *RootDoc) SetCustomProperty(name string, value any) error {
+	props, err := rd.CustomProperties()
+	if err != nil {
+		return err
+	}
+
+	for i := range props {
+		if strings.EqualFold(props[i].Name, name) {
+			props[i].Value = value
+			return rd.SetCustomProperties(props)
+		}
+	}
+	return rd.SetCustomProperties(append(props, CustomProperty{Name: name, Value: value}))
+}
+
+// customPropsPath returns the path of the custom properties part referenced by the package relationships.
+func (rd *RootDoc) customPropsPath() string {
+	for _, rel := range rd.RootRels.Relationships {
+		if rel.Type == constants.CUSTOM_PROP_TYPE && rel.Target != "" {
+			return strings.TrimPrefix(rel.Target, "/")
+		}
+	}
+	return customPropsPath
+}
+
+// formatCustomValue returns the variant type and the text of a custom property value.
+func formatCustomValue(value any) (vt string, text string, err error) {
+	switch v := value.(type) {
+	case string:
+		return "lpwstr", v, nil
+	case bool:
+		return "bool", strconv.FormatBool(v), nil
+	case time.Time:
+		return "filetime", FormatCoreTime(v), nil
+	case int:
+		return formatCustomInt(int64(v))
+	case int8:
+		return formatCustomInt(int64(v))
+	case int16:
+		return formatCustomInt(int64(v))
+	case int32:
+		return formatCustomInt(int64(v))
+	case int64:
+		return formatCustomInt(v)
+	case uint:
+		return formatCustomUint(uint64(v))
+	case uint8:
+		return formatCustomInt(int64(v))
+	case uint16:
+		return formatCustomInt(int64(v))
+	case uint32:
+		return formatCustomInt(int64(v))
+	case uint64:
+		return formatCustomUint(v)
+	case float32:
+		return "r8", strconv.FormatFloat(float64(v), 'g', -1, 32), nil
+	case float64:
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return "", "", fmt.Errorf("unsupported number %v", v)
+		}
+		return "r8", strconv.FormatFloat(v, 'g', -1, 64), nil
+	}
+	return "", "", fmt.Errorf("unsupported value type %T", value)
+}
+
+// formatCustomInt returns the variant type and the text of an integer, a 32-bit integer as
+// Word writes numbers when it fits.
+func formatCustomInt(v int64) (string, string, error) {
+	if v >= math.MinInt32 && v <= math.MaxInt32 {
+		return "i4", strconv.FormatInt(v, 10), nil
+	}
+	return "i8", strconv.FormatInt(v, 10), nil
+}
+
+// formatCustomUint returns the variant type and the text of an unsigned integer, as a signed
+// integer when it fits.
+func formatCustomUint(v uint64) (string, string, error) {
+	if v <= math.MaxInt64 {
+		return formatCustomInt(int64(v))
+	}
+	return "ui8", strconv.FormatUint(v, 10), nil
+}
+
+// parseCustomValue returns the value of a custom property from its variant type and text.
+func parseCustomValue(vt, text string) (any, error) {
+	switch vt {
+	case "i1", "i2", "i4", "i8", "int":
+		v, err := strconv.ParseInt(text, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		if v < math.MinInt || v > math.MaxInt {
+			return v, nil
+		}
+		return int(v), nil
+	case "ui1", "ui2", "ui4", "ui8", "uint":
+		v, err := strconv.ParseUint(text, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		if v > math.MaxInt64 {
+			return v, nil
+		}
+		if v > math.MaxInt {
+			return int64(v), nil
+		}
+		return int(v), nil
+	case "r4", "r8", "decimal":
+		return strconv.ParseFloat(text, 64)
+	case "bool":
+		return text == "true" || text == "1", nil
+	case "filetime", "date":
+		return time.Parse(time.RFC3339, text)
+	}
+	return text, nil
+}
@@ -0,0 +1,487 @@
+package docx
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MamaShip/godocx/common/constants"
+	"github.com/MamaShip/godocx/wml/ctypes"
+)
+
+// ErrUnsupportedXPath is returned when binding a content control with an XPath godocx cannot
+// evaluate, see ContentControl.Bind.
+var ErrUnsupportedXPath = errors.New("unsupported XPath")
+
+// CustomXMLPart is a custom XML part of the document (customXml/itemN.xml): XML data stored
+// in the package, to which content controls can be bound so that they display its values.
+type CustomXMLPart struct {
+	root *RootDoc
+	path string // Path of the part in the package, e.g. customXml/item1.xml
+	id   string // Item ID of the part, from its properties part, e.g. {8F2D...}
+}
+
+// ctDatastoreItem is the structure used for encoding the properties part of a custom XML part.
+type ctDatastoreItem struct {
+	XMLName    xml.Name `xml:"ds:datastoreItem"`
+	ItemID     string   `xml:"ds:itemID,attr"`
+	Xmlns      string   `xml:"xmlns:ds,attr"`
+	SchemaRefs struct{} `xml:"ds:schemaRefs"`
+}
+
+// AddCustomXMLPart adds a custom XML part holding the content to the document, with a new
+// item ID, and returns it so that content controls can be bound to it.
+//
+// Returns:
+//   - error: An error if the content is not well-formed XML.
+//
+// Example:
+//
+//	data, err := document.AddCustomXMLPart([]byte(`<order xmlns="urn:orders"><customer>Jane Doe</customer></order>`))
+//	field := document.AddParagraph("Customer: ").AddContentControl(docx.ContentControlOptions{Tag: "customer"})
+//	err = field.Bind(data, "/ns0:order[1]/ns0:customer[1]", "xmlns:ns0='urn:orders'")
+func (rd *RootDoc) AddCustomXMLPart(content []byte) (*CustomXMLPart, error) {
+	if _, err := parseXMLTree(content); err != nil {
+		return nil, err
+	}
+
+	id, err := newGUID()
+	if err != nil {
+		return nil, err
+	}
+
+	n := 1
+	for {
+		if _, ok := rd.FileMap.Load(fmt.Sprintf("customXml/item%d.xml", n)); !ok {
+			break
+		}
+		n++
+	}
+	itemPath := fmt.Sprintf("customXml/item%d.xml", n)
+	propsPath := fmt.Sprintf("customXml/itemProps%d.xml", n)
+
+	props, err := marshal(ctDatastoreItem{
+		ItemID: id,
+		Xmlns:  "http://schemas.openxmlformats.org/officeDocument/2006/customXml",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	relsPath := path.Join(path.Dir(itemPath), "_rels", path.Base(itemPath)+".rels")
+	if _, err = rd.addPartRelation(relsPath, Relationship{Type: constants.SourceRelationshipCustomXMLProps, Target: path.Base(propsPath)}); err != nil {
+		return nil, err
+	}
+	rd.FileMap.Store(itemPath, content)
+	rd.FileMap.Store(propsPath, props)
+	_ = rd.ContentType.AddExtension("xml", "application/xml")
+	_ = rd.ContentType.AddOverride("/"+propsPath, constants.ContentTypeCustomXMLProperties)
+	rd.Document.addRelation(constants.SourceRelationshipCustomXML, "../"+itemPath)
+
+	return &CustomXMLPart{root: rd, path: itemPath, id: id}, nil
+}
+
+// CustomXMLParts returns the custom XML parts referenced by the document, in the order of its
+// relationships.
+func (rd *RootDoc) CustomXMLParts() []*CustomXMLPart {
+	var parts []*CustomXMLPart
+	for _, rel := range rd.Document.DocRels.Relationships {
+		if rel.Type != constants.SourceRelationshipCustomXML {
+			continue
+		}
+
+		itemPath := path.Join("word", rel.Target)
+		if strings.HasPrefix(rel.Target, "/") {
+			itemPath = strings.TrimPrefix(rel.Target, "/")
+		}
+		if _, ok := rd.FileMap.Load(itemPath); !ok {
+			continue
+		}
+		parts = append(parts, &CustomXMLPart{root: rd, path: itemPath, id: rd.customXMLItemID(itemPath)})
+	}
+	return parts
+}
+
+// CustomXMLPartByID returns the custom XML part with the given item ID, or nil if the document
+// has none. Item IDs are compared case-insensitively.
+func (rd *RootDoc) CustomXMLPartByID(id string) *CustomXMLPart {
+	for _, part := range rd.CustomXMLParts() {
+		if strings.EqualFold(part.id, id) {
+			return part
+		}
+	}
+	return nil
+}
+
+// customXMLItemID returns the item ID recorded in the properties part of the custom XML part,
+// or an empty string if it has none.
+func (rd *RootDoc) customXMLItemID(itemPath string) string {
+	relsPath := path.Join(path.Dir(itemPath), "_rels", path.Base(itemPath)+".rels")
+	content, ok := rd.FileMap.Load(relsPath)
+	if !ok {
+		return ""
+	}
+
+	var rels Relationships
+	if err := xml.Unmarshal(content.([]byte), &rels); err != nil {
+		return ""
+	}
+	for _, rel := range rels.Relationships {
+		if rel.Type != constants.SourceRelationshipCustomXMLProps {
+			continue
+		}
+
+		props, ok := rd.FileMap.Load(path.Join(path.Dir(itemPath), rel.Target))
+		if !ok {
+			return ""
+		}
+		tree, err := parseXMLTree(props.([]byte))
+		if err != nil {
+			return ""
+		}
+		for _, attr := range tree.attrs {
+			if attr.Name.Local == "itemID" {
+				return attr.Value
+			}
+		}
+	}
+	return ""
+}
+
+// ID returns the item ID of the custom XML part, e.g. {8F2D...}, by which content controls
+// refer to it; an empty string if the part has no properties part.
+func (cp *CustomXMLPart) ID() string {
+	return cp.id
+}
+
+// Content returns the XML content of the custom XML part.
+func (cp *CustomXMLPart) Content() []byte {
+	content, ok := cp.root.FileMap.Load(cp.path)
+	if !ok {
+		return nil
+	}
+	return content.([]byte)
+}
+
+// SetContent replaces the XML content of the custom XML part, and updates the content
+// controls of the document bound to it with the new values, see UpdateBoundContentControls.
+//
+// Returns:
+//   - error: An error if the content is not well-formed XML; the part is left unchanged then.
+func (cp *CustomXMLPart) SetContent(content []byte) error {
+	if _, err := parseXMLTree(content); err != nil {
+		return err
+	}
+
+	cp.root.FileMap.Store(cp.path, content)
+	for _, control := range cp.root.ContentControls() {
+		if control.boundTo(cp) {
+			control.refreshBinding(cp)
+		}
+	}
+	return nil
+}
+
+// Bind binds the content control to an element of the custom XML part, as the XML Mapping
+// pane of Word, and displays the value of the element. Word updates the content control when
+// the document is opened; godocx does when the content of the part is replaced with
+// SetContent or by UpdateBoundContentControls.
+//
+// The XPath is an absolute path of elements, each with an optional position, ending with an
+// optional attribute, e.g. "/ns0:order[1]/ns0:customer[1]/@id". Elements without a prefix match
+// any namespace.
+//
+// Parameters:
+//   - part: The custom XML part holding the data.
+//   - xpath: The path of the bound element in the part.
+//   - prefixMappings: The namespace prefixes used by the path, e.g. "xmlns:ns0='urn:orders'";
+//     may be empty.
+//
+// Returns:
+//   - error: ErrUnsupportedXPath if the path cannot be evaluated; the content control is left
+//     unchanged then.
+func (c *ContentControl) Bind(part *CustomXMLPart, xpath, prefixMappings string) error {
+	if _, err := parseXPath(xpath, parsePrefixMappings(prefixMappings)); err != nil {
+		return err
+	}
+
+	c.property().DataBinding = &ctypes.SdtDataBinding{
+		PrefixMappings: prefixMappings,
+		XPath:          xpath,
+		StoreItemID:    part.ID(),
+	}
+	c.refreshBinding(part)
+	return nil
+}
+
+// XPath returns the XPath of the custom XML element the content control is bound to, or an
+// empty string if it is not bound.
+func (c *ContentControl) XPath() string {
+	if c.ct.Property == nil || c.ct.Property.DataBinding == nil {
+		return ""
+	}
+	return c.ct.Property.DataBinding.XPath
+}
+
+// UpdateBoundContentControls displays in the content controls of the document bound to a
+// custom XML part the current values of their elements, as Word does when opening the
+// document, and returns the number of content controls updated. Content controls bound to a
+// missing element or with an unsupported XPath are left unchanged.
+func (rd *RootDoc) UpdateBoundContentControls() int {
+	parts := rd.CustomXMLParts()
+
+	count := 0
+	for _, control := range rd.ContentControls() {
+		for _, part := range parts {
+			if control.boundTo(part) {
+				if control.refreshBinding(part) {
+					count++
+				}
+				break
+			}
+		}
+	}
+	return count
+}
+
+// boundTo reports whether the content control is bound to the custom XML part. A binding
+// without an item ID refers to the first part.
+func (c *ContentControl) boundTo(part *CustomXMLPart) bool {
+	if c.ct.Property == nil || c.ct.Property.DataBinding == nil {
+		return false
+	}
+
+	id := c.ct.Property.DataBinding.StoreItemID
+	if id == "" {
+		parts := part.root.CustomXMLParts()
+		return len(parts) > 0 && parts[0].path == part.path
+	}
+	return strings.EqualFold(id, part.id)
+}
+
+// refreshBinding displays the value of the bound element of the part in the content control,
+// and reports whether the element was found.
+func (c *ContentControl) refreshBinding(part *CustomXMLPart) bool {
+	binding := c.ct.Property.DataBinding
+	steps, err := parseXPath(binding.XPath, parsePrefixMappings(binding.PrefixMappings))
+	if err != nil {
+		return false
+	}
+	tree, err := parseXMLTree(part.Content())
+	if err != nil {
+		return false
+	}
+	value, ok := tree.evaluate(steps)
+	if !ok {
+		return false
+	}
+	return c.setValue(value)
+}
+
+// setValue displays the value in the content control according to its type: a check box is
+// checked by "true" or "1", a date picker takes an ISO 8601 date, a list selects the item with
+// the value and a picture content control takes a base64 encoded image. It reports whether
+// the value was set.
+func (c *ContentControl) setValue(value string) bool {
+	switch c.Type() {
+	case ContentControlCheckbox:
+		c.SetChecked(value == "true" || value == "1")
+	case ContentControlDate:
+		for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02"} {
+			if date, err := time.Parse(layout, strings.TrimSpace(value)); err == nil {
+				c.SetDate(date)
+				return true
+			}
+		}
+		c.SetText(value)
+	case ContentControlDropDown, ContentControlComboBox:
+		if !c.Select(value) {
+			c.SetText(value)
+		}
+	case ContentControlPicture:
+		// Pictures are bound to base64 encoded images
+		img, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(value), ""))
+		if err != nil {
+			return false
+		}
+		format, err := detectImageFormat(img)
+		if err != nil {
+			return false
+		}
+		if _, err = c.SetImage(bytes.NewReader(img), format); err != nil {
+			return false
+		}
+	default:
+		c.SetText(value)
+	}
+	return true
+}
+
+// xmlNode is an element of a custom XML part, as needed to evaluate XPaths.
+type xmlNode struct {
+	name     xml.Name
+	attrs    []xml.Attr
+	children []*xmlNode
+	text     strings.Builder // Text of the element and its descendants
+}
+
+// parseXMLTree returns the root element of the XML content.
+func parseXMLTree(content []byte) (*xmlNode, error) {
+	d := xml.NewDecoder(bytes.NewReader(content))
+
+	var root *xmlNode
+	var stack []*xmlNode
+	for {
+		token, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			node := &xmlNode{name: t.Name, attrs: t.Attr}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, node)
+			} else if root == nil {
+				root = node
+			}
+			stack = append(stack, node)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			for _, node := range stack {
+				node.text.Write(t)
+			}
+		}
+	}
+
+	if root == nil {
+		return nil, errors.New("custom XML part without a root element")
+	}
+	return root, nil
+}
+
+// xpathStep is a step of an XPath: an element, at a position among the elements of the same
+// name if set, or an attribute.
+type xpathStep struct {
+	space, local string // Namespace is empty to match any namespace
+	position     int    // 1-based, 0 for the first element
+	attribute    bool
+}
+
+// parsePrefixMappings returns the namespaces of the prefixes declared in the prefix
+// mappings of a data binding, e.g. "xmlns:ns0='urn:orders'".
+func parsePrefixMappings(mappings string) map[string]string {
+	namespaces := map[string]string{}
+	for _, decl := range strings.Fields(mappings) {
+		name, value, ok := strings.Cut(decl, "=")
+		if !ok || !strings.HasPrefix(name, "xmlns:") {
+			continue
+		}
+		namespaces[strings.TrimPrefix(name, "xmlns:")] = strings.Trim(value, `'"`)
+	}
+	return namespaces
+}
+
+// parseXPath returns the steps of an absolute XPath made of element names with optional
+// positions and an optional final attribute.
+func parseXPath(xpath string, namespaces map[string]string) ([]xpathStep, error) {
+	if !strings.HasPrefix(xpath, "/") || strings.HasPrefix(xpath, "//") {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedXPath, xpath)
+	}
+
+	parts := strings.Split(xpath[1:], "/")
+	steps := make([]xpathStep, 0, len(parts))
+	for i, part := range parts {
+		var step xpathStep
+		if strings.HasPrefix(part, "@") {
+			if i != len(parts)-1 {
+				return nil, fmt.Errorf("%w: %q", ErrUnsupportedXPath, xpath)
+			}
+			step.attribute = true
+			part = part[1:]
+		}
+
+		if name, pos, ok := strings.Cut(part, "["); ok && !step.attribute {
+			n, err := strconv.Atoi(strings.TrimSuffix(pos, "]"))
+			if err != nil || !strings.HasSuffix(pos, "]") || n < 1 {
+				return nil, fmt.Errorf("%w: %q", ErrUnsupportedXPath, xpath)
+			}
+			part, step.position = name, n
+		}
+
+		if prefix, local, ok := strings.Cut(part, ":"); ok {
+			space, declared := namespaces[prefix]
+			if !declared {
+				return nil, fmt.Errorf("%w: undeclared prefix %q in %q", ErrUnsupportedXPath, prefix, xpath)
+			}
+			step.space, part = space, local
+		}
+
+		if part == "" || strings.ContainsAny(part, "[]()*=|") {
+			return nil, fmt.Errorf("%w: %q", ErrUnsupportedXPath, xpath)
+		}
+		step.local = part
+		steps = append(steps, step)
+	}
+	return steps, nil
+}
+
+// evaluate returns the text of the element or the value of the attribute selected by the
+// steps, starting at the root element, and whether it was found.
+func (n *xmlNode) evaluate(steps []xpathStep) (string, bool) {
+	if len(steps) == 0 || steps[0].attribute || !steps[0].matches(n.name) || steps[0].position > 1 {
+		return "", false
+	}
+
+	node := n
+	for _, step := range steps[1:] {
+		if step.attribute {
+			for _, attr := range node.attrs {
+				if step.matches(attr.Name) {
+					return attr.Value, true
+				}
+			}
+			return "", false
+		}
+
+		position := step.position
+		if position == 0 {
+			position = 1
+		}
+
+		var next *xmlNode
+		seen := 0
+		for _, child := range node.children {
+			if !step.matches(child.name) {
+				continue
+			}
+			seen++
+			if seen == position {
+				next = child
+				break
+			}
+		}
+		if next == nil {
+			return "", false
+		}
+		node = next
+	}
+	return node.text.String(), true
+}
+
+// matches reports whether the step selects the element or attribute name.
+func (s xpathStep) matches(name xml.Name) bool {
+	return name.Local == s.local && (s.space == "" || name.Space == s.space)
+}
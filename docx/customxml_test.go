@@ -0,0 +1,77 @@
+package docx_test
+
+import (
+	"bytes"
+	"testing"
+
+	godocx "github.com/MamaShip/godocx"
+	"github.com/MamaShip/godocx/docx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomXMLPart_Bind(t *testing.T) {
+	rd, err := godocx.NewDocument()
+	require.NoError(t, err)
+
+	data, err := rd.AddCustomXMLPart([]byte(`<order xmlns="urn:orders" id="42"><customer>Jane Doe</customer><priority>2</priority><paid>true</paid></order>`))
+	require.NoError(t, err)
+	assert.Regexp(t, `^\{[0-9A-F]{8}-[0-9A-F]{4}-4[0-9A-F]{3}-[89AB][0-9A-F]{3}-[0-9A-F]{12}\}$`, data.ID())
+
+	p := rd.AddParagraph("Order ")
+	number := p.AddContentControl(docx.ContentControlOptions{Tag: "number"})
+	customer := p.AddContentControl(docx.ContentControlOptions{Tag: "customer"})
+	priority := p.AddContentControl(docx.ContentControlOptions{
+		Type:  docx.ContentControlDropDown,
+		Items: []docx.ContentControlItem{{Text: "High", Value: "1"}, {Text: "Low", Value: "2"}},
+	})
+	paid := p.AddContentControl(docx.ContentControlOptions{Type: docx.ContentControlCheckbox})
+
+	const ns = "xmlns:ns0='urn:orders'"
+	require.NoError(t, number.Bind(data, "/ns0:order[1]/@id", ns))
+	require.NoError(t, customer.Bind(data, "/ns0:order[1]/ns0:customer[1]", ns))
+	require.NoError(t, priority.Bind(data, "/order/priority", ""))
+	require.NoError(t, paid.Bind(data, "/ns0:order[1]/ns0:paid[1]", ns))
+	assert.Equal(t, "42", number.Text())
+	assert.Equal(t, "Jane Doe", customer.Text())
+	assert.Equal(t, "Low", priority.Text())
+	assert.True(t, paid.Checked())
+	assert.Equal(t, "/ns0:order[1]/ns0:customer[1]", customer.XPath())
+
+	assert.ErrorIs(t, customer.Bind(data, "//customer", ""), docx.ErrUnsupportedXPath)
+	assert.ErrorIs(t, customer.Bind(data, "/ns1:order", ns), docx.ErrUnsupportedXPath)
+	_, err = rd.AddCustomXMLPart([]byte(`<order>`))
+	assert.Error(t, err)
+
+	// The default template holds the bibliography sources in the first custom XML part
+	files, content := writeParts(t, rd)
+	assert.Equal(t, `<order xmlns="urn:orders" id="42"><customer>Jane Doe</customer><priority>2</priority><paid>true</paid></order>`, string(files["customXml/item2.xml"]))
+	assert.Contains(t, string(files["customXml/itemProps2.xml"]), `ds:itemID="`+data.ID()+`"`)
+	assert.Contains(t, string(files["customXml/_rels/item2.xml.rels"]), `Target="itemProps2.xml"`)
+	assert.Contains(t, string(files["word/_rels/document.xml.rels"]), `Target="../customXml/item2.xml"`)
+	assert.Contains(t, string(files["[Content_Types].xml"]), `PartName="/customXml/itemProps2.xml"`)
+	assert.Contains(t, string(files["word/document.xml"]),
+		`<w:dataBinding w:prefixMappings="xmlns:ns0=&#39;urn:orders&#39;" w:xpath="/ns0:order[1]/ns0:customer[1]" w:storeItemID="`+data.ID()+`"></w:dataBinding>`)
+
+	// The bound content controls of the loaded document follow the changes of the data
+	loaded, err := godocx.ReadDocx(bytes.NewReader(content), int64(len(content)))
+	require.NoError(t, err)
+	require.Len(t, loaded.CustomXMLParts(), 2)
+	part := loaded.CustomXMLPartByID(data.ID())
+	require.NotNil(t, part)
+	assert.Equal(t, data.ID(), part.ID())
+	assert.Equal(t, data.Content(), part.Content())
+
+	require.NoError(t, part.SetContent([]byte(`<order xmlns="urn:orders" id="43"><customer>John Smith</customer><priority>1</priority><paid>0</paid></order>`)))
+	controls := loaded.ContentControls()
+	require.Len(t, controls, 4)
+	assert.Equal(t, "Order 43John SmithHigh☐", loaded.PlainText())
+	assert.Error(t, part.SetContent([]byte(`not xml`)))
+
+	// Other parts are left alone, and missing elements leave the content controls unchanged
+	other, err := loaded.AddCustomXMLPart([]byte(`<order xmlns="urn:orders"><customer>Other</customer></order>`))
+	require.NoError(t, err)
+	require.NoError(t, other.SetContent([]byte(`<order xmlns="urn:orders"/>`)))
+	assert.Equal(t, 4, loaded.UpdateBoundContentControls())
+	assert.Equal(t, "John Smith", controls[1].Text())
+}
@@ -32,6 +32,29 @@ func TestDeterministicWrite_DifferentInstances(t *testing.T) {
 	require.Equal(t, b1.Bytes(), b2.Bytes())
 }
 
+// Ensures that WriteTo reports the bytes written and that the output can be read back
+// from memory.
+func TestWriteTo_ReadDocx(t *testing.T) {
+	rd := buildElaborateDoc(t)
+
+	var b1, b2 bytes.Buffer
+	n, err := rd.WriteTo(&b1)
+	require.NoError(t, err)
+	require.Equal(t, int64(b1.Len()), n)
+
+	n, err = rd.WriteTo(&b2)
+	require.NoError(t, err)
+	require.Equal(t, int64(b2.Len()), n)
+	require.Equal(t, b1.Bytes(), b2.Bytes())
+
+	loaded, err := godocx.ReadDocx(bytes.NewReader(b1.Bytes()), int64(b1.Len()))
+	require.NoError(t, err)
+	require.Equal(t, rd.PlainText(), loaded.PlainText())
+
+	_, err = godocx.ReadDocx(bytes.NewReader([]byte("not a docx")), 10)
+	require.Error(t, err)
+}
+
 // buildElaborateDoc creates a document that exercises multiple features
 // (headings, styled runs, ordered/bulleted lists with nesting, and a table)
 // to verify byte-for-byte determinism of the writer.
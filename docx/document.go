@@ -2,8 +2,10 @@ package docx
 
 import (
 	"encoding/xml"
+	"math"
 
 	"github.com/MamaShip/godocx/internal"
+	"github.com/MamaShip/godocx/wml/ctypes"
 	"github.com/MamaShip/godocx/wml/stypes"
 )
 
@@ -96,6 +98,7 @@ func (d *Document) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) (e
 				}
 				d.Background = bg
 			default:
+				d.Root.LogDebug("skipped unknown element", "element", elem.Name.Local, "parent", "document")
 				if err = decoder.Skip(); err != nil {
 					return err
 				}
@@ -123,6 +126,19 @@ func (rd *RootDoc) AddPageBreak() *Paragraph {
 	return p
 }
 
+// AddColumnBreak adds a column break to the document by inserting a paragraph containing
+// only a column break, so that the following content starts at the top of the next column of
+// a section laid out in columns (see SectionProperties.SetColumns).
+//
+// Returns:
+//   - *Paragraph: A pointer to the newly created Paragraph object containing the column break.
+func (rd *RootDoc) AddColumnBreak() *Paragraph {
+	p := rd.AddEmptyParagraph()
+	p.AddRun().AddColumnBreak()
+
+	return p
+}
+
 // AddHorizontalLine adds a simple horizontal line (divider) to the document.
 //
 // This creates an empty paragraph with a bottom border styled as a single line.
@@ -137,14 +153,7 @@ func (rd *RootDoc) AddPageBreak() *Paragraph {
 //	document := godocx.NewDocument()
 //	document.AddHorizontalLine()
 func (rd *RootDoc) AddHorizontalLine() *Paragraph {
-	p := rd.AddEmptyParagraph()
-	p.BottomBorder(stypes.BorderStyleSingle, 6, "auto")
-
-	// Set tight spacing to avoid empty line effect
-	p.Spacing(0, 0)
-	p.LineSpacing(20, stypes.LineSpacingRuleExact) // 1pt exact line height
-
-	return p
+	return rd.addHorizontalLine(stypes.BorderStyleSingle, 6, "auto", false)
 }
 
 // AddDoubleHorizontalLine adds a double horizontal line (divider) to the document.
@@ -160,14 +169,7 @@ func (rd *RootDoc) AddHorizontalLine() *Paragraph {
 //	document := godocx.NewDocument()
 //	document.AddDoubleHorizontalLine()
 func (rd *RootDoc) AddDoubleHorizontalLine() *Paragraph {
-	p := rd.AddEmptyParagraph()
-	p.BottomBorder(stypes.BorderStyleDouble, 6, "auto")
-
-	// Set tight spacing to avoid empty line effect
-	p.Spacing(0, 0)
-	p.LineSpacing(20, stypes.LineSpacingRuleExact) // 1pt exact line height
-
-	return p
+	return rd.addHorizontalLine(stypes.BorderStyleDouble, 6, "auto", false)
 }
 
 // AddThickHorizontalLine adds a thick horizontal line (divider) to the document.
@@ -183,14 +185,7 @@ func (rd *RootDoc) AddDoubleHorizontalLine() *Paragraph {
 //	document := godocx.NewDocument()
 //	document.AddThickHorizontalLine()
 func (rd *RootDoc) AddThickHorizontalLine() *Paragraph {
-	p := rd.AddEmptyParagraph()
-	p.BottomBorder(stypes.BorderStyleThick, 12, "auto")
-
-	// Set tight spacing to avoid empty line effect
-	p.Spacing(0, 0)
-	p.LineSpacing(20, stypes.LineSpacingRuleExact) // 1pt exact line height
-
-	return p
+	return rd.addHorizontalLine(stypes.BorderStyleThick, 12, "auto", false)
 }
 
 // AddDashedHorizontalLine adds a dashed horizontal line (divider) to the document.
@@ -206,14 +201,7 @@ func (rd *RootDoc) AddThickHorizontalLine() *Paragraph {
 //	document := godocx.NewDocument()
 //	document.AddDashedHorizontalLine()
 func (rd *RootDoc) AddDashedHorizontalLine() *Paragraph {
-	p := rd.AddEmptyParagraph()
-	p.BottomBorder(stypes.BorderStyleDashed, 6, "auto")
-
-	// Set tight spacing to avoid empty line effect
-	p.Spacing(0, 0)
-	p.LineSpacing(20, stypes.LineSpacingRuleExact) // 1pt exact line height
-
-	return p
+	return rd.addHorizontalLine(stypes.BorderStyleDashed, 6, "auto", false)
 }
 
 // AddCustomHorizontalLine adds a custom horizontal line (divider) to the document with specified properties.
@@ -223,7 +211,7 @@ func (rd *RootDoc) AddDashedHorizontalLine() *Paragraph {
 //
 // Parameters:
 //   - style: The border style from stypes.BorderStyle (e.g., BorderStyleSingle, BorderStyleDouble, BorderStyleWave).
-//   - size: The border width in eighths of a point (e.g., 6 = 0.75pt, 12 = 1.5pt, 24 = 3pt).
+//   - size: The border width in eighths of a point (e.g., 6 = 0.75pt, 12 = 1.5pt, 24 = 3pt), see BorderSizeFromPoints.
 //   - color: The border color in hex format (e.g., "FF0000" for red, "0000FF" for blue) or "auto" for automatic color.
 //
 // Returns:
@@ -235,12 +223,239 @@ func (rd *RootDoc) AddDashedHorizontalLine() *Paragraph {
 //	// Add a red wavy line at 1.5pt thickness
 //	document.AddCustomHorizontalLine(stypes.BorderStyleWave, 12, "FF0000")
 func (rd *RootDoc) AddCustomHorizontalLine(style stypes.BorderStyle, size int, color string) *Paragraph {
+	return rd.addHorizontalLine(style, size, color, false)
+}
+
+// AddHorizontalLineAbove adds a simple horizontal line drawn above the following content.
+//
+// Unlike AddHorizontalLine, which uses a bottom border, this creates an empty paragraph
+// with a top border, so the rule sits on top of the paragraph box. It uses the same
+// tight spacing so that no visible empty line is created.
+//
+// Returns:
+//   - *Paragraph: A pointer to the newly created Paragraph object with a horizontal line.
+//
+// Example:
+//
+//	document := godocx.NewDocument()
+//	document.AddHorizontalLineAbove()
+func (rd *RootDoc) AddHorizontalLineAbove() *Paragraph {
+	return rd.AddCustomHorizontalLineAbove(stypes.BorderStyleSingle, 6, "auto")
+}
+
+// AddDoubleHorizontalLineAbove adds a double horizontal line drawn above the following content.
+//
+// Returns:
+//   - *Paragraph: A pointer to the newly created Paragraph object with a double horizontal line.
+func (rd *RootDoc) AddDoubleHorizontalLineAbove() *Paragraph {
+	return rd.AddCustomHorizontalLineAbove(stypes.BorderStyleDouble, 6, "auto")
+}
+
+// AddThickHorizontalLineAbove adds a thick horizontal line drawn above the following content.
+//
+// Returns:
+//   - *Paragraph: A pointer to the newly created Paragraph object with a thick horizontal line.
+func (rd *RootDoc) AddThickHorizontalLineAbove() *Paragraph {
+	return rd.AddCustomHorizontalLineAbove(stypes.BorderStyleThick, 12, "auto")
+}
+
+// AddDashedHorizontalLineAbove adds a dashed horizontal line drawn above the following content.
+//
+// Returns:
+//   - *Paragraph: A pointer to the newly created Paragraph object with a dashed horizontal line.
+func (rd *RootDoc) AddDashedHorizontalLineAbove() *Paragraph {
+	return rd.AddCustomHorizontalLineAbove(stypes.BorderStyleDashed, 6, "auto")
+}
+
+// AddCustomHorizontalLineAbove adds a custom horizontal line drawn above the following content.
+//
+// Parameters:
+//   - style: The border style from stypes.BorderStyle (e.g., BorderStyleSingle, BorderStyleDouble, BorderStyleWave).
+//   - size: The border width in eighths of a point (e.g., 6 = 0.75pt, 12 = 1.5pt, 24 = 3pt), see BorderSizeFromPoints.
+//   - color: The border color in hex format (e.g., "FF0000" for red, "0000FF" for blue) or "auto" for automatic color.
+//
+// Returns:
+//   - *Paragraph: A pointer to the newly created Paragraph object with a custom horizontal line.
+func (rd *RootDoc) AddCustomHorizontalLineAbove(style stypes.BorderStyle, size int, color string) *Paragraph {
+	return rd.addHorizontalLine(style, size, color, true)
+}
+
+// HLineOptions describes a horizontal line added with AddHorizontalLineWith.
+// The zero value gives the line of AddHorizontalLine.
+type HLineOptions struct {
+	Style stypes.BorderStyle // Border style; empty for a single line
+	Size  int                // Border width in eighths of a point (see BorderSizeFromPoints); 0 for 6 (0.75pt)
+	Color string             // Border color in hex format, or "auto"; empty for "auto"
+	Above bool               // Draw the line as a top border rather than a bottom border
+
+	Before uint64 // Spacing above the line, in twips
+	After  uint64 // Spacing below the line, in twips
+
+	// AutoLineHeight keeps the line height of the paragraph style instead of the 1pt exact
+	// line height, so the paragraph takes a full empty line.
+	AutoLineHeight bool
+}
+
+// BorderSizeToPoints returns the width in points of a border size expressed in eighths of
+// a point, the unit of the size parameter of the border methods (e.g. 6 = 0.75pt).
+func BorderSizeToPoints(size int) float64 {
+	return float64(size) / 8
+}
+
+// BorderSizeFromPoints returns the border size, in eighths of a point, of a width in points,
+// rounded to the nearest unit (e.g. 1.5pt = 12). Word accepts line borders from 2 (0.25pt)
+// to 96 (12pt).
+func BorderSizeFromPoints(points float64) int {
+	return int(math.Round(points * 8))
+}
+
+// AddHorizontalLineWith adds a horizontal line (divider) to the document with the given options.
+//
+// By default the line has the tight spacing of AddHorizontalLine: no spacing before and after
+// and a 1pt exact line height, so that no visible empty line is created.
+//
+// Returns:
+//   - *Paragraph: A pointer to the newly created Paragraph object with a horizontal line.
+//
+// Example:
+//
+//	document := godocx.NewDocument()
+//	// A 1.5pt gray divider with 12pt of space above and below
+//	document.AddHorizontalLineWith(docx.HLineOptions{
+//		Size:   docx.BorderSizeFromPoints(1.5),
+//		Color:  "808080",
+//		Before: 240,
+//		After:  240,
+//	})
+func (rd *RootDoc) AddHorizontalLineWith(opts HLineOptions) *Paragraph {
+	if opts.Style == "" {
+		opts.Style = stypes.BorderStyleSingle
+	}
+	if opts.Size == 0 {
+		opts.Size = 6
+	}
+	if opts.Color == "" {
+		opts.Color = "auto"
+	}
+
 	p := rd.AddEmptyParagraph()
-	p.BottomBorder(style, size, color)
 
-	// Set tight spacing to avoid empty line effect
+	hl := &horizontalLineProp{
+		size:     opts.Size,
+		space:    "1",
+		color:    opts.Color,
+		before:   opts.Before,
+		after:    opts.After,
+		line:     20,
+		lineRule: stypes.LineSpacingRuleExact,
+	}
+	hl.edge = ctypes.Border{Val: opts.Style, Size: &hl.size, Space: &hl.space, Color: &hl.color}
+	if opts.Above {
+		hl.border.Top = &hl.edge
+	} else {
+		hl.border.Bottom = &hl.edge
+	}
+	hl.spacing = ctypes.Spacing{Before: &hl.before, After: &hl.after}
+	if !opts.AutoLineHeight {
+		hl.spacing.Line = &hl.line
+		hl.spacing.LineRule = &hl.lineRule
+	}
+	hl.prop.Border = &hl.border
+	hl.prop.Spacing = &hl.spacing
+
+	p.ct.Property = &hl.prop
+	return p
+}
+
+// horizontalLineProp holds the properties of a horizontal line paragraph together with
+// the values they point to, so that they are allocated at once for each line.
+type horizontalLineProp struct {
+	prop    ctypes.ParagraphProp
+	border  ctypes.ParaBorder
+	edge    ctypes.Border
+	spacing ctypes.Spacing
+
+	size     int
+	space    string
+	color    string
+	before   uint64
+	after    uint64
+	line     int
+	lineRule stypes.LineSpacingRule
+}
+
+// addHorizontalLine adds an empty paragraph with a bottom border, or a top border if above
+// is set, and tight spacing to avoid an empty line effect (no spacing before and after,
+// 1pt exact line height).
+//
+// The result is the same as calling BottomBorder or TopBorder, Spacing(0, 0) and
+// LineSpacing(20, stypes.LineSpacingRuleExact) on an empty paragraph.
+func (rd *RootDoc) addHorizontalLine(style stypes.BorderStyle, size int, color string, above bool) *Paragraph {
+	return rd.AddHorizontalLineWith(HLineOptions{Style: style, Size: size, Color: color, Above: above})
+}
+
+// AddBoxedSeparator adds a section divider with a rule above and below an optional label.
+//
+// This creates a paragraph with both a top and a bottom border. The label, if any,
+// is centered between the two rules. Spacing before and after the paragraph is removed;
+// without a label the paragraph also uses the 1pt exact line height of the other
+// horizontal lines, so the two rules sit right next to each other.
+//
+// Parameters:
+//   - text: The centered label, or an empty string for no label.
+//
+// Returns:
+//   - *Paragraph: A pointer to the newly created Paragraph object.
+//
+// Example:
+//
+//	document := godocx.NewDocument()
+//	document.AddBoxedSeparator("Part II")
+func (rd *RootDoc) AddBoxedSeparator(text string) *Paragraph {
+	p := rd.AddEmptyParagraph()
+	p.TopBorder(stypes.BorderStyleSingle, 6, "auto")
+	p.BottomBorder(stypes.BorderStyleSingle, 6, "auto")
+	p.Justification(stypes.JustificationCenter)
+
 	p.Spacing(0, 0)
-	p.LineSpacing(20, stypes.LineSpacingRuleExact) // 1pt exact line height
+	if text == "" {
+		p.LineSpacing(20, stypes.LineSpacingRuleExact) // 1pt exact line height
+	} else {
+		p.AddText(text)
+	}
+
+	return p
+}
+
+// AddPartialHorizontalLine adds a centered horizontal line spanning a percentage of the content width.
+//
+// The percentage is resolved against the content width of the active section
+// (see ContentWidth), so a 50% line is exactly half the usable width regardless of
+// page size and margins. The line is shortened by indenting the paragraph equally
+// on both sides.
+//
+// Parameters:
+//   - percent: The width of the line as a percentage (0-100) of the content width.
+//
+// Returns:
+//   - *Paragraph: A pointer to the newly created Paragraph object with a horizontal line.
+//
+// Example:
+//
+//	document := godocx.NewDocument()
+//	document.AddPartialHorizontalLine(50)
+func (rd *RootDoc) AddPartialHorizontalLine(percent float64) *Paragraph {
+	if percent < 0 {
+		percent = 0
+	}
+	if percent > 100 {
+		percent = 100
+	}
+
+	p := rd.AddHorizontalLine()
+
+	side := int(float64(rd.ContentWidth()) * (100 - percent) / 200)
+	p.Indent(&ctypes.Indent{Left: internal.ToPtr(side), Right: internal.ToPtr(side)})
 
 	return p
 }
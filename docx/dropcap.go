@@ -0,0 +1,219 @@
+package docx
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/MamaShip/godocx/internal"
+	"github.com/MamaShip/godocx/wml/ctypes"
+	"github.com/MamaShip/godocx/wml/stypes"
+)
+
+const (
+	// maxDropCapLines is the largest height of a drop cap in lines allowed by Word.
+	maxDropCapLines = 10
+
+	// defaultFontSize is the font size in half-points of runs that set none, as in Word.
+	defaultFontSize = 22
+)
+
+// DropCap turns the first letter of the paragraph into a drop cap, a large initial letter
+// spanning several lines of the paragraph, as in magazines.
+//
+// As in Word, the letter is moved to a framed paragraph inserted right before this one,
+// formatted as the first run of this paragraph. The size of the letter and the line height
+// of the frame are derived from the font size of that run, the way Word computes them for
+// the default line spacing.
+//
+// Nothing is done if the paragraph has no text, if position is not stypes.DropCapInside or
+// stypes.DropCapMargin, or if the paragraph is not part of the document body, a table cell,
+// a header or a footer.
+//
+// Parameters:
+//   - position: stypes.DropCapInside to drop the letter in the text, or stypes.DropCapMargin
+//     to put it in the margin.
+//   - lines: The height of the letter in lines, from 1 to 10; other values are clamped.
+//
+// Returns:
+//   - *Paragraph: The paragraph instance for method chaining.
+//
+// Example:
+//
+//	document.AddParagraph("Once upon a time...").DropCap(stypes.DropCapInside, 3)
+func (p *Paragraph) DropCap(position stypes.DropCap, lines int) *Paragraph {
+	if position != stypes.DropCapInside && position != stypes.DropCapMargin {
+		return p
+	}
+	if lines < 1 {
+		lines = 1
+	}
+	if lines > maxDropCapLines {
+		lines = maxDropCapLines
+	}
+
+	run, text := firstTextOfParagraph(&p.ct)
+	if run == nil {
+		return p
+	}
+
+	letter, size := p.dropCapLetter(run, text, lines)
+	frame := newParagraph(p.root)
+	frame.ct.Property = &ctypes.ParagraphProp{
+		KeepNext: ctypes.OnOffFromBool(true),
+		FrameProp: &ctypes.FrameProp{
+			DropCap: internal.ToPtr(position),
+			Lines:   internal.ToPtr(lines),
+			Wrap:    internal.ToPtr(stypes.WrapAround),
+			VAnchor: internal.ToPtr(stypes.AnchorText),
+			HAnchor: internal.ToPtr(stypes.AnchorText),
+		},
+		Spacing: &ctypes.Spacing{
+			After:    internal.ToPtr(uint64(0)),
+			Line:     internal.ToPtr(lines * lineHeight(size)),
+			LineRule: internal.ToPtr(stypes.LineSpacingRuleExact),
+		},
+		TextAlignment: ctypes.NewGenSingleStrVal(stypes.TextAlignBaseline),
+	}
+	if p.ct.Property != nil && p.ct.Property.Style != nil {
+		frame.ct.Property.Style = ctypes.NewParagraphStyle(p.ct.Property.Style.Val)
+	}
+	frame.ct.Children = []ctypes.ParagraphChild{{Run: letter}}
+
+	if !p.root.insertBeforeParagraph(p, frame) {
+		return p
+	}
+
+	// The letter is only removed once the drop cap is in place
+	trimmed := strings.TrimLeftFunc(text.Text, unicode.IsSpace)
+	_, n := utf8.DecodeRuneInString(trimmed)
+	text.Text = trimmed[n:]
+	return p
+}
+
+// dropCapLetter returns the run of the drop cap of the first letter of the text of the run,
+// and the font size in half-points of the run.
+func (p *Paragraph) dropCapLetter(run *ctypes.Run, text *ctypes.Text, lines int) (*ctypes.Run, uint64) {
+	size := p.root.defaultFontSize()
+	if run.Property != nil && run.Property.Size != nil {
+		size = run.Property.Size.Value
+	}
+
+	letter, _ := utf8.DecodeRuneInString(strings.TrimLeftFunc(text.Text, unicode.IsSpace))
+	prop := &ctypes.RunProperty{}
+	if run.Property != nil {
+		prop = internal.DeepCopy(run.Property)
+	}
+
+	// The letter spans the lines, and is lowered to sit on the baseline of the last one
+	capSize := uint64(lines*lineHeight(size)) * 14 / 100
+	prop.Size = ctypes.NewFontSize(capSize)
+	prop.SizeCs = ctypes.NewFontSizeCS(capSize)
+	prop.Position = ctypes.NewDecimalNum(-int(size) * (lines - 1) * 2 / 10)
+
+	return &ctypes.Run{
+		Property: prop,
+		Children: []ctypes.RunChild{{Text: ctypes.TextFromString(string(letter))}},
+	}, size
+}
+
+// lineHeight returns the height in twentieths of a point of a line of text of the font size
+// in half-points, with single line spacing.
+func lineHeight(size uint64) int {
+	return int(size) * 12
+}
+
+// defaultFontSize returns the font size in half-points of runs that set none, from the
+// document defaults.
+func (rd *RootDoc) defaultFontSize() uint64 {
+	if rd.DocStyles != nil && rd.DocStyles.DocDefaults != nil && rd.DocStyles.DocDefaults.RunProp != nil {
+		if rPr := rd.DocStyles.DocDefaults.RunProp.RunProp; rPr != nil && rPr.Size != nil {
+			return rPr.Size.Value
+		}
+	}
+	return defaultFontSize
+}
+
+// firstTextOfParagraph returns the first run of the paragraph with text other than white
+// space, and its text element, or nil if there is none.
+func firstTextOfParagraph(p *ctypes.Paragraph) (*ctypes.Run, *ctypes.Text) {
+	for _, child := range p.Children {
+		if child.Run == nil {
+			continue
+		}
+		for _, runChild := range child.Run.Children {
+			if runChild.Text != nil && strings.TrimSpace(runChild.Text.Text) != "" {
+				return child.Run, runChild.Text
+			}
+		}
+	}
+	return nil, nil
+}
+
+// insertBeforeParagraph inserts the paragraph p right before the paragraph ref, in the body,
+// a table cell, a header or a footer, and reports whether ref was found.
+func (rd *RootDoc) insertBeforeParagraph(ref, p *Paragraph) bool {
+	if rd.Document != nil && rd.Document.Body != nil {
+		if children, ok := insertBeforeChild(rd.Document.Body.Children, ref, p); ok {
+			rd.Document.Body.Children = children
+			return true
+		}
+	}
+
+	for _, hf := range rd.headerFooters {
+		if children, ok := insertBeforeChild(hf.Children, ref, p); ok {
+			hf.Children = children
+			return true
+		}
+	}
+	return false
+}
+
+// insertBeforeChild returns the children with p inserted right before ref, looking into the
+// tables, and reports whether ref was found.
+func insertBeforeChild(children []DocumentChild, ref, p *Paragraph) ([]DocumentChild, bool) {
+	for i, child := range children {
+		if child.Para == ref {
+			children = append(children, DocumentChild{})
+			copy(children[i+1:], children[i:])
+			children[i] = DocumentChild{Para: p}
+			return children, true
+		}
+
+		if child.Table != nil && insertBeforeTableParagraph(&child.Table.ct, &ref.ct, &p.ct) {
+			return children, true
+		}
+	}
+	return children, false
+}
+
+// insertBeforeTableParagraph inserts the paragraph p right before the paragraph ref of a cell
+// of the table or of its nested tables, and reports whether ref was found.
+func insertBeforeTableParagraph(tbl *ctypes.Table, ref, p *ctypes.Paragraph) bool {
+	for _, rowContent := range tbl.RowContents {
+		if rowContent.Row == nil {
+			continue
+		}
+
+		for _, cellContent := range rowContent.Row.Contents {
+			if cellContent.Cell == nil {
+				continue
+			}
+
+			cell := cellContent.Cell
+			for i, block := range cell.Contents {
+				if block.Paragraph == ref {
+					cell.Contents = append(cell.Contents, ctypes.TCBlockContent{})
+					copy(cell.Contents[i+1:], cell.Contents[i:])
+					cell.Contents[i] = ctypes.TCBlockContent{Paragraph: p}
+					return true
+				}
+
+				if block.Table != nil && insertBeforeTableParagraph(block.Table, ref, p) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,80 @@
+package docx
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/MamaShip/godocx/wml/stypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParagraph_DropCap(t *testing.T) {
+	rd := setupRootDoc(t)
+	rd.AddParagraph("Title")
+	p := rd.AddParagraph("  Once upon a time")
+	p.Style("BodyText")
+	p.GetCT().Children[0].Run.Property = nil
+	p.DropCap(stypes.DropCapInside, 3)
+
+	children := rd.Document.Body.Children
+	require.Len(t, children, 3)
+	assert.Same(t, p, children[2].Para)
+
+	out, err := xml.Marshal(children[1].Para.GetCT())
+	require.NoError(t, err)
+	assert.Equal(t, `<w:p><w:pPr><w:pStyle w:val="BodyText"></w:pStyle><w:keepNext w:val="true"></w:keepNext>`+
+		`<w:framePr w:dropCap="drop" w:lines="3" w:wrap="around" w:hAnchor="text" w:vAnchor="text"></w:framePr>`+
+		`<w:spacing w:after="0" w:line="792" w:lineRule="exact"></w:spacing><w:textAlignment w:val="baseline"></w:textAlignment></w:pPr>`+
+		`<w:r><w:rPr><w:position w:val="-8"></w:position><w:sz w:val="110"></w:sz><w:szCs w:val="110"></w:szCs></w:rPr><w:t>O</w:t></w:r></w:p>`, string(out))
+	assert.Equal(t, "nce upon a time", p.GetCT().Children[0].Run.Children[0].Text.Text)
+
+	// The frame survives a round trip
+	doc, err := xml.Marshal(rd.Document)
+	require.NoError(t, err)
+	loaded, err := LoadDocXml(setupRootDoc(t), "word/document.xml", doc)
+	require.NoError(t, err)
+	pPr := loaded.Body.Children[1].Para.GetCT().Property
+	require.NotNil(t, pPr.FrameProp)
+	assert.Equal(t, stypes.DropCapInside, *pPr.FrameProp.DropCap)
+	assert.Equal(t, 3, *pPr.FrameProp.Lines)
+	assert.Equal(t, 792, *pPr.Spacing.Line)
+}
+
+func TestParagraph_DropCapFormatting(t *testing.T) {
+	rd := setupRootDoc(t)
+	cell := rd.AddTable(1, 1).Cell(0, 0)
+	p := cell.AddEmptyPara()
+	p.AddText("Émile").Font("Georgia").Size(14)
+	p.DropCap(stypes.DropCapMargin, 20)
+
+	contents := cell.ct.Contents
+	require.Len(t, contents, 2)
+	assert.Same(t, p.GetCT(), contents[1].Paragraph)
+
+	frame := contents[0].Paragraph
+	assert.Equal(t, 10, *frame.Property.FrameProp.Lines, "lines are clamped")
+	letter := frame.Children[0].Run
+	assert.Equal(t, "É", letter.Children[0].Text.Text)
+	assert.Equal(t, "Georgia", letter.Property.Fonts.Ascii)
+	assert.Equal(t, uint64(10*28*12*14/100), letter.Property.Size.Value)
+	assert.Equal(t, "mile", p.GetCT().Children[0].Run.Children[0].Text.Text)
+}
+
+func TestParagraph_DropCapNoOp(t *testing.T) {
+	rd := setupRootDoc(t)
+	empty := rd.AddParagraph(" ")
+	empty.DropCap(stypes.DropCapInside, 3)
+	none := rd.AddParagraph("Text")
+	none.DropCap(stypes.DropCapNone, 3)
+
+	// Paragraphs that are not part of the document are left unchanged
+	detached := newParagraph(rd, paraWithText("Detached"))
+	detached.DropCap(stypes.DropCapInside, 2)
+
+	assert.Len(t, rd.Document.Body.Children, 2)
+	assert.Nil(t, empty.GetCT().Property)
+	assert.Nil(t, none.GetCT().Property)
+	assert.Equal(t, "Text", none.GetCT().Children[0].Run.Children[0].Text.Text)
+	assert.Equal(t, "Detached", detached.GetCT().Children[0].Run.Children[0].Text.Text)
+}
@@ -0,0 +1,305 @@
+package docx
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"unicode/utf16"
+
+	"github.com/MamaShip/godocx/internal"
+)
+
+// ErrEmptyPassword is returned when a document is encrypted with an empty password.
+var ErrEmptyPassword = errors.New("password is empty")
+
+// Parameters of the agile encryption written by WriteEncrypted.
+const (
+	encryptionKeyBits     = 256
+	encryptionBlockSize   = aes.BlockSize
+	encryptionSaltSize    = 16
+	encryptionHashSize    = sha512.Size
+	encryptionSpinCount   = 100000
+	encryptionSegmentSize = 4096
+)
+
+// Block keys of [MS-OFFCRYPTO] 2.3.4.11 to 2.3.4.14, mixed into the hashes deriving the keys
+// and initialization vectors.
+var (
+	blockKeyVerifierHashInput = []byte{0xfe, 0xa7, 0xd2, 0x76, 0x3b, 0x4b, 0x9e, 0x79}
+	blockKeyVerifierHashValue = []byte{0xd7, 0xaa, 0x0f, 0x6d, 0x30, 0x61, 0x34, 0x4e}
+	blockKeyEncryptedKey      = []byte{0x14, 0x6e, 0x0b, 0xe7, 0xab, 0xac, 0xd0, 0xd6}
+	blockKeyIntegrityKey      = []byte{0x5f, 0xb2, 0xad, 0x01, 0x0c, 0xb9, 0xe1, 0xf6}
+	blockKeyIntegrityValue    = []byte{0xa0, 0x67, 0x7f, 0x02, 0xb2, 0x2c, 0x84, 0x33}
+)
+
+// SaveEncrypted saves the document to the specified file path, encrypted with the password
+// needed to open it. See WriteEncrypted for the encryption scheme.
+func (rd *RootDoc) SaveEncrypted(fileName, password string) error {
+	if fileName == "" {
+		return errors.New("Destination file path is empty")
+	}
+
+	var buf bytes.Buffer
+	if err := rd.WriteEncrypted(&buf, password); err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Clean(fileName), buf.Bytes(), 0o644)
+}
+
+// WriteEncrypted writes the document to w encrypted with the password needed to open it,
+// unlike SetProtection which only restricts editing.
+//
+// The document is written with the ECMA-376 agile encryption of [MS-OFFCRYPTO], the scheme
+// used by Word 2010 and later: the package is encrypted with a random 256-bit AES key in CBC
+// mode, in 4096-byte segments, and the key is encrypted with a key derived from the password
+// by 100000 iterations of SHA-512. An HMAC-SHA512 of the encrypted package protects its
+// integrity. The result is a compound file (OLE) container holding the EncryptionInfo and
+// EncryptedPackage streams, as written by Word.
+//
+// Returns:
+//   - error: ErrEmptyPassword if the password is empty.
+func (rd *RootDoc) WriteEncrypted(w io.Writer, password string) error {
+	if password == "" {
+		return ErrEmptyPassword
+	}
+
+	var pkg bytes.Buffer
+	if err := rd.Write(&pkg); err != nil {
+		return err
+	}
+
+	streams, err := encryptPackage(pkg.Bytes(), password)
+	if err != nil {
+		return err
+	}
+
+	return internal.WriteCompoundFile(w, streams)
+}
+
+// encryptPackage returns the streams of the compound file holding the package encrypted
+// with the password.
+func encryptPackage(pkg []byte, password string) ([]internal.CompoundStream, error) {
+	secretKey, err := randomBytes(encryptionKeyBits / 8)
+	if err != nil {
+		return nil, err
+	}
+	keyDataSalt, err := randomBytes(encryptionSaltSize)
+	if err != nil {
+		return nil, err
+	}
+
+	// EncryptedPackage: the package size, then the package encrypted segment by segment
+	encrypted := make([]byte, 8, 8+len(pkg)+encryptionBlockSize)
+	binary.LittleEndian.PutUint64(encrypted, uint64(len(pkg)))
+	for i := 0; i*encryptionSegmentSize < len(pkg); i++ {
+		end := (i + 1) * encryptionSegmentSize
+		if end > len(pkg) {
+			end = len(pkg)
+		}
+		segment, err := aesCBCEncrypt(secretKey, segmentIV(keyDataSalt, uint32(i)), pkg[i*encryptionSegmentSize:end])
+		if err != nil {
+			return nil, err
+		}
+		encrypted = append(encrypted, segment...)
+	}
+
+	// Data integrity: an HMAC of the encrypted package, with its key, encrypted with the secret key
+	hmacKey, err := randomBytes(encryptionHashSize)
+	if err != nil {
+		return nil, err
+	}
+	mac := hmac.New(sha512.New, hmacKey)
+	mac.Write(encrypted)
+
+	encryptedHmacKey, err := aesCBCEncrypt(secretKey, blockIV(keyDataSalt, blockKeyIntegrityKey), hmacKey)
+	if err != nil {
+		return nil, err
+	}
+	encryptedHmacValue, err := aesCBCEncrypt(secretKey, blockIV(keyDataSalt, blockKeyIntegrityValue), mac.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+
+	// Password key encryptor: a verifier to check the password, and the encrypted secret key
+	passwordSalt, err := randomBytes(encryptionSaltSize)
+	if err != nil {
+		return nil, err
+	}
+	passwordHash := agilePasswordHash(password, passwordSalt, encryptionSpinCount)
+
+	verifierHashInput, err := randomBytes(encryptionSaltSize)
+	if err != nil {
+		return nil, err
+	}
+	verifierHashValue := sha512.Sum512(verifierHashInput)
+
+	encryptedVerifierHashInput, err := aesCBCEncrypt(agileKey(passwordHash, blockKeyVerifierHashInput), passwordSalt, verifierHashInput)
+	if err != nil {
+		return nil, err
+	}
+	encryptedVerifierHashValue, err := aesCBCEncrypt(agileKey(passwordHash, blockKeyVerifierHashValue), passwordSalt, verifierHashValue[:])
+	if err != nil {
+		return nil, err
+	}
+	encryptedKeyValue, err := aesCBCEncrypt(agileKey(passwordHash, blockKeyEncryptedKey), passwordSalt, secretKey)
+	if err != nil {
+		return nil, err
+	}
+
+	b64 := base64.StdEncoding.EncodeToString
+	params := ` saltSize="` + strconv.Itoa(encryptionSaltSize) + `" blockSize="` + strconv.Itoa(encryptionBlockSize) +
+		`" keyBits="` + strconv.Itoa(encryptionKeyBits) + `" hashSize="` + strconv.Itoa(encryptionHashSize) +
+		`" cipherAlgorithm="AES" cipherChaining="ChainingModeCBC" hashAlgorithm="SHA512"`
+
+	descriptor := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\r\n" +
+		`<encryption xmlns="http://schemas.microsoft.com/office/2006/encryption"` +
+		` xmlns:p="http://schemas.microsoft.com/office/2006/keyEncryptor/password"` +
+		` xmlns:c="http://schemas.microsoft.com/office/2006/keyEncryptor/certificate">` +
+		`<keyData` + params + ` saltValue="` + b64(keyDataSalt) + `"/>` +
+		`<dataIntegrity encryptedHmacKey="` + b64(encryptedHmacKey) + `" encryptedHmacValue="` + b64(encryptedHmacValue) + `"/>` +
+		`<keyEncryptors><keyEncryptor uri="http://schemas.microsoft.com/office/2006/keyEncryptor/password">` +
+		`<p:encryptedKey spinCount="` + strconv.Itoa(encryptionSpinCount) + `"` + params +
+		` saltValue="` + b64(passwordSalt) + `"` +
+		` encryptedVerifierHashInput="` + b64(encryptedVerifierHashInput) + `"` +
+		` encryptedVerifierHashValue="` + b64(encryptedVerifierHashValue) + `"` +
+		` encryptedKeyValue="` + b64(encryptedKeyValue) + `"/>` +
+		`</keyEncryptor></keyEncryptors></encryption>`
+
+	// EncryptionInfo: version 4.4 (agile), the reserved flags, then the XML descriptor
+	info := []byte{0x04, 0x00, 0x04, 0x00, 0x40, 0x00, 0x00, 0x00}
+	info = append(info, descriptor...)
+
+	return append(dataSpacesStreams(),
+		internal.CompoundStream{Path: "EncryptionInfo", Data: info},
+		internal.CompoundStream{Path: "EncryptedPackage", Data: encrypted},
+	), nil
+}
+
+// agilePasswordHash returns the hash of the salted password after the given number of
+// iterations, from which the keys of the password key encryptor are derived.
+func agilePasswordHash(password string, salt []byte, spinCount int) []byte {
+	input := append([]byte{}, salt...)
+	for _, unit := range utf16.Encode([]rune(password)) {
+		input = append(input, byte(unit), byte(unit>>8))
+	}
+
+	sum := sha512.Sum512(input)
+	hash := sum[:]
+	iteration := make([]byte, 4, 4+sha512.Size)
+	for i := 0; i < spinCount; i++ {
+		binary.LittleEndian.PutUint32(iteration, uint32(i))
+		sum = sha512.Sum512(append(iteration[:4], hash...))
+		hash = sum[:]
+	}
+	return hash
+}
+
+// agileKey returns the AES key derived from the password hash for the given block key.
+func agileKey(passwordHash, blockKey []byte) []byte {
+	sum := sha512.Sum512(append(append([]byte{}, passwordHash...), blockKey...))
+	return sum[:encryptionKeyBits/8]
+}
+
+// blockIV returns the initialization vector derived from the key data salt and a block key.
+func blockIV(salt, blockKey []byte) []byte {
+	sum := sha512.Sum512(append(append([]byte{}, salt...), blockKey...))
+	return sum[:encryptionBlockSize]
+}
+
+// segmentIV returns the initialization vector of the given segment of the package.
+func segmentIV(salt []byte, segment uint32) []byte {
+	index := make([]byte, 4)
+	binary.LittleEndian.PutUint32(index, segment)
+	return blockIV(salt, index)
+}
+
+// aesCBCEncrypt encrypts the data, padded with zeros to the block size.
+func aesCBCEncrypt(key, iv, data []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]byte, (len(data)+encryptionBlockSize-1)/encryptionBlockSize*encryptionBlockSize)
+	copy(out, data)
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(out, out)
+	return out, nil
+}
+
+func randomBytes(n int) ([]byte, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// dataSpacesStreams returns the streams of the \x06DataSpaces storage declaring that the
+// EncryptedPackage stream is transformed by the encryption ([MS-OFFCRYPTO] 2.2).
+func dataSpacesStreams() []internal.CompoundStream {
+	var version, dataSpaceMap, dataSpaceInfo, transformInfo bytes.Buffer
+
+	// DataSpaceVersionInfo
+	writeLengthPrefixedUTF16(&version, "Microsoft.Container.DataSpaces")
+	writeUint32s(&version, 0x00000001, 0x00000001, 0x00000001) // Reader, updater and writer versions 1.0
+
+	// DataSpaceMap with a single entry mapping EncryptedPackage to StrongEncryptionDataSpace
+	var entry bytes.Buffer
+	writeUint32s(&entry, 1, 0) // One reference component, of the stream type
+	writeLengthPrefixedUTF16(&entry, "EncryptedPackage")
+	writeLengthPrefixedUTF16(&entry, "StrongEncryptionDataSpace")
+	writeUint32s(&dataSpaceMap, 8, 1, uint32(entry.Len()+4))
+	dataSpaceMap.Write(entry.Bytes())
+
+	// DataSpaceDefinition
+	writeUint32s(&dataSpaceInfo, 8, 1)
+	writeLengthPrefixedUTF16(&dataSpaceInfo, "StrongEncryptionTransform")
+
+	// TransformInfoHeader followed by EncryptionTransformInfo
+	const transformID = "{FF9A3F03-56EF-4613-BDD5-5A41C1D07246}"
+	writeUint32s(&transformInfo, uint32(12+2*len(transformID)), 1)
+	writeLengthPrefixedUTF16(&transformInfo, transformID)
+	writeLengthPrefixedUTF16(&transformInfo, "Microsoft.Container.EncryptionTransform")
+	writeUint32s(&transformInfo, 0x00000001, 0x00000001, 0x00000001) // Reader, updater and writer versions 1.0
+	writeUint32s(&transformInfo, 0, 0, 0, 4)                         // No encryption name, block size and cipher mode
+
+	return []internal.CompoundStream{
+		{Path: "\x06DataSpaces/Version", Data: version.Bytes()},
+		{Path: "\x06DataSpaces/DataSpaceMap", Data: dataSpaceMap.Bytes()},
+		{Path: "\x06DataSpaces/DataSpaceInfo/StrongEncryptionDataSpace", Data: dataSpaceInfo.Bytes()},
+		{Path: "\x06DataSpaces/TransformInfo/StrongEncryptionTransform/\x06Primary", Data: transformInfo.Bytes()},
+	}
+}
+
+// writeLengthPrefixedUTF16 writes the string in UTF-16LE preceded by its length in bytes,
+// padded to a multiple of 4 bytes.
+func writeLengthPrefixedUTF16(buf *bytes.Buffer, s string) {
+	units := utf16.Encode([]rune(s))
+	writeUint32s(buf, uint32(2*len(units)))
+	for _, unit := range units {
+		buf.WriteByte(byte(unit))
+		buf.WriteByte(byte(unit >> 8))
+	}
+	if len(units)%2 != 0 {
+		buf.Write([]byte{0, 0})
+	}
+}
+
+func writeUint32s(buf *bytes.Buffer, values ...uint32) {
+	b := make([]byte, 4)
+	for _, value := range values {
+		binary.LittleEndian.PutUint32(b, value)
+		buf.Write(b)
+	}
+}
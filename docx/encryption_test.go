@@ -0,0 +1,264 @@
+package docx
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/xml"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/MamaShip/godocx/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// readCompoundFile returns the streams of a version 3 compound file by path, following the
+// allocation tables as a reader would.
+func readCompoundFile(t *testing.T, file []byte) map[string][]byte {
+	t.Helper()
+
+	require.Equal(t, []byte{0xD0, 0xCF, 0x11, 0xE0, 0xA1, 0xB1, 0x1A, 0xE1}, file[:8])
+	require.Zero(t, len(file)%512)
+	u32 := func(b []byte, off int) uint32 { return binary.LittleEndian.Uint32(b[off:]) }
+	sector := func(id uint32) []byte { return file[512*(int(id)+1) : 512*(int(id)+2)] }
+
+	// FAT sector locations from the header and the DIFAT chain
+	var fatSectors []uint32
+	for i := 0; i < 109; i++ {
+		if loc := u32(file, 76+4*i); loc != 0xFFFFFFFF {
+			fatSectors = append(fatSectors, loc)
+		}
+	}
+	for difat := u32(file, 68); difat != 0xFFFFFFFE; difat = u32(sector(difat), 508) {
+		for i := 0; i < 127; i++ {
+			if loc := u32(sector(difat), 4*i); loc != 0xFFFFFFFF {
+				fatSectors = append(fatSectors, loc)
+			}
+		}
+	}
+	require.Len(t, fatSectors, int(u32(file, 44)))
+
+	var fat []uint32
+	for _, loc := range fatSectors {
+		for i := 0; i < 128; i++ {
+			fat = append(fat, u32(sector(loc), 4*i))
+		}
+	}
+	chain := func(start uint32) []byte {
+		var data []byte
+		for id := start; id != 0xFFFFFFFE; id = fat[id] {
+			data = append(data, sector(id)...)
+		}
+		return data
+	}
+
+	dir := chain(u32(file, 48))
+	entry := func(id uint32) []byte { return dir[128*id : 128*(id+1)] }
+	name := func(e []byte) string {
+		units := make([]uint16, binary.LittleEndian.Uint16(e[64:])/2-1)
+		for i := range units {
+			units[i] = binary.LittleEndian.Uint16(e[2*i:])
+		}
+		return string(utf16.Decode(units))
+	}
+
+	root := entry(0)
+	require.Equal(t, "Root Entry", name(root))
+	miniStream := chain(u32(root, 116))
+	miniFAT := []uint32{}
+	if start := u32(file, 60); start != 0xFFFFFFFE {
+		miniFATBytes := chain(start)
+		for i := 0; i < len(miniFATBytes)/4; i++ {
+			miniFAT = append(miniFAT, u32(miniFATBytes, 4*i))
+		}
+	}
+
+	streams := make(map[string][]byte)
+	var walk func(id uint32, prefix string)
+	walk = func(id uint32, prefix string) {
+		if id == 0xFFFFFFFF {
+			return
+		}
+		e := entry(id)
+		walk(u32(e, 68), prefix)
+		walk(u32(e, 72), prefix)
+
+		path := prefix + name(e)
+		switch e[66] {
+		case 1:
+			walk(u32(e, 76), path+"/")
+		case 2:
+			size := int(binary.LittleEndian.Uint64(e[120:]))
+			data := []byte{}
+			if size < 4096 {
+				for mid := u32(e, 116); mid != 0xFFFFFFFE; mid = miniFAT[mid] {
+					data = append(data, miniStream[64*mid:64*(mid+1)]...)
+				}
+			} else {
+				data = chain(u32(e, 116))
+			}
+			streams[path] = data[:size]
+		}
+	}
+	walk(u32(root, 76), "")
+
+	return streams
+}
+
+// decryptAgile checks the password against the verifier of an agile EncryptionInfo stream
+// and returns the decrypted package, or nil if the password is wrong.
+func decryptAgile(t *testing.T, info, encrypted []byte, password string) []byte {
+	t.Helper()
+
+	require.Equal(t, []byte{0x04, 0x00, 0x04, 0x00, 0x40, 0x00, 0x00, 0x00}, info[:8])
+	var descriptor struct {
+		KeyData struct {
+			SaltValue string `xml:"saltValue,attr"`
+		} `xml:"keyData"`
+		DataIntegrity struct {
+			EncryptedHmacKey   string `xml:"encryptedHmacKey,attr"`
+			EncryptedHmacValue string `xml:"encryptedHmacValue,attr"`
+		} `xml:"dataIntegrity"`
+		Key struct {
+			SpinCount                  int    `xml:"spinCount,attr"`
+			SaltValue                  string `xml:"saltValue,attr"`
+			EncryptedVerifierHashInput string `xml:"encryptedVerifierHashInput,attr"`
+			EncryptedVerifierHashValue string `xml:"encryptedVerifierHashValue,attr"`
+			EncryptedKeyValue          string `xml:"encryptedKeyValue,attr"`
+		} `xml:"keyEncryptors>keyEncryptor>encryptedKey"`
+	}
+	require.NoError(t, xml.Unmarshal(info[8:], &descriptor))
+
+	b64 := func(s string) []byte {
+		b, err := base64.StdEncoding.DecodeString(s)
+		require.NoError(t, err)
+		return b
+	}
+	decrypt := func(key, iv, data []byte) []byte {
+		block, err := aes.NewCipher(key)
+		require.NoError(t, err)
+		out := make([]byte, len(data))
+		cipher.NewCBCDecrypter(block, iv).CryptBlocks(out, data)
+		return out
+	}
+
+	// Password verifier
+	salt := b64(descriptor.Key.SaltValue)
+	hash := agilePasswordHash(password, salt, descriptor.Key.SpinCount)
+	verifierInput := decrypt(agileKey(hash, blockKeyVerifierHashInput), salt, b64(descriptor.Key.EncryptedVerifierHashInput))
+	verifierValue := decrypt(agileKey(hash, blockKeyVerifierHashValue), salt, b64(descriptor.Key.EncryptedVerifierHashValue))
+	expected := sha512.Sum512(verifierInput)
+	if !bytes.Equal(expected[:], verifierValue[:sha512.Size]) {
+		return nil
+	}
+	secretKey := decrypt(agileKey(hash, blockKeyEncryptedKey), salt, b64(descriptor.Key.EncryptedKeyValue))
+
+	// Data integrity
+	keyDataSalt := b64(descriptor.KeyData.SaltValue)
+	hmacKey := decrypt(secretKey, blockIV(keyDataSalt, blockKeyIntegrityKey), b64(descriptor.DataIntegrity.EncryptedHmacKey))
+	hmacValue := decrypt(secretKey, blockIV(keyDataSalt, blockKeyIntegrityValue), b64(descriptor.DataIntegrity.EncryptedHmacValue))
+	mac := hmac.New(sha512.New, hmacKey[:sha512.Size])
+	mac.Write(encrypted)
+	assert.Equal(t, mac.Sum(nil), hmacValue[:sha512.Size], "HMAC of the encrypted package")
+
+	// Package, segment by segment
+	size := int(binary.LittleEndian.Uint64(encrypted))
+	var pkg []byte
+	data := encrypted[8:]
+	for i := 0; i*4096 < len(data); i++ {
+		end := (i + 1) * 4096
+		if end > len(data) {
+			end = len(data)
+		}
+		pkg = append(pkg, decrypt(secretKey, segmentIV(keyDataSalt, uint32(i)), data[i*4096:end])...)
+	}
+	return pkg[:size]
+}
+
+func TestWriteEncrypted(t *testing.T) {
+	rd := setupRootDoc(t)
+	rd.Document.DocRels = Relationships{RelativePath: "word/_rels/document.xml.rels"}
+	rd.RootRels.RelativePath = "_rels/.rels"
+	rd.Document.relativePath = "word/document.xml"
+	rd.DocStyles.RelativePath = "word/styles.xml"
+	for i := 0; i < 200; i++ {
+		rd.AddParagraph("Confidential figures, paragraph " + string(rune('A'+i%26)))
+	}
+
+	var plain bytes.Buffer
+	require.NoError(t, rd.Write(&plain))
+
+	var out bytes.Buffer
+	require.NoError(t, rd.WriteEncrypted(&out, "s3cr3t"))
+	assert.NotContains(t, out.String(), "Confidential")
+
+	streams := readCompoundFile(t, out.Bytes())
+	assert.Contains(t, streams, "\x06DataSpaces/Version")
+	assert.Contains(t, streams, "\x06DataSpaces/DataSpaceMap")
+	assert.Contains(t, streams, "\x06DataSpaces/DataSpaceInfo/StrongEncryptionDataSpace")
+	assert.Contains(t, streams, "\x06DataSpaces/TransformInfo/StrongEncryptionTransform/\x06Primary")
+	require.Contains(t, streams, "EncryptionInfo")
+	require.Contains(t, streams, "EncryptedPackage")
+
+	assert.Nil(t, decryptAgile(t, streams["EncryptionInfo"], streams["EncryptedPackage"], "wrong"))
+	assert.Equal(t, plain.Bytes(), decryptAgile(t, streams["EncryptionInfo"], streams["EncryptedPackage"], "s3cr3t"))
+
+	assert.ErrorIs(t, rd.WriteEncrypted(&out, ""), ErrEmptyPassword)
+}
+
+// TestAgileKeyDerivation checks the key derivation and encryption primitives against values
+// computed independently of this package from [MS-OFFCRYPTO] 2.3.4.11 to 2.3.4.15, with
+// Python's hashlib and "openssl enc -aes-256-cbc -nopad".
+func TestAgileKeyDerivation(t *testing.T) {
+	unhex := func(s string) []byte {
+		b, err := hex.DecodeString(s)
+		require.NoError(t, err)
+		return b
+	}
+	salt := unhex("000102030405060708090a0b0c0d0e0f")
+	keyDataSalt := unhex("101112131415161718191a1b1c1d1e1f")
+
+	hash := agilePasswordHash("Password1234_", salt, encryptionSpinCount)
+	assert.Equal(t, unhex("1154708599656ec9fff5342f72c700ee6d5a0d7ea340f6701f29a7e6159615113d72f0c919cc783d1aee8a570737908f74baf2d342d38d0397984163cfe29fed"), hash)
+
+	key := agileKey(hash, blockKeyEncryptedKey)
+	assert.Equal(t, unhex("7a8b2091cd76dd40577bbc7b165de0985a9de0e0aded58ce94fc4b35294c0d0e"), key)
+	assert.Equal(t, unhex("7d786688805c5934859055665c8e1a80"), blockIV(keyDataSalt, blockKeyIntegrityKey))
+
+	iv := segmentIV(keyDataSalt, 1)
+	assert.Equal(t, unhex("da22ccba062aff577d207b2dee4e165b"), iv)
+
+	encrypted, err := aesCBCEncrypt(key, iv, []byte("Confidential figures"))
+	require.NoError(t, err)
+	assert.Equal(t, unhex("307e6de55a451896ef0084a97cbff9660ed524365a56c9612a72f0fad0c564fa"), encrypted)
+}
+
+func TestWriteCompoundFile(t *testing.T) {
+	large := bytes.Repeat([]byte("0123456789abcdef"), 5000)
+	// Large enough to need more FAT sectors than the header lists, and thus a DIFAT sector
+	huge := bytes.Repeat([]byte{0xAB}, 8<<20)
+	streams := []internal.CompoundStream{
+		{Path: "Huge", Data: huge},
+		{Path: "Small", Data: []byte("tiny")},
+		{Path: "Storage/Nested", Data: bytes.Repeat([]byte{7}, 100)},
+		{Path: "Storage/Other", Data: []byte{}},
+		{Path: "Large", Data: large},
+	}
+
+	var out bytes.Buffer
+	require.NoError(t, internal.WriteCompoundFile(&out, streams))
+
+	read := readCompoundFile(t, out.Bytes())
+	assert.Len(t, read, len(streams))
+	for _, stream := range streams {
+		assert.Equal(t, stream.Data, read[stream.Path], stream.Path)
+	}
+
+	assert.Error(t, internal.WriteCompoundFile(&out, []internal.CompoundStream{{Path: "A", Data: nil}, {Path: "A", Data: nil}}))
+}
@@ -0,0 +1,181 @@
+package docx
+
+import (
+	"strings"
+
+	"github.com/MamaShip/godocx/internal"
+	"github.com/MamaShip/godocx/wml/ctypes"
+	"github.com/MamaShip/godocx/wml/stypes"
+)
+
+// fieldChildren returns the run content of a complex field with the given instruction.
+//
+// The field is marked dirty so that Word recalculates it when the document is opened.
+// If result is not empty it is used as the placeholder result until the field is updated.
+func fieldChildren(instr string, result string) []ctypes.RunChild {
+	begin := ctypes.NewFldChar(stypes.FldCharTypeBegin)
+	begin.Dirty = internal.ToPtr(stypes.OnOffTrue)
+
+	children := []ctypes.RunChild{
+		{FldChar: begin},
+		{InstrText: &ctypes.Text{Text: " " + instr + " ", Space: internal.ToPtr(ctypes.TextSpacePreserve)}},
+	}
+
+	if result != "" {
+		children = append(children,
+			ctypes.RunChild{FldChar: ctypes.NewFldChar(stypes.FldCharTypeSeparate)},
+			ctypes.RunChild{Text: ctypes.TextFromString(result)},
+		)
+	}
+
+	return append(children, ctypes.RunChild{FldChar: ctypes.NewFldChar(stypes.FldCharTypeEnd)})
+}
+
+// addField appends a run containing a complex field to the paragraph.
+func (p *Paragraph) addField(instr string, result string) *Run {
+	run := &ctypes.Run{
+		Children: fieldChildren(instr, result),
+	}
+
+	p.ct.Children = append(p.ct.Children, ctypes.ParagraphChild{Run: run})
+
+	return newRun(p.root, run)
+}
+
+// AddField appends a run containing a field with the given instruction to the paragraph,
+// e.g. `DATE \@ "d MMMM yyyy"` or "SECTIONPAGES".
+//
+// The field is marked dirty so that Word updates it when the document is opened. Until then,
+// it shows result, which may be empty.
+//
+// Returns:
+//   - *Run: The run holding the field, which can be formatted like any other run.
+//
+// Example:
+//
+//	p := document.AddFooter(docx.HeaderFooterDefault).AddParagraph("Printed on ")
+//	p.AddField(`PRINTDATE \@ "yyyy-MM-dd"`, "")
+func (p *Paragraph) AddField(instr string, result string) *Run {
+	return p.addField(instr, result)
+}
+
+// AddField appends a field with the given instruction to the run, after its current content.
+// See Paragraph.AddField for details.
+//
+// Returns:
+//   - *Run: The run instance for method chaining.
+func (r *Run) AddField(instr string, result string) *Run {
+	r.ct.Children = append(r.ct.Children, fieldChildren(instr, result)...)
+	return r
+}
+
+// quoteFieldArg quotes a field argument, escaping embedded quotes and backslashes.
+func quoteFieldArg(arg string) string {
+	arg = strings.ReplaceAll(arg, `\`, `\\`)
+	arg = strings.ReplaceAll(arg, `"`, `\"`)
+	return `"` + arg + `"`
+}
+
+// AddPageNumberField appends a PAGE field showing the current page number to the paragraph.
+//
+// The field shows "1" until Word updates it. Combine it with plain runs and
+// AddPageCountField to build footers such as "Page 1 of 10".
+//
+// Returns:
+//   - *Run: The run holding the field, which can be formatted like any other run.
+//
+// Example:
+//
+//	p := document.AddFooter(docx.HeaderFooterDefault).AddParagraph("Page ")
+//	p.AddPageNumberField()
+//	p.AddText(" of ")
+//	p.AddPageCountField()
+func (p *Paragraph) AddPageNumberField() *Run {
+	return p.addField("PAGE", "1")
+}
+
+// AddPageCountField appends a NUMPAGES field showing the total number of pages to the paragraph.
+//
+// The field shows "1" until Word updates it. See AddPageNumberField for an example.
+//
+// Returns:
+//   - *Run: The run holding the field, which can be formatted like any other run.
+func (p *Paragraph) AddPageCountField() *Run {
+	return p.addField("NUMPAGES", "1")
+}
+
+// RefKind selects what a cross-reference added with AddCrossReference shows.
+// RefHyperlink can be combined with the other values, e.g. RefPage | RefHyperlink.
+type RefKind int
+
+const (
+	RefContent   RefKind = 0      // Content of the bookmark (REF field)
+	RefPage      RefKind = 1 << 0 // Page number of the bookmark (PAGEREF field)
+	RefHyperlink RefKind = 1 << 1 // The reference links to the bookmark (\h switch)
+)
+
+// AddCrossReference appends a REF or PAGEREF field referring to the bookmark with the given name,
+// e.g. one added with AddBookmark, to the paragraph.
+//
+// Until Word updates the field, a REF field shows the current text of the bookmark, or its name
+// if it is not found, and a PAGEREF field shows "1".
+//
+// Returns:
+//   - *Run: The run holding the field, which can be formatted like any other run.
+//
+// Example:
+//
+//	caption := document.AddParagraph("Figure 1: Sales by region")
+//	caption.AddBookmark("FigSales")
+//
+//	p := document.AddParagraph("See ")
+//	p.AddCrossReference("FigSales", docx.RefContent|docx.RefHyperlink)
+//	p.AddText(" on page ")
+//	p.AddCrossReference("FigSales", docx.RefPage)
+func (p *Paragraph) AddCrossReference(bookmarkName string, kind RefKind) *Run {
+	instr, result := "REF "+bookmarkName, bookmarkName
+	if kind&RefPage != 0 {
+		instr, result = "PAGEREF "+bookmarkName, "1"
+	} else if text := p.root.bookmarkText(bookmarkName); text != "" {
+		result = text
+	}
+
+	if kind&RefHyperlink != 0 {
+		instr += ` \h`
+	}
+
+	return p.addField(instr, result)
+}
+
+// bookmarkText returns the text of the paragraph content spanned by the bookmark with the given
+// name, up to the end of the paragraph holding its start, or "" if it is not found.
+func (rd *RootDoc) bookmarkText(name string) string {
+	var (
+		sb    strings.Builder
+		found bool
+	)
+
+	rd.walkParagraphs(func(p *ctypes.Paragraph) {
+		if found {
+			return
+		}
+
+		for i, child := range p.Children {
+			if child.BookmarkStart == nil || child.BookmarkStart.Name != name {
+				continue
+			}
+
+			found = true
+			id := child.BookmarkStart.ID
+			for _, inner := range p.Children[i+1:] {
+				if inner.BookmarkEnd != nil && inner.BookmarkEnd.ID == id {
+					break
+				}
+				writeParagraphChildrenText(&sb, []ctypes.ParagraphChild{inner})
+			}
+			return
+		}
+	})
+
+	return sb.String()
+}
@@ -0,0 +1,86 @@
+package docx
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParagraph_AddPageNumberAndCountFields(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	p := rd.AddParagraph("Page ")
+	p.AddPageNumberField().Bold(true)
+	p.AddText(" of ")
+	p.AddPageCountField()
+
+	out, err := xml.Marshal(p.ct)
+	if err != nil {
+		t.Fatalf("marshal paragraph: %v", err)
+	}
+
+	expected := `<w:p><w:r><w:t xml:space="preserve">Page </w:t></w:r>` +
+		`<w:r><w:rPr><w:b w:val="true"></w:b></w:rPr>` +
+		`<w:fldChar w:fldCharType="begin" w:dirty="true"></w:fldChar>` +
+		`<w:instrText xml:space="preserve"> PAGE </w:instrText>` +
+		`<w:fldChar w:fldCharType="separate"></w:fldChar><w:t>1</w:t>` +
+		`<w:fldChar w:fldCharType="end"></w:fldChar></w:r>` +
+		`<w:r><w:t xml:space="preserve"> of </w:t></w:r>` +
+		`<w:r><w:fldChar w:fldCharType="begin" w:dirty="true"></w:fldChar>` +
+		`<w:instrText xml:space="preserve"> NUMPAGES </w:instrText>` +
+		`<w:fldChar w:fldCharType="separate"></w:fldChar><w:t>1</w:t>` +
+		`<w:fldChar w:fldCharType="end"></w:fldChar></w:r></w:p>`
+	assert.Equal(t, expected, string(out))
+}
+
+func TestParagraph_AddCrossReference(t *testing.T) {
+	rd := setupRootDoc(t)
+	rd.AddParagraph("Figure 1: Sales").AddBookmark("FigSales")
+
+	p := rd.AddParagraph("See ")
+	p.AddCrossReference("FigSales", RefContent|RefHyperlink)
+	p.AddText(" on page ")
+	p.AddCrossReference("FigSales", RefPage)
+	p.AddText(", and ")
+	p.AddCrossReference("Missing", RefContent)
+
+	out, err := xml.Marshal(p.ct)
+	if err != nil {
+		t.Fatalf("marshal paragraph: %v", err)
+	}
+
+	assert.Contains(t, string(out), `<w:instrText xml:space="preserve"> REF FigSales \h </w:instrText>`+
+		`<w:fldChar w:fldCharType="separate"></w:fldChar><w:t>Figure 1: Sales</w:t>`)
+	assert.Contains(t, string(out), `<w:instrText xml:space="preserve"> PAGEREF FigSales </w:instrText>`+
+		`<w:fldChar w:fldCharType="separate"></w:fldChar><w:t>1</w:t>`)
+	assert.Contains(t, string(out), `<w:instrText xml:space="preserve"> REF Missing </w:instrText>`+
+		`<w:fldChar w:fldCharType="separate"></w:fldChar><w:t>Missing</w:t>`)
+	assert.Equal(t, "See Figure 1: Sales on page 1, and Missing", p.Text())
+}
+
+func TestAddField(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	p := rd.AddParagraph("Section page ")
+	p.AddField("PAGE", "1")
+	p.AddText(" of ")
+	p.AddField("SECTIONPAGES", "").Italic(true)
+	run := p.AddText("Printed ").AddField(`PRINTDATE \@ "yyyy-MM-dd"`, "2024-01-31")
+
+	out, err := xml.Marshal(p.ct)
+	if err != nil {
+		t.Fatalf("marshal paragraph: %v", err)
+	}
+
+	assert.Contains(t, string(out), `<w:r><w:rPr><w:i w:val="true"></w:i></w:rPr>`+
+		`<w:fldChar w:fldCharType="begin" w:dirty="true"></w:fldChar>`+
+		`<w:instrText xml:space="preserve"> SECTIONPAGES </w:instrText>`+
+		`<w:fldChar w:fldCharType="end"></w:fldChar></w:r>`)
+	assert.Contains(t, string(out), `<w:r><w:t xml:space="preserve">Printed </w:t>`+
+		`<w:fldChar w:fldCharType="begin" w:dirty="true"></w:fldChar>`+
+		`<w:instrText xml:space="preserve"> PRINTDATE \@ &#34;yyyy-MM-dd&#34; </w:instrText>`+
+		`<w:fldChar w:fldCharType="separate"></w:fldChar><w:t>2024-01-31</w:t>`+
+		`<w:fldChar w:fldCharType="end"></w:fldChar></w:r>`)
+	assert.Len(t, run.ct.Children, 6)
+}
@@ -0,0 +1,146 @@
+package docx
+
+import (
+	"github.com/MamaShip/godocx/common/units"
+	"github.com/MamaShip/godocx/dml"
+	"github.com/MamaShip/godocx/dml/dmlct"
+	"github.com/MamaShip/godocx/dml/dmlst"
+)
+
+// TextWrap is the way the text of the document flows around a floating image.
+type TextWrap int
+
+const (
+	TextWrapSquare        TextWrap = iota // Text wraps around the bounding box of the image
+	TextWrapTight                         // Text wraps tightly around the image
+	TextWrapTopAndBottom                  // Text stops above the image and resumes below it
+	TextWrapBehindText                    // The image is behind the text, which is not wrapped
+	TextWrapInFrontOfText                 // The image is in front of the text, which is not wrapped
+)
+
+// wrapPolygonSize is the size of the coordinate space of a wrapping polygon, as in Word.
+const wrapPolygonSize = 21600
+
+// FloatOptions describes the position and text wrapping of a floating image.
+type FloatOptions struct {
+	// Wrap is the way the text flows around the image.
+	Wrap TextWrap
+
+	// HorizontalFrom is what X is relative to, e.g. dmlst.RelFromHPage; empty for the column.
+	HorizontalFrom dmlst.RelFromH
+
+	// VerticalFrom is what Y is relative to, e.g. dmlst.RelFromVPage; empty for the paragraph.
+	VerticalFrom dmlst.RelFromV
+
+	// X and Y are the offsets of the top left corner of the image from its references.
+	X units.Emu
+	Y units.Emu
+}
+
+// Float turns the image into a floating image (wp:anchor), placed at the given position
+// relative to the page, the margins, the column or the paragraph it is anchored to, with the
+// text flowing around it as set by opts.Wrap. It can be called again to move the image.
+//
+// Returns:
+//   - *Drawing: The drawing instance for method chaining.
+//
+// Example:
+//
+//	logo, err := document.AddEmptyParagraph().AddInlineImageFile("logo.png")
+//	if err == nil {
+//		logo.Float(docx.FloatOptions{
+//			Wrap:           docx.TextWrapSquare,
+//			HorizontalFrom: dmlst.RelFromHPage,
+//			VerticalFrom:   dmlst.RelFromVPage,
+//			X:              units.Inch(0.5).ToEmu(),
+//			Y:              units.Inch(0.5).ToEmu(),
+//		})
+//	}
+func (d *Drawing) Float(opts FloatOptions) *Drawing {
+	if d.anchor == nil && !d.anchorInline() {
+		return d
+	}
+
+	a := d.anchor
+	a.PositionH = dml.PoistionH{RelativeFrom: opts.HorizontalFrom, PosOffset: int(opts.X)}
+	if a.PositionH.RelativeFrom == "" {
+		a.PositionH.RelativeFrom = dmlst.RelFromHColumn
+	}
+	a.PositionV = dml.PoistionV{RelativeFrom: opts.VerticalFrom, PosOffset: int(opts.Y)}
+	if a.PositionV.RelativeFrom == "" {
+		a.PositionV.RelativeFrom = dmlst.RelFromVParagraph
+	}
+
+	a.WrapNone, a.WrapSquare, a.WrapTight, a.WrapThrough, a.WrapTopBtm = nil, nil, nil, nil, nil
+	a.BehindDoc = 0
+	switch opts.Wrap {
+	case TextWrapTight:
+		a.WrapTight = &dml.WrapTight{WrapText: dmlst.WrapTextBothSides, WrapPolygon: dml.WrapPolygon{
+			Start: dmlct.NewPoint2D(0, 0),
+			LineTo: []dmlct.Point2D{
+				dmlct.NewPoint2D(0, wrapPolygonSize),
+				dmlct.NewPoint2D(wrapPolygonSize, wrapPolygonSize),
+				dmlct.NewPoint2D(wrapPolygonSize, 0),
+				dmlct.NewPoint2D(0, 0),
+			},
+		}}
+	case TextWrapTopAndBottom:
+		a.WrapTopBtm = &dml.WrapTopBtm{}
+	case TextWrapBehindText:
+		a.WrapNone = &dml.WrapNone{}
+		a.BehindDoc = 1
+	case TextWrapInFrontOfText:
+		a.WrapNone = &dml.WrapNone{}
+	default:
+		a.WrapSquare = &dml.WrapSquare{WrapText: dmlst.WrapTextBothSides}
+	}
+	return d
+}
+
+// IsFloating reports whether the image is a floating image, see Float.
+func (d *Drawing) IsFloating() bool {
+	return d.anchor != nil
+}
+
+// anchorInline replaces the inline drawing by an anchored one in the paragraph, and reports
+// whether the inline drawing was found.
+func (d *Drawing) anchorInline() bool {
+	for _, child := range d.para.ct.Children {
+		if child.Run == nil {
+			continue
+		}
+		for _, runChild := range child.Run.Children {
+			drawing := runChild.Drawing
+			if drawing == nil {
+				continue
+			}
+			for i := range drawing.Inline {
+				if &drawing.Inline[i] != d.inline {
+					continue
+				}
+
+				inline := drawing.Inline[i]
+				anchor := dml.NewAnchor()
+				anchor.SimplePos = dmlct.NewPoint2D(0, 0)
+				anchor.LayoutInCell = 1
+				anchor.AllowOverlap = 1
+				anchor.RelativeHeight = int(inline.DocProp.ID)
+				anchor.Extent = inline.Extent
+				anchor.EffectExtent = inline.EffectExtent
+				if anchor.EffectExtent == nil {
+					anchor.EffectExtent = dml.NewEffectExtent(0, 0, 0, 0)
+				}
+				anchor.DocProp = inline.DocProp
+				anchor.CNvGraphicFramePr = inline.CNvGraphicFramePr
+				anchor.Graphic = inline.Graphic
+
+				drawing.Inline = append(drawing.Inline[:i], drawing.Inline[i+1:]...)
+				drawing.Anchor = append(drawing.Anchor, anchor)
+				d.inline = nil
+				d.anchor = anchor
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,274 @@
+package docx
+
+import (
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/MamaShip/godocx/common/constants"
+)
+
+// FontStyle is the style of a font embedded in the document, e.g. the bold typeface of a family.
+type FontStyle string
+
+const (
+	FontStyleRegular    FontStyle = "embedRegular"
+	FontStyleBold       FontStyle = "embedBold"
+	FontStyleItalic     FontStyle = "embedItalic"
+	FontStyleBoldItalic FontStyle = "embedBoldItalic"
+)
+
+// fontStyles lists the font styles in the order of their elements in w:font.
+var fontStyles = []FontStyle{FontStyleRegular, FontStyleBold, FontStyleItalic, FontStyleBoldItalic}
+
+var (
+	// ErrEmptyFont is returned when the font to embed has no data or no name.
+	ErrEmptyFont = errors.New("font data or name is empty")
+
+	// ErrInvalidFontStyle is returned when a font is embedded with an unknown style.
+	ErrInvalidFontStyle = errors.New("invalid font style")
+)
+
+// obfuscatedHeaderSize is the number of bytes at the start of an embedded font that are
+// obfuscated with the font key.
+const obfuscatedHeaderSize = 32
+
+// embedTrueTypeFontsSuccessors lists settings that follow w:embedTrueTypeFonts in the
+// schema order and are commonly present.
+var embedTrueTypeFontsSuccessors = append([]string{
+	"<w:embedSystemFonts",
+	"<w:saveSubsetFonts",
+	"<w:saveFormsData",
+	"<w:mirrorMargins",
+	"<w:gutterAtTop",
+	"<w:hideSpellingErrors",
+	"<w:hideGrammaticalErrors",
+	"<w:proofState",
+	"<w:attachedTemplate",
+	"<w:trackRevisions",
+	"<w:documentProtection",
+}, documentProtectionSuccessors...)
+
+var fontEmbedPattern = regexp.MustCompile(`<w:(embed(?:Regular|Bold|Italic|BoldItalic))\b[^>]*/>`)
+
+// EmbedFont embeds a TrueType or OpenType font in the document, so that it renders with
+// this font on computers where the font is not installed.
+//
+// The font is stored under word/fonts/ obfuscated with a random key as required by Word
+// (ECMA-376 Part 1, 17.8.1), and referenced by the entry of the font in the font table,
+// which is created if missing. Embedding of TrueType fonts is enabled in the settings.
+// Embedding another font for the same name and style replaces the reference to the previous one.
+//
+// Parameters:
+//   - r: The font file, e.g. a .ttf file.
+//   - fontName: The name of the font family, as used by the runs, e.g. "Open Sans".
+//   - style: The typeface of the family the font file holds, e.g. FontStyleBold.
+//
+// Returns:
+//   - error: ErrEmptyFont if the font or its name is empty, ErrInvalidFontStyle for an unknown
+//     style, ErrNoSettings if the document has no settings part, or the error reading r.
+//
+// Example:
+//
+//	file, _ := os.Open("OpenSans-Regular.ttf")
+//	defer file.Close()
+//	err := document.EmbedFont(file, "Open Sans", docx.FontStyleRegular)
+func (rd *RootDoc) EmbedFont(r io.Reader, fontName string, style FontStyle) error {
+	if !isFontStyle(style) {
+		return fmt.Errorf("%w: %q", ErrInvalidFontStyle, style)
+	}
+	if _, ok := rd.FileMap.Load(settingsPath); !ok {
+		return ErrNoSettings
+	}
+
+	font, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	if len(font) == 0 || fontName == "" {
+		return ErrEmptyFont
+	}
+
+	fontKey, err := newGUID()
+	if err != nil {
+		return err
+	}
+
+	tablePath := rd.fontTablePath()
+	fontPath := rd.nextFontPath(tablePath)
+	rd.FileMap.Store(fontPath, obfuscateFont(font, fontKey))
+	_ = rd.ContentType.AddExtension("odttf", constants.ContentTypeObfuscatedFont)
+
+	relsPath := path.Join(path.Dir(tablePath), "_rels", path.Base(tablePath)+".rels")
+	rID, err := rd.addPartRelation(relsPath, Relationship{
+		Type:   constants.SourceRelationshipFont,
+		Target: strings.TrimPrefix(fontPath, path.Dir(tablePath)+"/"),
+	})
+	if err != nil {
+		return err
+	}
+
+	embed := `<w:` + string(style) + ` r:id="` + rID + `" w:fontKey="` + fontKey + `"/>`
+	rd.setFontEmbed(tablePath, fontName, style, embed)
+
+	rd.replaceSetting("embedTrueTypeFonts", `<w:embedTrueTypeFonts/>`, embedTrueTypeFontsSuccessors)
+	return nil
+}
+
+func isFontStyle(style FontStyle) bool {
+	for _, s := range fontStyles {
+		if s == style {
+			return true
+		}
+	}
+	return false
+}
+
+// newGUID returns a random GUID in registry format, e.g. {4D3F2C1B-...}, as used for the key
+// obfuscating an embedded font and the item ID of a custom XML part.
+func newGUID() (string, error) {
+	guid, err := randomBytes(16)
+	if err != nil {
+		return "", err
+	}
+	guid[6] = guid[6]&0x0F | 0x40 // Version 4
+	guid[8] = guid[8]&0x3F | 0x80 // Variant 10
+
+	h := strings.ToUpper(hex.EncodeToString(guid))
+	return "{" + h[0:8] + "-" + h[8:12] + "-" + h[12:16] + "-" + h[16:20] + "-" + h[20:] + "}", nil
+}
+
+// obfuscateFont returns a copy of the font whose first 32 bytes are XORed with the bytes of
+// the font key, taken from the last hex digits of the key to the first. The obfuscation is
+// its own inverse.
+func obfuscateFont(font []byte, fontKey string) []byte {
+	digits := strings.NewReplacer("{", "", "}", "", "-", "").Replace(fontKey)
+	key := make([]byte, len(digits)/2)
+	for i := range key {
+		b, _ := strconv.ParseUint(digits[len(digits)-2*i-2:len(digits)-2*i], 16, 8)
+		key[i] = byte(b)
+	}
+
+	out := append([]byte{}, font...)
+	for i := 0; i < obfuscatedHeaderSize && i < len(out); i++ {
+		out[i] ^= key[i%len(key)]
+	}
+	return out
+}
+
+// fontTablePath returns the path of the font table part of the document, creating an empty
+// one if the document has none.
+func (rd *RootDoc) fontTablePath() string {
+	for _, rel := range rd.Document.DocRels.Relationships {
+		if rel.Type != constants.SourceRelationshipFontTable {
+			continue
+		}
+		if strings.HasPrefix(rel.Target, "/") {
+			return strings.TrimPrefix(rel.Target, "/")
+		}
+		return "word/" + rel.Target
+	}
+
+	const tablePath = "word/fontTable.xml"
+	rd.Document.addRelation(constants.SourceRelationshipFontTable, "fontTable.xml")
+	_ = rd.ContentType.AddOverride("/"+tablePath, constants.ContentTypeFontTable)
+	rd.FileMap.Store(tablePath, []byte(string(constants.XMLHeader)+
+		`<w:fonts xmlns:w="`+constants.XMLNS_W+`" xmlns:r="`+constants.XMLNS_R+`"></w:fonts>`))
+	return tablePath
+}
+
+// nextFontPath returns the path of a new embedded font, in the fonts folder next to the font table.
+func (rd *RootDoc) nextFontPath(tablePath string) string {
+	for i := 1; ; i++ {
+		fontPath := path.Join(path.Dir(tablePath), "fonts", "font"+strconv.Itoa(i)+".odttf")
+		if _, ok := rd.FileMap.Load(fontPath); !ok {
+			return fontPath
+		}
+	}
+}
+
+// addPartRelation adds a relationship with the type, target and target mode of rel to the
+// relationships part at relsPath, creating it if needed, and returns the ID of the relationship.
+func (rd *RootDoc) addPartRelation(relsPath string, rel Relationship) (string, error) {
+	rels := Relationships{Xmlns: constants.XMLNS}
+	if content, ok := rd.FileMap.Load(relsPath); ok {
+		if err := xml.Unmarshal(content.([]byte), &rels); err != nil {
+			return "", err
+		}
+		rels.Xmlns = constants.XMLNS
+	}
+
+	next := 1
+	for _, rel := range rels.Relationships {
+		if n, err := strconv.Atoi(strings.TrimPrefix(rel.ID, "rId")); err == nil && n >= next {
+			next = n + 1
+		}
+	}
+
+	rel.ID = "rId" + strconv.Itoa(next)
+	rels.Relationships = append(rels.Relationships, &rel)
+
+	content, err := marshal(rels)
+	if err != nil {
+		return "", err
+	}
+	rd.FileMap.Store(relsPath, content)
+	return rel.ID, nil
+}
+
+// setFontEmbed sets the embedded font reference of the given style of the font table entry
+// of the font, adding an entry for the font if needed.
+func (rd *RootDoc) setFontEmbed(tablePath, fontName string, style FontStyle, embed string) {
+	existing, _ := rd.FileMap.Load(tablePath)
+	content := string(existing.([]byte))
+
+	// The embedded fonts are referenced by relationship IDs
+	if start := strings.Index(content, "<w:fonts"); start >= 0 {
+		if end := strings.Index(content[start:], ">"); end >= 0 && !strings.Contains(content[start:start+end], "xmlns:r=") {
+			content = content[:start] + `<w:fonts xmlns:r="` + constants.XMLNS_R + `"` + content[start+len("<w:fonts"):]
+		}
+	}
+
+	name := regexp.QuoteMeta(escapeAttr(fontName))
+	entry := regexp.MustCompile(`(?s)<w:font\b[^>]*\bw:name="` + name + `"[^>]*?(?:/>|>.*?</w:font>)`)
+
+	loc := entry.FindStringIndex(content)
+	if loc == nil {
+		font := `<w:font w:name="` + escapeAttr(fontName) + `"><w:charset w:val="00"/><w:family w:val="auto"/><w:pitch w:val="variable"/>` +
+			embed + `</w:font>`
+		if end := strings.LastIndex(content, "</w:fonts>"); end >= 0 {
+			content = content[:end] + font + content[end:]
+		}
+		rd.FileMap.Store(tablePath, []byte(content))
+		return
+	}
+
+	font := content[loc[0]:loc[1]]
+	if strings.HasSuffix(font, "/>") {
+		font = strings.TrimSuffix(font, "/>") + "></w:font>"
+	}
+
+	// The embed elements come last, in the order of fontStyles
+	embeds := map[FontStyle]string{style: embed}
+	for _, match := range fontEmbedPattern.FindAllStringSubmatch(font, -1) {
+		if FontStyle(match[1]) != style {
+			embeds[FontStyle(match[1])] = match[0]
+		}
+	}
+	font = fontEmbedPattern.ReplaceAllString(font, "")
+
+	var sb strings.Builder
+	sb.WriteString(strings.TrimSuffix(font, "</w:font>"))
+	for _, s := range fontStyles {
+		sb.WriteString(embeds[s])
+	}
+	sb.WriteString("</w:font>")
+
+	rd.FileMap.Store(tablePath, []byte(content[:loc[0]]+sb.String()+content[loc[1]:]))
+}
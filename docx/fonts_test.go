@@ -0,0 +1,71 @@
+package docx_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"regexp"
+	"strings"
+	"testing"
+
+	godocx "github.com/MamaShip/godocx"
+	"github.com/MamaShip/godocx/docx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmbedFont(t *testing.T) {
+	rd, err := godocx.NewDocument()
+	require.NoError(t, err)
+	rd.AddParagraph("Branded").AddText(" text").Font("Brand Sans")
+
+	regular := bytes.Repeat([]byte("REGULAR-FONT-DATA"), 10)
+	bold := bytes.Repeat([]byte("BOLD-FONT-DATA"), 10)
+	require.NoError(t, rd.EmbedFont(bytes.NewReader(bold), "Brand Sans", docx.FontStyleBold))
+	require.NoError(t, rd.EmbedFont(bytes.NewReader(regular), "Brand Sans", docx.FontStyleRegular))
+
+	files, _ := writeParts(t, rd)
+
+	fontTable := string(files["word/fontTable.xml"])
+	entry := regexp.MustCompile(`(?s)<w:font w:name="Brand Sans">.*?</w:font>`).FindString(fontTable)
+	require.NotEmpty(t, entry)
+	embeds := regexp.MustCompile(`<w:(embed\w+) r:id="(rId\d+)" w:fontKey="(\{[0-9A-F-]{36}\})"/>`).FindAllStringSubmatch(entry, -1)
+	require.Len(t, embeds, 2)
+	assert.Equal(t, "embedRegular", embeds[0][1], "embed elements follow the schema order")
+	assert.Equal(t, "embedBold", embeds[1][1])
+	assert.Contains(t, fontTable, `xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"`)
+
+	rels := string(files["word/_rels/fontTable.xml.rels"])
+	for _, embed := range embeds {
+		target := regexp.MustCompile(`Id="` + embed[2] + `" Type="[^"]*/relationships/font" Target="(fonts/font\d\.odttf)"`).FindStringSubmatch(rels)
+		require.NotNil(t, target, embed[2])
+
+		// Undo the obfuscation with the font key, read from the last byte to the first
+		digits := strings.NewReplacer("{", "", "}", "", "-", "").Replace(embed[3])
+		guid, err := hex.DecodeString(digits)
+		require.NoError(t, err)
+		font := append([]byte{}, files["word/"+target[1]]...)
+		for i := 0; i < 32; i++ {
+			font[i] ^= guid[15-i%16]
+		}
+
+		expected := regular
+		if embed[1] == "embedBold" {
+			expected = bold
+		}
+		assert.Equal(t, expected, font)
+		assert.NotEqual(t, expected, files["word/"+target[1]])
+	}
+
+	assert.Contains(t, string(files["[Content_Types].xml"]), `<Default Extension="odttf" ContentType="application/vnd.openxmlformats-officedocument.obfuscatedFont">`)
+	assert.Contains(t, string(files["word/settings.xml"]), `<w:embedTrueTypeFonts/>`)
+	assert.Empty(t, rd.Validate())
+}
+
+func TestEmbedFont_Errors(t *testing.T) {
+	rd, err := godocx.NewDocument()
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, rd.EmbedFont(bytes.NewReader(nil), "Brand Sans", docx.FontStyleRegular), docx.ErrEmptyFont)
+	assert.ErrorIs(t, rd.EmbedFont(strings.NewReader("data"), "", docx.FontStyleRegular), docx.ErrEmptyFont)
+	assert.ErrorIs(t, rd.EmbedFont(strings.NewReader("data"), "Brand Sans", "embedHeavy"), docx.ErrInvalidFontStyle)
+}
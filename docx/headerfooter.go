@@ -0,0 +1,314 @@
+package docx
+
+import (
+	"encoding/xml"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/MamaShip/godocx/common/constants"
+	"github.com/MamaShip/godocx/wml/ctypes"
+	"github.com/MamaShip/godocx/wml/stypes"
+)
+
+// HeaderFooterType selects the pages of a section a header or footer is displayed on.
+type HeaderFooterType string
+
+const (
+	HeaderFooterDefault HeaderFooterType = "default" // All pages not covered by another type
+	HeaderFooterEven    HeaderFooterType = "even"    // Even numbered pages
+	HeaderFooterFirst   HeaderFooterType = "first"   // First page of the section
+)
+
+// headerFooter holds the content of a header or footer part.
+type headerFooter struct {
+	root     *RootDoc
+	Children []DocumentChild
+
+	elemName string // w:hdr or w:ftr
+	path     string // Path of the part in the package, e.g. word/header1.xml
+}
+
+// Header is the content displayed at the top of the pages of a section.
+type Header struct {
+	*headerFooter
+}
+
+// Footer is the content displayed at the bottom of the pages of a section.
+type Footer struct {
+	*headerFooter
+}
+
+// AddParagraph adds a new paragraph with the specified text to the header or footer.
+//
+// The pictures and hyperlinks added to the paragraph are referenced from the relationships
+// of the header or footer part.
+//
+// Example:
+//
+//	header := document.AddHeader(docx.HeaderFooterDefault)
+//	_, err := header.AddEmptyParagraph().AddPicture("logo.png", units.Inch(1), units.Inch(0.5))
+//	header.AddParagraph("Quarterly report")
+func (hf *headerFooter) AddParagraph(text string) *Paragraph {
+	p := newParagraph(hf.root)
+	p.hf = hf
+	p.AddText(text)
+	hf.Children = append(hf.Children, DocumentChild{Para: p})
+	return p
+}
+
+// AddEmptyParagraph adds a new empty paragraph to the header or footer.
+func (hf *headerFooter) AddEmptyParagraph() *Paragraph {
+	p := newParagraph(hf.root)
+	p.hf = hf
+	hf.Children = append(hf.Children, DocumentChild{Para: p})
+	return p
+}
+
+// MarshalXML implements the xml.Marshaler interface for the header or footer part.
+func (hf headerFooter) MarshalXML(e *xml.Encoder, start xml.StartElement) (err error) {
+	start.Name.Local = hf.elemName
+	start.Attr = append(start.Attr, docAttrs...)
+
+	if err = e.EncodeToken(start); err != nil {
+		return err
+	}
+
+	if err = marshalChildren(e, hf.Children); err != nil {
+		return err
+	}
+
+	return e.EncodeToken(xml.EndElement{Name: start.Name})
+}
+
+// AddHeader returns the header of the given type for the last section of the document,
+// creating the header part if the section does not reference one yet.
+//
+// Requesting a HeaderFooterFirst header enables a distinct first page (w:titlePg) for the
+// section, and requesting a HeaderFooterEven header enables distinct even and odd page
+// headers in the document settings.
+//
+// Returns nil if the section references a header of the type whose part cannot be parsed;
+// the part is left as loaded then.
+//
+// Example:
+//
+//	header := document.AddHeader(docx.HeaderFooterDefault)
+//	header.AddParagraph("Quarterly report")
+func (rd *RootDoc) AddHeader(hfType HeaderFooterType) *Header {
+	hf := rd.headerFooterPart(rd.ensureSectPr(), hfType, false)
+	if hf == nil {
+		return nil
+	}
+	return &Header{hf}
+}
+
+// AddFooter returns the footer of the given type for the last section of the document,
+// creating the footer part if the section does not reference one yet.
+//
+// See AddHeader for the handling of the footer types.
+func (rd *RootDoc) AddFooter(hfType HeaderFooterType) *Footer {
+	hf := rd.headerFooterPart(rd.ensureSectPr(), hfType, true)
+	if hf == nil {
+		return nil
+	}
+	return &Footer{hf}
+}
+
+// SetDifferentFirstPage sets whether the first page of the last section of the document has
+// its own header and footer. See SectionProperties.SetDifferentFirstPage for details.
+func (rd *RootDoc) SetDifferentFirstPage(value bool) {
+	rd.lastSection().SetDifferentFirstPage(value)
+}
+
+// SetEvenAndOddHeaders sets whether even pages use the headers and footers of type
+// HeaderFooterEven and odd pages the ones of type HeaderFooterDefault (w:evenAndOddHeaders
+// in the document settings). The setting applies to all the sections of the document.
+//
+// Adding a header or footer of type HeaderFooterEven enables it.
+func (rd *RootDoc) SetEvenAndOddHeaders(value bool) {
+	if !value {
+		rd.replaceSetting("evenAndOddHeaders", "", nil)
+		return
+	}
+	rd.enableEvenAndOddHeaders()
+}
+
+// headerFooterPart finds or creates the header or footer part of the given type
+// referenced by the section properties. It returns nil if the referenced part cannot be
+// parsed, rather than pointing the reference at a new, empty part.
+func (rd *RootDoc) headerFooterPart(sectPr *ctypes.SectionProp, hfType HeaderFooterType, footer bool) *headerFooter {
+	if hfType == "" {
+		hfType = HeaderFooterDefault
+	}
+
+	switch hfType {
+	case HeaderFooterFirst:
+		sectPr.TitlePg = ctypes.NewGenSingleStrVal(stypes.OnOffTrue)
+	case HeaderFooterEven:
+		rd.enableEvenAndOddHeaders()
+	}
+
+	name, elemName, relType, contentType := "header", "w:hdr", constants.SourceRelationshipHeader, constants.ContentTypeHeader
+	if footer {
+		name, elemName, relType, contentType = "footer", "w:ftr", constants.SourceRelationshipFooter, constants.ContentTypeFooter
+	}
+
+	// Reuse the part already referenced for this type, e.g. when the document was loaded from a file
+	refIdx := -1
+	if footer {
+		for i, ref := range sectPr.FooterReference {
+			if ref.Type == stypes.HdrFtrType(hfType) {
+				refIdx = i
+			}
+		}
+	} else {
+		for i, ref := range sectPr.HeaderReference {
+			if ref.Type == stypes.HdrFtrType(hfType) {
+				refIdx = i
+			}
+		}
+	}
+
+	if refIdx >= 0 {
+		refID := sectPr.HeaderReference[refIdx].ID
+		if footer {
+			refID = sectPr.FooterReference[refIdx].ID
+		}
+		hf, err := rd.loadHeaderFooter(refID, elemName)
+		if err != nil {
+			rd.LogDebug("kept unparsable "+name+" part", "id", refID, "error", err)
+			return nil
+		}
+		if hf != nil {
+			return hf
+		}
+	}
+
+	path := rd.nextHeaderFooterPath(name)
+	target := strings.TrimPrefix(path, "word/")
+	rID := rd.Document.addRelation(relType, target)
+	_ = rd.ContentType.AddOverride("/"+path, contentType)
+
+	// A reference whose part cannot be found is pointed at the new part
+	switch {
+	case footer && refIdx >= 0:
+		sectPr.FooterReference[refIdx].ID = rID
+	case footer:
+		sectPr.FooterReference = append(sectPr.FooterReference, ctypes.FooterReference{Type: stypes.HdrFtrType(hfType), ID: rID})
+	case refIdx >= 0:
+		sectPr.HeaderReference[refIdx].ID = rID
+	default:
+		sectPr.HeaderReference = append(sectPr.HeaderReference, ctypes.HeaderReference{Type: stypes.HdrFtrType(hfType), ID: rID})
+	}
+
+	hf := &headerFooter{root: rd, elemName: elemName, path: path}
+	rd.storeHeaderFooter(hf)
+	return hf
+}
+
+// loadHeaderFooter returns the part targeted by the relationship with the given ID,
+// parsing it from the file map the first time it is requested.
+// It returns nil if the relationship or the part cannot be found, and the parse error if
+// the part cannot be parsed.
+func (rd *RootDoc) loadHeaderFooter(rID, elemName string) (*headerFooter, error) {
+	var target string
+	for _, rel := range rd.Document.DocRels.Relationships {
+		if rel.ID == rID {
+			target = rel.Target
+			break
+		}
+	}
+	if target == "" {
+		return nil, nil
+	}
+
+	path := "word/" + target
+	if strings.HasPrefix(target, "/") {
+		path = strings.TrimPrefix(target, "/")
+	}
+
+	if hf, ok := rd.headerFooters[path]; ok {
+		return hf, nil
+	}
+
+	content, ok := rd.FileMap.Load(path)
+	if !ok {
+		return nil, nil
+	}
+
+	body := NewBody(rd)
+	d, release := rd.newDecoder(content.([]byte))
+	defer release()
+	if err := d.Decode(body); err != nil {
+		return nil, fmt.Errorf("%w: %s", err, path)
+	}
+
+	hf := &headerFooter{root: rd, Children: body.Children, elemName: elemName, path: path}
+	for _, child := range hf.Children {
+		if child.Para != nil {
+			child.Para.hf = hf
+		}
+		if child.Sdt != nil {
+			child.Sdt.hf = hf
+			for _, p := range child.Sdt.Paragraphs() {
+				p.hf = hf
+			}
+		}
+	}
+	rd.storeHeaderFooter(hf)
+	return hf, nil
+}
+
+// addRelation adds the relationship to the relationships part of the header or footer and
+// returns its ID.
+func (hf *headerFooter) addRelation(rel Relationship) string {
+	relsPath := path.Join(path.Dir(hf.path), "_rels", path.Base(hf.path)+".rels")
+	rID, err := hf.root.addPartRelation(relsPath, rel)
+	if err != nil {
+		hf.root.LogDebug("could not add relationship to header or footer", "part", hf.path, "error", err)
+	}
+	return rID
+}
+
+func (rd *RootDoc) storeHeaderFooter(hf *headerFooter) {
+	if rd.headerFooters == nil {
+		rd.headerFooters = make(map[string]*headerFooter)
+	}
+	rd.headerFooters[hf.path] = hf
+}
+
+// nextHeaderFooterPath returns the first free part path of the form word/<name>N.xml.
+func (rd *RootDoc) nextHeaderFooterPath(name string) string {
+	for i := 1; ; i++ {
+		path := fmt.Sprintf("word/%s%d.xml", name, i)
+		if _, ok := rd.headerFooters[path]; ok {
+			continue
+		}
+		if _, ok := rd.FileMap.Load(path); ok {
+			continue
+		}
+		return path
+	}
+}
+
+// evenAndOddSuccessors lists the settings that follow w:evenAndOddHeaders in the schema order
+// and are commonly present; the element is inserted before the earliest one found.
+var evenAndOddSuccessors = []string{
+	"<w:bookFoldRevPrinting",
+	"<w:bookFoldPrinting",
+	"<w:drawingGridHorizontalSpacing",
+	"<w:drawingGridVerticalSpacing",
+	"<w:displayHorizontalDrawingGridEvery",
+	"<w:displayVerticalDrawingGridEvery",
+	"<w:characterSpacingControl",
+	"<w:compat",
+	"<w:rsids",
+	"</w:settings>",
+}
+
+// enableEvenAndOddHeaders adds w:evenAndOddHeaders to settings.xml so that even page
+// headers and footers are used.
+func (rd *RootDoc) enableEvenAndOddHeaders() {
+	rd.replaceSetting("evenAndOddHeaders", "<w:evenAndOddHeaders/>", evenAndOddSuccessors)
+}
@@ -0,0 +1,250 @@
+package docx_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"regexp"
+	"strings"
+	"testing"
+
+	godocx "github.com/MamaShip/godocx"
+	"github.com/MamaShip/godocx/common/constants"
+	"github.com/MamaShip/godocx/common/units"
+	"github.com/MamaShip/godocx/docx"
+	"github.com/MamaShip/godocx/packager"
+	"github.com/MamaShip/godocx/wml/stypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeParts(t *testing.T, rd *docx.RootDoc) (map[string][]byte, []byte) {
+	t.Helper()
+
+	var buf bytes.Buffer
+	require.NoError(t, rd.Write(&buf))
+
+	content := buf.Bytes()
+	files, err := packager.ReadFromZip(&content)
+	require.NoError(t, err)
+	return files, content
+}
+
+// zipParts packages the parts as a document, e.g. parts returned by writeParts and then altered.
+func zipParts(t *testing.T, files map[string][]byte) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write(content)
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func TestAddHeaderAndFooter(t *testing.T) {
+	rd, err := godocx.NewDocument()
+	require.NoError(t, err)
+
+	rd.AddHeader(docx.HeaderFooterDefault).AddParagraph("Quarterly report")
+	rd.AddHeader(docx.HeaderFooterFirst).AddParagraph("Cover")
+	rd.AddFooter(docx.HeaderFooterDefault).AddParagraph("Confidential")
+	rd.AddParagraph("Body")
+
+	files, _ := writeParts(t, rd)
+
+	header1 := string(files["word/header1.xml"])
+	assert.Contains(t, header1, "<w:hdr ")
+	assert.Contains(t, header1, "<w:t>Quarterly report</w:t>")
+	assert.Contains(t, string(files["word/header2.xml"]), "<w:t>Cover</w:t>")
+
+	footer1 := string(files["word/footer1.xml"])
+	assert.Contains(t, footer1, "<w:ftr ")
+	assert.Contains(t, footer1, "<w:t>Confidential</w:t>")
+
+	document := string(files["word/document.xml"])
+	assert.Regexp(t, `<w:headerReference w:type="default" r:id="rId\d+"></w:headerReference>`, document)
+	assert.Regexp(t, `<w:headerReference w:type="first" r:id="rId\d+"></w:headerReference>`, document)
+	assert.Regexp(t, `<w:footerReference w:type="default" r:id="rId\d+"></w:footerReference>`, document)
+	assert.Contains(t, document, `<w:titlePg w:val="true"></w:titlePg>`)
+
+	rels := string(files["word/_rels/document.xml.rels"])
+	assert.Contains(t, rels, `Target="header1.xml"`)
+	assert.Contains(t, rels, `Target="header2.xml"`)
+	assert.Contains(t, rels, `Target="footer1.xml"`)
+
+	contentTypes := string(files["[Content_Types].xml"])
+	assert.Contains(t, contentTypes, `PartName="/word/header1.xml"`)
+	assert.Contains(t, contentTypes, `PartName="/word/footer1.xml"`)
+}
+
+func TestAddHeaderAndFooter_PicturesAndLinks(t *testing.T) {
+	rd, err := godocx.NewDocument()
+	require.NoError(t, err)
+	rd.AddParagraph("Body").AddLink("body link", "https://example.com/body")
+
+	header := rd.AddHeader(docx.HeaderFooterDefault)
+	_, err = header.AddEmptyParagraph().AddPicture("../godocx.png", units.Inch(1), units.Inch(0.5))
+	require.NoError(t, err)
+	header.AddParagraph("See ").AddLink("the site", "https://example.com")
+
+	files, content := writeParts(t, rd)
+	headerRels := string(files["word/_rels/header1.xml.rels"])
+	assert.Regexp(t, `Id="rId1" Type="[^"]+/image" Target="media/image\d+\.png"`, headerRels)
+	assert.Contains(t, headerRels, `Id="rId2" Type="`+constants.SourceRelationshipHyperLink+`" Target="https://example.com" TargetMode="External"`)
+	assert.Contains(t, string(files["word/header1.xml"]), `r:embed="rId1"`)
+	assert.Contains(t, string(files["word/header1.xml"]), `<w:hyperlink r:id="rId2">`)
+
+	// The main document only references its own link
+	rels := string(files["word/_rels/document.xml.rels"])
+	assert.NotContains(t, rels, "/image")
+	assert.NotContains(t, rels, `Target="https://example.com"`)
+	assert.Contains(t, rels, `Target="https://example.com/body"`)
+
+	// Paragraphs of loaded headers add to the relationships of the header
+	loaded, err := godocx.ReadDocx(bytes.NewReader(content), int64(len(content)))
+	require.NoError(t, err)
+	loaded.AddHeader(docx.HeaderFooterDefault).AddParagraph("").AddLink("contact", "https://example.com/contact")
+
+	files, _ = writeParts(t, loaded)
+	headerRels = string(files["word/_rels/header1.xml.rels"])
+	assert.Contains(t, headerRels, `Id="rId3" Type="`+constants.SourceRelationshipHyperLink+`" Target="https://example.com/contact" TargetMode="External"`)
+	assert.Contains(t, string(files["word/header1.xml"]), `<w:hyperlink r:id="rId3">`)
+}
+
+func TestAddHeader_SameTypeReturnsSamePart(t *testing.T) {
+	rd, err := godocx.NewDocument()
+	require.NoError(t, err)
+
+	rd.AddHeader(docx.HeaderFooterDefault).AddParagraph("One")
+	rd.AddHeader(docx.HeaderFooterDefault).AddParagraph("Two")
+
+	files, _ := writeParts(t, rd)
+
+	assert.NotContains(t, files, "word/header2.xml")
+	assert.Contains(t, string(files["word/header1.xml"]), "<w:t>Two</w:t>")
+	assert.Equal(t, 1, strings.Count(string(files["word/document.xml"]), "<w:headerReference"))
+}
+
+func TestAddHeader_RoundTripDoesNotDuplicate(t *testing.T) {
+	rd, err := godocx.NewDocument()
+	require.NoError(t, err)
+	rd.AddHeader(docx.HeaderFooterDefault).AddParagraph("Original")
+	rd.AddFooter(docx.HeaderFooterDefault).AddParagraph("Page")
+
+	_, content := writeParts(t, rd)
+
+	reopened, err := packager.Unpack(&content)
+	require.NoError(t, err)
+
+	header := reopened.AddHeader(docx.HeaderFooterDefault)
+	require.Len(t, header.Children, 1)
+	assert.Equal(t, "Original", header.Children[0].Para.Text())
+	header.AddParagraph("Added")
+	reopened.AddFooter(docx.HeaderFooterDefault)
+
+	files, _ := writeParts(t, reopened)
+
+	assert.NotContains(t, files, "word/header2.xml")
+	assert.NotContains(t, files, "word/footer2.xml")
+	header1 := string(files["word/header1.xml"])
+	assert.Contains(t, header1, "<w:t>Original</w:t>")
+	assert.Contains(t, header1, "<w:t>Added</w:t>")
+	assert.Contains(t, string(files["word/footer1.xml"]), "<w:t>Page</w:t>")
+
+	document := string(files["word/document.xml"])
+	assert.Equal(t, 1, strings.Count(document, "<w:headerReference"))
+	assert.Equal(t, 1, strings.Count(document, "<w:footerReference"))
+	assert.Equal(t, 1, strings.Count(string(files["word/_rels/document.xml.rels"]), `Target="header1.xml"`))
+}
+
+func TestAddHeader_UnparsablePartIsKept(t *testing.T) {
+	rd, err := godocx.NewDocument()
+	require.NoError(t, err)
+	rd.AddHeader(docx.HeaderFooterDefault).AddParagraph("Original")
+
+	files, _ := writeParts(t, rd)
+	header := strings.Replace(string(files["word/header1.xml"]), "<w:p>", `<w:p><w:pPr><w:ind w:left="1cm"/></w:pPr>`, 1)
+	files["word/header1.xml"] = []byte(header)
+	content := zipParts(t, files)
+
+	reopened, err := packager.Unpack(&content)
+	require.NoError(t, err)
+	assert.Nil(t, reopened.AddHeader(docx.HeaderFooterDefault))
+
+	// The header is saved as loaded and the section still references it
+	files, _ = writeParts(t, reopened)
+	assert.Equal(t, header, string(files["word/header1.xml"]))
+	assert.NotContains(t, files, "word/header2.xml")
+	assert.Equal(t, 1, strings.Count(string(files["word/document.xml"]), "<w:headerReference"))
+	assert.Equal(t, 1, strings.Count(string(files["word/_rels/document.xml.rels"]), "relationships/header\""))
+}
+
+func TestAddHeader_EvenEnablesEvenAndOddHeaders(t *testing.T) {
+	rd, err := godocx.NewDocument()
+	require.NoError(t, err)
+
+	rd.AddHeader(docx.HeaderFooterEven).AddParagraph("Even")
+	rd.AddFooter(docx.HeaderFooterEven)
+
+	files, _ := writeParts(t, rd)
+
+	settings := string(files["word/settings.xml"])
+	assert.Equal(t, 1, strings.Count(settings, "<w:evenAndOddHeaders/>"))
+	assert.Less(t, strings.Index(settings, "<w:evenAndOddHeaders/>"), strings.Index(settings, "<w:characterSpacingControl"))
+	assert.NotContains(t, string(files["word/document.xml"]), "<w:titlePg")
+}
+
+func TestSetEvenAndOddHeaders(t *testing.T) {
+	rd, err := godocx.NewDocument()
+	require.NoError(t, err)
+
+	rd.SetEvenAndOddHeaders(true)
+	rd.SetEvenAndOddHeaders(true)
+	files, _ := writeParts(t, rd)
+	assert.Equal(t, 1, strings.Count(string(files["word/settings.xml"]), "<w:evenAndOddHeaders/>"))
+
+	rd.SetEvenAndOddHeaders(false)
+	files, _ = writeParts(t, rd)
+	assert.NotContains(t, string(files["word/settings.xml"]), "evenAndOddHeaders")
+}
+
+func TestSectionHeadersAndFooters(t *testing.T) {
+	rd, err := godocx.NewDocument()
+	require.NoError(t, err)
+
+	rd.AddParagraph("Title page")
+	rd.SetDifferentFirstPage(true)
+	rd.AddHeader(docx.HeaderFooterDefault).AddParagraph("Report")
+	rd.AddFooter(docx.HeaderFooterFirst).AddParagraph("Draft")
+
+	appendix := rd.AddSectionBreak(stypes.SectionMarkNextPage)
+	appendix.AddHeader(docx.HeaderFooterDefault).AddParagraph("Appendix")
+	appendix.AddFooter(docx.HeaderFooterDefault).AddParagraph("Page")
+	rd.AddParagraph("Appendix content")
+	assert.Nil(t, rd.CurrentSectionProperties().AddHeader(docx.HeaderFooterDefault))
+
+	files, _ := writeParts(t, rd)
+	document := string(files["word/document.xml"])
+
+	// The first section keeps its references and its title page, the second has its own
+	sections := regexp.MustCompile(`(?s)<w:sectPr>.*?</w:sectPr>`).FindAllString(document, -1)
+	require.Len(t, sections, 2)
+	assert.Equal(t, 1, strings.Count(sections[0], "<w:headerReference"))
+	assert.Equal(t, 1, strings.Count(sections[0], `<w:footerReference w:type="first"`))
+	assert.Contains(t, sections[0], "<w:titlePg")
+	assert.Equal(t, 1, strings.Count(sections[1], `<w:headerReference w:type="default"`))
+	assert.Equal(t, 1, strings.Count(sections[1], `<w:footerReference w:type="default"`))
+	assert.NotContains(t, sections[1], "<w:titlePg")
+
+	assert.Contains(t, string(files["word/header1.xml"]), "<w:t>Report</w:t>")
+	assert.Contains(t, string(files["word/header2.xml"]), "<w:t>Appendix</w:t>")
+
+	appendix.SetDifferentFirstPage(true).SetDifferentFirstPage(false)
+	files, _ = writeParts(t, rd)
+	sections = regexp.MustCompile(`(?s)<w:sectPr>.*?</w:sectPr>`).FindAllString(string(files["word/document.xml"]), -1)
+	assert.NotContains(t, sections[1], "<w:titlePg")
+}
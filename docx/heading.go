@@ -5,12 +5,33 @@ import (
 	"fmt"
 
 	"github.com/MamaShip/godocx/wml/ctypes"
+	"github.com/MamaShip/godocx/wml/stypes"
 )
 
+// HeadingOptions controls how headings added with AddHeading are formatted.
+type HeadingOptions struct {
+	// PageBreakBefore lists the heading levels that start on a new page (w:pageBreakBefore),
+	// e.g. []uint{1} to start each chapter on a new page.
+	PageBreakBefore []uint
+}
+
+// SetHeadingOptions sets the options applied to headings added to the document from now on.
+//
+// Example:
+//
+//	document.SetHeadingOptions(docx.HeadingOptions{PageBreakBefore: []uint{1}})
+//	document.AddHeading("Chapter 2", 1) // Starts on a new page
+func (rd *RootDoc) SetHeadingOptions(opts HeadingOptions) {
+	rd.headingOpts = opts
+}
+
 // Return a heading paragraph newly added to the end of the document.
 // The heading paragraph will contain text and have its paragraph style determined by level.
 // If level is 0, the style is set to Title.
-// The style is set to Heading {level}.
+// The style is set to Heading {level}, and the outline level of the paragraph is set so that
+// the heading is picked up by a table of contents.
+// If the style is missing from the document styles, a default definition is added.
+// Levels listed in HeadingOptions.PageBreakBefore start on a new page (see RootDoc.SetHeadingOptions).
 // if level is outside the range 0-9, error will be returned
 func (rd *RootDoc) AddHeading(text string, level uint) (*Paragraph, error) {
 	if level < 0 || level > 9 {
@@ -26,6 +47,16 @@ func (rd *RootDoc) AddHeading(text string, level uint) (*Paragraph, error) {
 	}
 
 	p.ct.Property.Style = ctypes.NewParagraphStyle(style)
+	rd.ensureStyle(style, stypes.StyleTypeParagraph)
+	if level != 0 {
+		p.OutlineLevel(int(level))
+	}
+	for _, breakLevel := range rd.headingOpts.PageBreakBefore {
+		if breakLevel == level {
+			p.PageBreakBefore(true)
+			break
+		}
+	}
 
 	bodyElem := DocumentChild{
 		Para: p,
@@ -35,3 +66,28 @@ func (rd *RootDoc) AddHeading(text string, level uint) (*Paragraph, error) {
 	p.AddText(text)
 	return p, nil
 }
+
+// OutlineLevel sets the outline level of the paragraph (w:outlineLvl), which is used by
+// the navigation pane and by tables of contents built from outline levels.
+//
+// Parameters:
+//   - level: The heading level, from 1 to 9. Other values remove the outline level.
+//
+// Returns:
+//   - *Paragraph: The paragraph instance for method chaining.
+//
+// Example:
+//
+//	p := document.AddParagraph("Appendix")
+//	p.OutlineLevel(1)
+func (p *Paragraph) OutlineLevel(level int) *Paragraph {
+	p.ensureProp()
+
+	if level < 1 || level > 9 {
+		p.ct.Property.OutlineLvl = nil
+		return p
+	}
+
+	p.ct.Property.OutlineLvl = ctypes.NewDecimalNum(level - 1)
+	return p
+}
@@ -0,0 +1,72 @@
+package docx
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/MamaShip/godocx/wml/stypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddHeading_OutlineLevel(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	for level := uint(1); level <= 9; level++ {
+		p, err := rd.AddHeading("Heading", level)
+		require.NoError(t, err)
+		require.NotNil(t, p.ct.Property.OutlineLvl)
+		assert.Equal(t, int(level)-1, p.ct.Property.OutlineLvl.Val)
+	}
+
+	// Heading styles are bold with descending font sizes
+	prev := uint64(0)
+	for _, id := range []string{"Heading9", "Heading3", "Heading2", "Heading1"} {
+		style := rd.GetStyleByID(id, stypes.StyleTypeParagraph)
+		require.NotNil(t, style, id)
+		assert.Equal(t, stypes.OnOffTrue, *style.RunProp.Bold.Val)
+		assert.GreaterOrEqual(t, style.RunProp.Size.Value, prev)
+		prev = style.RunProp.Size.Value
+	}
+
+	title, err := rd.AddHeading("Title", 0)
+	require.NoError(t, err)
+	assert.Nil(t, title.ct.Property.OutlineLvl)
+
+	_, err = rd.AddHeading("Too deep", 10)
+	assert.Error(t, err)
+}
+
+func TestParagraphOutlineLevel(t *testing.T) {
+	rd := setupRootDoc(t)
+	p := rd.AddParagraph("Appendix").OutlineLevel(2)
+
+	out, err := xml.Marshal(p.ct)
+	require.NoError(t, err)
+	assert.Contains(t, string(out), `<w:outlineLvl w:val="1"></w:outlineLvl>`)
+
+	p.OutlineLevel(0)
+	assert.Nil(t, p.ct.Property.OutlineLvl)
+}
+
+func TestAddHeading_PageBreakBefore(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	h1, err := rd.AddHeading("Before options", 1)
+	require.NoError(t, err)
+	assert.Nil(t, h1.ct.Property.PageBreakBefore)
+
+	rd.SetHeadingOptions(HeadingOptions{PageBreakBefore: []uint{1}})
+
+	chapter, err := rd.AddHeading("Chapter", 1)
+	require.NoError(t, err)
+	section, err := rd.AddHeading("Section", 2)
+	require.NoError(t, err)
+
+	assert.Equal(t, stypes.OnOffTrue, *chapter.ct.Property.PageBreakBefore.Val)
+	assert.Nil(t, section.ct.Property.PageBreakBefore)
+
+	out, err := xml.Marshal(chapter.ct)
+	require.NoError(t, err)
+	assert.Contains(t, string(out), `<w:pageBreakBefore w:val="true"></w:pageBreakBefore>`)
+}
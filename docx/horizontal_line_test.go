@@ -1,6 +1,7 @@
 package docx
 
 import (
+	"encoding/xml"
 	"testing"
 
 	"github.com/MamaShip/godocx/internal"
@@ -227,6 +228,52 @@ func TestParagraphBorder(t *testing.T) {
 	assert.Equal(t, stypes.BorderStyleDotted, p.ct.Property.Border.Right.Val, "Right border style should be dotted")
 }
 
+// TestParagraphBorder_BottomAfterFullBorder tests that BottomBorder only replaces the bottom edge
+func TestParagraphBorder_BottomAfterFullBorder(t *testing.T) {
+	doc := setupRootDoc(t)
+	p := doc.AddParagraph("Test paragraph")
+
+	edge := func(style stypes.BorderStyle) *ctypes.Border {
+		return &ctypes.Border{Val: style, Size: internal.ToPtr(4), Color: internal.ToPtr("auto")}
+	}
+	p.Border(&ctypes.ParaBorder{
+		Top:    edge(stypes.BorderStyleSingle),
+		Left:   edge(stypes.BorderStyleSingle),
+		Right:  edge(stypes.BorderStyleSingle),
+		Bottom: edge(stypes.BorderStyleSingle),
+	})
+	p.BottomBorder(stypes.BorderStyleDouble, 12, "FF0000")
+
+	border := p.ct.Property.Border
+	assert.Equal(t, stypes.BorderStyleSingle, border.Top.Val, "Top border should be kept")
+	assert.Equal(t, stypes.BorderStyleSingle, border.Left.Val, "Left border should be kept")
+	assert.Equal(t, stypes.BorderStyleSingle, border.Right.Val, "Right border should be kept")
+	assert.Equal(t, stypes.BorderStyleDouble, border.Bottom.Val, "Bottom border should be replaced")
+	assert.Equal(t, 12, *border.Bottom.Size, "Bottom border size should be replaced")
+	assert.Equal(t, "FF0000", *border.Bottom.Color, "Bottom border color should be replaced")
+}
+
+// TestParagraphBorder_FullBorderAfterBottom tests that Border keeps edges it does not set
+func TestParagraphBorder_FullBorderAfterBottom(t *testing.T) {
+	doc := setupRootDoc(t)
+	p := doc.AddParagraph("Test paragraph")
+
+	p.BottomBorder(stypes.BorderStyleDouble, 12, "auto")
+	p.Border(&ctypes.ParaBorder{
+		Top:  &ctypes.Border{Val: stypes.BorderStyleSingle},
+		Left: &ctypes.Border{Val: stypes.BorderStyleDashed},
+	})
+
+	border := p.ct.Property.Border
+	assert.Equal(t, stypes.BorderStyleSingle, border.Top.Val, "Top border should be set")
+	assert.Equal(t, stypes.BorderStyleDashed, border.Left.Val, "Left border should be set")
+	assert.Nil(t, border.Right, "Right border should stay unset")
+	assert.Equal(t, stypes.BorderStyleDouble, border.Bottom.Val, "Bottom border should be kept")
+
+	p.Border(nil)
+	assert.Nil(t, p.ct.Property.Border, "Border(nil) should remove all borders")
+}
+
 // TestHorizontalLine_Integration tests creating a document with multiple horizontal lines
 func TestHorizontalLine_Integration(t *testing.T) {
 	doc := setupRootDoc(t)
@@ -249,3 +296,202 @@ func TestHorizontalLine_Integration(t *testing.T) {
 	assert.NotNil(t, doc.Document.Body.Children[5].Para.ct.Property.Border.Bottom, "Sixth child should have bottom border")
 	assert.NotNil(t, doc.Document.Body.Children[7].Para.ct.Property.Border.Bottom, "Eighth child should have bottom border")
 }
+
+// addLineWithSetters builds a horizontal line with the public paragraph setters
+func addLineWithSetters(doc *RootDoc, style stypes.BorderStyle, size int, color string, above bool) *Paragraph {
+	p := doc.AddEmptyParagraph()
+	if above {
+		p.TopBorder(style, size, color)
+	} else {
+		p.BottomBorder(style, size, color)
+	}
+	p.Spacing(0, 0)
+	p.LineSpacing(20, stypes.LineSpacingRuleExact)
+	return p
+}
+
+// TestHorizontalLine_MatchesSetters verifies that the horizontal lines marshal exactly like
+// paragraphs built with the border and spacing setters
+func TestHorizontalLine_MatchesSetters(t *testing.T) {
+	doc := setupRootDoc(t)
+
+	tests := []struct {
+		name  string
+		got   *Paragraph
+		style stypes.BorderStyle
+		size  int
+		color string
+		above bool
+	}{
+		{"Single", doc.AddHorizontalLine(), stypes.BorderStyleSingle, 6, "auto", false},
+		{"Double", doc.AddDoubleHorizontalLine(), stypes.BorderStyleDouble, 6, "auto", false},
+		{"Thick", doc.AddThickHorizontalLine(), stypes.BorderStyleThick, 12, "auto", false},
+		{"Dashed", doc.AddDashedHorizontalLine(), stypes.BorderStyleDashed, 6, "auto", false},
+		{"Custom", doc.AddCustomHorizontalLine(stypes.BorderStyleWave, 12, "FF0000"), stypes.BorderStyleWave, 12, "FF0000", false},
+		{"Above", doc.AddHorizontalLineAbove(), stypes.BorderStyleSingle, 6, "auto", true},
+		{"CustomAbove", doc.AddCustomHorizontalLineAbove(stypes.BorderStyleDotted, 4, "00FF00"), stypes.BorderStyleDotted, 4, "00FF00", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := addLineWithSetters(setupRootDoc(t), tt.style, tt.size, tt.color, tt.above)
+
+			wantXML, err := xml.Marshal(want.ct)
+			assert.NoError(t, err)
+			gotXML, err := xml.Marshal(tt.got.ct)
+			assert.NoError(t, err)
+			assert.Equal(t, string(wantXML), string(gotXML))
+		})
+	}
+}
+
+// TestHorizontalLine_Independent verifies that changing one line does not affect another
+func TestHorizontalLine_Independent(t *testing.T) {
+	doc := setupRootDoc(t)
+	first := doc.AddHorizontalLine()
+	second := doc.AddHorizontalLine()
+
+	*first.ct.Property.Border.Bottom.Size = 24
+	first.LineSpacing(240, stypes.LineSpacingRuleAuto)
+
+	assert.Equal(t, 6, *second.ct.Property.Border.Bottom.Size)
+	assertTightSpacing(t, second)
+}
+
+// TestAddHorizontalLineAbove tests the top border variants of the horizontal lines
+func TestAddHorizontalLineAbove(t *testing.T) {
+	doc := setupRootDoc(t)
+
+	tests := []struct {
+		name  string
+		add   func() *Paragraph
+		style stypes.BorderStyle
+		size  int
+		color string
+	}{
+		{"Single", doc.AddHorizontalLineAbove, stypes.BorderStyleSingle, 6, "auto"},
+		{"Double", doc.AddDoubleHorizontalLineAbove, stypes.BorderStyleDouble, 6, "auto"},
+		{"Thick", doc.AddThickHorizontalLineAbove, stypes.BorderStyleThick, 12, "auto"},
+		{"Dashed", doc.AddDashedHorizontalLineAbove, stypes.BorderStyleDashed, 6, "auto"},
+		{"Custom", func() *Paragraph {
+			return doc.AddCustomHorizontalLineAbove(stypes.BorderStyleWave, 12, "FF0000")
+		}, stypes.BorderStyleWave, 12, "FF0000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := tt.add()
+
+			assert.NotNil(t, p.ct.Property.Border.Top, "Paragraph should have top border")
+			assert.Nil(t, p.ct.Property.Border.Bottom, "Paragraph should not have bottom border")
+			assert.Equal(t, tt.style, p.ct.Property.Border.Top.Val)
+			assert.Equal(t, tt.size, *p.ct.Property.Border.Top.Size)
+			assert.Equal(t, tt.color, *p.ct.Property.Border.Top.Color)
+
+			assertTightSpacing(t, p)
+		})
+	}
+}
+
+// TestAddBoxedSeparator tests the AddBoxedSeparator method
+func TestAddBoxedSeparator(t *testing.T) {
+	doc := setupRootDoc(t)
+
+	p := doc.AddBoxedSeparator("Part II")
+
+	assert.NotNil(t, p.ct.Property.Border.Top, "Paragraph should have top border")
+	assert.NotNil(t, p.ct.Property.Border.Bottom, "Paragraph should have bottom border")
+	assert.Equal(t, stypes.BorderStyleSingle, p.ct.Property.Border.Top.Val)
+	assert.Equal(t, stypes.BorderStyleSingle, p.ct.Property.Border.Bottom.Val)
+	assert.Equal(t, stypes.JustificationCenter, p.ct.Property.Justification.Val)
+	assert.Equal(t, uint64(0), *p.ct.Property.Spacing.Before)
+	assert.Equal(t, uint64(0), *p.ct.Property.Spacing.After)
+	assert.Equal(t, "Part II", p.Text())
+
+	// Without a label the separator collapses to the tight line height
+	empty := doc.AddBoxedSeparator("")
+	assert.Empty(t, empty.ct.Children)
+	assertTightSpacing(t, empty)
+}
+
+// TestAddPartialHorizontalLine tests that partial lines are resolved against the content width
+func TestAddPartialHorizontalLine(t *testing.T) {
+	doc := setupRootDoc(t)
+
+	// Letter with 1 inch margins: 9360 twips of content, a 50% line leaves 2340 on each side
+	p := doc.AddPartialHorizontalLine(50)
+	assert.NotNil(t, p.ct.Property.Border.Bottom, "Paragraph should have bottom border")
+	assert.Equal(t, 2340, *p.ct.Property.Indent.Left)
+	assert.Equal(t, 2340, *p.ct.Property.Indent.Right)
+	assertTightSpacing(t, p)
+
+	// Wider margins shrink the content width and therefore the indentation
+	doc.Document.Body.SectPr = &ctypes.SectionProp{
+		PageMargin: &ctypes.PageMargin{Left: internal.ToPtr(2880), Right: internal.ToPtr(2880)},
+	}
+	p = doc.AddPartialHorizontalLine(50)
+	assert.Equal(t, 1620, *p.ct.Property.Indent.Left)
+	assert.Equal(t, 1620, *p.ct.Property.Indent.Right)
+
+	// Out of range percentages are clamped
+	p = doc.AddPartialHorizontalLine(150)
+	assert.Equal(t, 0, *p.ct.Property.Indent.Left)
+}
+
+// TestAddHorizontalLineWith tests the defaults and the spacing options of AddHorizontalLineWith
+func TestAddHorizontalLineWith(t *testing.T) {
+	doc := setupRootDoc(t)
+
+	// The zero value is the same line as AddHorizontalLine
+	assert.Equal(t, doc.AddHorizontalLine().ct, doc.AddHorizontalLineWith(HLineOptions{}).ct)
+
+	p := doc.AddHorizontalLineWith(HLineOptions{
+		Style:  stypes.BorderStyleDotted,
+		Size:   BorderSizeFromPoints(1.5),
+		Color:  "808080",
+		Above:  true,
+		Before: 240,
+		After:  120,
+	})
+	assert.Nil(t, p.ct.Property.Border.Bottom)
+	assert.Equal(t, stypes.BorderStyleDotted, p.ct.Property.Border.Top.Val)
+	assert.Equal(t, 12, *p.ct.Property.Border.Top.Size)
+	assert.Equal(t, "808080", *p.ct.Property.Border.Top.Color)
+	assert.Equal(t, uint64(240), *p.ct.Property.Spacing.Before)
+	assert.Equal(t, uint64(120), *p.ct.Property.Spacing.After)
+	assert.Equal(t, 20, *p.ct.Property.Spacing.Line)
+	assert.Equal(t, stypes.LineSpacingRuleExact, *p.ct.Property.Spacing.LineRule)
+
+	p = doc.AddHorizontalLineWith(HLineOptions{After: 240, AutoLineHeight: true})
+	assert.Nil(t, p.ct.Property.Spacing.Line)
+	assert.Nil(t, p.ct.Property.Spacing.LineRule)
+
+	out, err := xml.Marshal(p.ct)
+	assert.NoError(t, err)
+	assert.Contains(t, string(out), `<w:spacing w:before="0" w:after="240"></w:spacing>`)
+}
+
+func TestBorderSizePoints(t *testing.T) {
+	assert.Equal(t, 0.75, BorderSizeToPoints(6))
+	assert.Equal(t, 3.0, BorderSizeToPoints(24))
+	assert.Equal(t, 6, BorderSizeFromPoints(0.75))
+	assert.Equal(t, 4, BorderSizeFromPoints(0.5))
+	assert.Equal(t, 2, BorderSizeFromPoints(0.25))
+}
+
+func BenchmarkAddHorizontalLine(b *testing.B) {
+	doc := &RootDoc{Document: &Document{Body: &Body{}}}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		doc.AddHorizontalLine()
+	}
+}
+
+// BenchmarkAddHorizontalLine_Setters measures the same line built with the paragraph setters, for comparison
+func BenchmarkAddHorizontalLine_Setters(b *testing.B) {
+	doc := &RootDoc{Document: &Document{Body: &Body{}}}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		addLineWithSetters(doc, stypes.BorderStyleSingle, 6, "auto", false)
+	}
+}
@@ -0,0 +1,107 @@
+package docx
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/MamaShip/godocx/common/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParagraph_AddHyperlink(t *testing.T) {
+	rd := setupRootDoc(t)
+	rd.Document.Root = rd
+	rd.DocStyles.RelativePath = "word/styles.xml"
+
+	p := rd.AddParagraph("Visit ")
+	run := p.AddHyperlink("our site", "https://example.com")
+	run.Bold(true)
+
+	assert.Len(t, p.ct.Children, 2)
+	link := p.ct.Children[1].Link
+	if assert.NotNil(t, link) {
+		assert.Equal(t, "rId1", link.ID)
+		assert.Same(t, run.ct, link.Run)
+		assert.Equal(t, constants.HyperLinkStyle, link.Run.Property.Style.Val)
+		assert.NotNil(t, link.Run.Property.Bold)
+	}
+
+	rels := rd.Document.DocRels.Relationships
+	if assert.Len(t, rels, 1) {
+		assert.Equal(t, constants.SourceRelationshipHyperLink, rels[0].Type)
+		assert.Equal(t, "External", rels[0].TargetMode)
+		assert.Equal(t, "https://example.com", rels[0].Target)
+	}
+	assert.Equal(t, 1, rd.Document.RID)
+
+	// Without sharing, the same URL gets a new relationship
+	second := p.AddHyperlink("again", "https://example.com")
+	assert.Len(t, rd.Document.DocRels.Relationships, 2)
+	assert.Equal(t, "rId2", p.ct.Children[2].Link.ID)
+	assert.NotNil(t, second)
+}
+
+func TestShareHyperlinkRelationships(t *testing.T) {
+	rd := setupRootDoc(t)
+	rd.Document.Root = rd
+	rd.DocStyles.RelativePath = "word/styles.xml"
+	rd.ShareHyperlinkRelationships(true)
+
+	p := rd.AddEmptyParagraph()
+	p.AddHyperlink("one", "https://example.com")
+	p.AddHyperlink("two", "https://example.com")
+	p.AddHyperlink("three", "https://example.org")
+
+	assert.Len(t, rd.Document.DocRels.Relationships, 2)
+	assert.Equal(t, p.ct.Children[0].Link.ID, p.ct.Children[1].Link.ID)
+	assert.NotEqual(t, p.ct.Children[0].Link.ID, p.ct.Children[2].Link.ID)
+}
+
+func TestHyperlinkTargetEscaped(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	p := rd.AddEmptyParagraph()
+	p.AddHyperlink("search", `https://example.com/?q=a&b="c"`)
+
+	out, err := xml.Marshal(rd.Document.DocRels.Relationships[0])
+	if err != nil {
+		t.Fatalf("marshal relationship: %v", err)
+	}
+	assert.True(t, strings.Contains(string(out), `Target="https://example.com/?q=a&amp;b=&#34;c&#34;"`), string(out))
+}
+
+func TestParagraph_LinkRun(t *testing.T) {
+	rd := setupRootDoc(t)
+	rd.Document.Root = rd
+
+	p := rd.AddParagraph("See ")
+	site := p.AddText("example.com").Bold(true)
+	styled := p.AddText(" or the manual").Style("Strong")
+
+	assert.Same(t, site, p.LinkRun(site, "https://example.com"))
+	assert.Same(t, styled, p.LinkRun(styled, "https://example.com/manual"))
+	assert.Nil(t, p.LinkRun(rd.AddParagraph("Other").AddText("x"), "https://example.org"))
+
+	link := p.ct.Children[1].Link
+	if assert.NotNil(t, link) {
+		assert.Same(t, site.ct, link.Run)
+		assert.Equal(t, constants.HyperLinkStyle, site.ct.Property.Style.Val)
+		assert.NotNil(t, site.ct.Property.Bold)
+	}
+	assert.Equal(t, "Strong", p.ct.Children[2].Link.Run.Property.Style.Val)
+	assert.Len(t, rd.Document.DocRels.Relationships, 3, "styles part and two links")
+	assert.Equal(t, "See example.com or the manual", p.Text())
+}
+
+func TestAddHyperlink_InjectsStyle(t *testing.T) {
+	rd := setupRootDoc(t)
+	rd.Document.Root = rd
+
+	rd.AddEmptyParagraph().AddHyperlink("site", "https://example.com")
+	rd.AddEmptyParagraph().AddHyperlink("site", "https://example.com")
+
+	styles := marshalStyles(t, rd)
+	assert.Equal(t, 1, strings.Count(styles, `w:styleId="Hyperlink"`))
+	assert.Contains(t, styles, `<w:color w:val="0563C1"></w:color><w:u w:val="single"></w:u>`)
+}
@@ -0,0 +1,470 @@
+package docx
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"math"
+	"os"
+
+	"github.com/MamaShip/godocx/common/units"
+	"github.com/MamaShip/godocx/dml"
+	"github.com/MamaShip/godocx/dml/dmlct"
+	"github.com/MamaShip/godocx/dml/dmlpic"
+)
+
+const (
+	// emuPerPixel is the number of EMUs in one pixel at 96 DPI.
+	emuPerPixel = 9525
+
+	// emuPerInch is the number of EMUs in one inch.
+	emuPerInch = 914400
+
+	// defaultImageDPI is the resolution of images that do not record theirs, as in Word.
+	defaultImageDPI = 96
+)
+
+// ImageFormat identifies the encoding of an image added with AddImage.
+type ImageFormat string
+
+const (
+	ImageFormatPNG  ImageFormat = "png"
+	ImageFormatJPEG ImageFormat = "jpeg"
+	ImageFormatGIF  ImageFormat = "gif"
+)
+
+// ErrUnsupportedImageFormat is returned when adding an image in a format godocx cannot embed.
+var ErrUnsupportedImageFormat = errors.New("unsupported image format")
+
+// decodeImageConfig returns the pixel dimensions of the encoded image.
+func decodeImageConfig(imgBytes []byte, format ImageFormat) (image.Config, error) {
+	r := bytes.NewReader(imgBytes)
+	switch format {
+	case ImageFormatPNG:
+		return png.DecodeConfig(r)
+	case ImageFormatJPEG:
+		return jpeg.DecodeConfig(r)
+	case ImageFormatGIF:
+		return gif.DecodeConfig(r)
+	default:
+		return image.Config{}, fmt.Errorf("%w: %q", ErrUnsupportedImageFormat, format)
+	}
+}
+
+// detectImageFormat returns the format of the encoded image from its signature.
+func detectImageFormat(imgBytes []byte) (ImageFormat, error) {
+	switch {
+	case bytes.HasPrefix(imgBytes, []byte("\x89PNG\r\n\x1a\n")):
+		return ImageFormatPNG, nil
+	case bytes.HasPrefix(imgBytes, []byte{0xFF, 0xD8, 0xFF}):
+		return ImageFormatJPEG, nil
+	case bytes.HasPrefix(imgBytes, []byte("GIF87a")), bytes.HasPrefix(imgBytes, []byte("GIF89a")):
+		return ImageFormatGIF, nil
+	default:
+		return "", ErrUnsupportedImageFormat
+	}
+}
+
+// imageDPI returns the horizontal and vertical resolution recorded in the encoded image, from
+// the pHYs chunk of a PNG image or the JFIF header of a JPEG image, or 96 DPI if there is none.
+func imageDPI(imgBytes []byte, format ImageFormat) (float64, float64) {
+	switch format {
+	case ImageFormatPNG:
+		// Chunks follow the 8-byte signature: length, type, data and CRC
+		for pos := 8; pos+12 <= len(imgBytes); {
+			length := int(binary.BigEndian.Uint32(imgBytes[pos:]))
+			chunk := string(imgBytes[pos+4 : pos+8])
+			if pos+12+length > len(imgBytes) || chunk == "IDAT" {
+				break
+			}
+			// The resolution is in pixels per meter when the unit is 1
+			if data := imgBytes[pos+8 : pos+8+length]; chunk == "pHYs" && length == 9 && data[8] == 1 {
+				x, y := binary.BigEndian.Uint32(data), binary.BigEndian.Uint32(data[4:])
+				if x > 0 && y > 0 {
+					return float64(x) * 0.0254, float64(y) * 0.0254
+				}
+			}
+			pos += 12 + length
+		}
+	case ImageFormatJPEG:
+		// The JFIF APP0 segment follows the start of image marker
+		if len(imgBytes) >= 18 && imgBytes[2] == 0xFF && imgBytes[3] == 0xE0 && string(imgBytes[6:11]) == "JFIF\x00" {
+			x, y := binary.BigEndian.Uint16(imgBytes[14:]), binary.BigEndian.Uint16(imgBytes[16:])
+			if x > 0 && y > 0 {
+				switch imgBytes[13] {
+				case 1: // Dots per inch
+					return float64(x), float64(y)
+				case 2: // Dots per centimeter
+					return float64(x) * 2.54, float64(y) * 2.54
+				}
+			}
+		}
+	}
+	return defaultImageDPI, defaultImageDPI
+}
+
+// Drawing is an image placed inline with the text of a paragraph, or floating, see Float.
+type Drawing struct {
+	root   *RootDoc
+	para   *Paragraph
+	inline *dml.Inline
+
+	// anchor replaces inline once the drawing is floating
+	anchor *dml.Anchor
+
+	// Source dimensions of the image in pixels, and its size at its resolution
+	pxWidth   int
+	pxHeight  int
+	natWidth  units.Emu
+	natHeight units.Emu
+	crop      ImageCrop
+
+	// keepAspect derives a missing dimension from the source aspect ratio in SetSize.
+	keepAspect bool
+}
+
+// AddImage adds a new paragraph containing the image read from r.
+//
+// The image is stored as a new media part and sized from its pixel dimensions and the
+// resolution it records, or 96 DPI if it records none.
+//
+// Parameters:
+//   - r: The encoded image.
+//   - format: The image encoding; PNG, JPEG and GIF are supported.
+//
+// Returns:
+//   - *Drawing: The added image.
+//   - error: ErrUnsupportedImageFormat for other formats, or an error reading or decoding the image.
+//
+// Example:
+//
+//	f, _ := os.Open("gopher.png")
+//	defer f.Close()
+//	img, err := document.AddImage(f, docx.ImageFormatPNG)
+func (rd *RootDoc) AddImage(r io.Reader, format ImageFormat) (*Drawing, error) {
+	p := newParagraph(rd)
+
+	drawing, err := p.AddInlineImage(r, format)
+	if err != nil {
+		return nil, err
+	}
+
+	rd.Document.Body.Children = append(rd.Document.Body.Children, DocumentChild{Para: p})
+
+	return drawing, nil
+}
+
+// AddInlineImage adds the image read from r to the paragraph, inline with its text.
+//
+// See RootDoc.AddImage for details.
+func (p *Paragraph) AddInlineImage(r io.Reader, format ImageFormat) (*Drawing, error) {
+	imgBytes, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := decodeImageConfig(imgBytes, format)
+	if err != nil {
+		return nil, err
+	}
+
+	dpiX, dpiY := imageDPI(imgBytes, format)
+	width := units.Emu(float64(cfg.Width)*emuPerInch/dpiX + 0.5)
+	height := units.Emu(float64(cfg.Height)*emuPerInch/dpiY + 0.5)
+
+	pic, err := p.addPictureBytes(imgBytes, "."+string(format), width, height)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Drawing{
+		root:      p.root,
+		para:      p,
+		inline:    pic.Inline,
+		pxWidth:   cfg.Width,
+		pxHeight:  cfg.Height,
+		natWidth:  width,
+		natHeight: height,
+	}, nil
+}
+
+// AddInlineImageFile adds the image file at path to the paragraph, inline with its text.
+// The format is detected from the content of the file.
+//
+// See RootDoc.AddImage for details.
+//
+// Example:
+//
+//	p := document.AddParagraph("Logo: ")
+//	img, err := p.AddInlineImageFile("logo.png")
+func (p *Paragraph) AddInlineImageFile(path string) (*Drawing, error) {
+	imgBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	format, err := detectImageFormat(imgBytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", err, path)
+	}
+
+	return p.AddInlineImage(bytes.NewReader(imgBytes), format)
+}
+
+// Paragraph returns the paragraph containing the drawing.
+func (d *Drawing) Paragraph() *Paragraph {
+	return d.para
+}
+
+// Size returns the displayed size of the drawing in EMUs.
+func (d *Drawing) Size() (width, height units.Emu) {
+	extent, _ := d.parts()
+	return units.Emu(extent.Width), units.Emu(extent.Height)
+}
+
+// PixelSize returns the dimensions of the source image in pixels.
+func (d *Drawing) PixelSize() (width, height int) {
+	return d.pxWidth, d.pxHeight
+}
+
+// KeepAspectRatio sets whether SetSize and SetSizeCM derive a dimension passed as zero
+// from the other one, using the aspect ratio of the source image.
+//
+// Returns:
+//   - *Drawing: The drawing instance for method chaining.
+func (d *Drawing) KeepAspectRatio(keep bool) *Drawing {
+	d.keepAspect = keep
+	return d
+}
+
+// SetSize sets the displayed size of the drawing in EMUs (914400 per inch, 360000 per cm).
+//
+// When KeepAspectRatio is enabled, passing zero for one dimension computes it from the
+// other one and the source image dimensions, less the cropped edges. Otherwise a zero dimension is left unchanged.
+//
+// Example:
+//
+//	img.KeepAspectRatio(true).SetSize(int64(units.Inch(3).ToEmu()), 0)
+func (d *Drawing) SetSize(widthEMU, heightEMU int64) *Drawing {
+	curWidth, curHeight := d.Size()
+	width, height := units.Emu(widthEMU), units.Emu(heightEMU)
+
+	if pxWidth, pxHeight := d.visiblePixels(); d.keepAspect && pxWidth > 0 && pxHeight > 0 {
+		switch {
+		case width > 0 && height <= 0:
+			height = units.Emu(float64(width)*pxHeight/pxWidth + 0.5)
+		case height > 0 && width <= 0:
+			width = units.Emu(float64(height)*pxWidth/pxHeight + 0.5)
+		}
+	}
+
+	if width <= 0 {
+		width = curWidth
+	}
+	if height <= 0 {
+		height = curHeight
+	}
+
+	d.setExtent(width, height)
+	return d
+}
+
+// SetSizeCM sets the displayed size of the drawing in centimeters.
+// Zero dimensions are handled as in SetSize.
+func (d *Drawing) SetSizeCM(width, height float64) *Drawing {
+	return d.SetSize(int64(units.Cm(width).ToEmu()), int64(units.Cm(height).ToEmu()))
+}
+
+// setExtent updates both the wp:extent of the drawing and the a:ext of the picture.
+func (d *Drawing) setExtent(width, height units.Emu) {
+	extent, graphic := d.parts()
+	extent.Width = uint64(width)
+	extent.Height = uint64(height)
+
+	if data := graphic.Data; data != nil && data.Pic != nil {
+		xfrm := data.Pic.PicShapeProp.TransformGroup
+		if xfrm == nil {
+			xfrm = dmlpic.NewTransformGroup()
+			data.Pic.PicShapeProp.TransformGroup = xfrm
+		}
+		xfrm.Extent = dmlct.NewPostvSz2D(width, height)
+	}
+}
+
+// parts returns the extent and the graphic of the inline or floating drawing.
+func (d *Drawing) parts() (*dmlct.PSize2D, *dml.Graphic) {
+	if d.anchor != nil {
+		return &d.anchor.Extent, &d.anchor.Graphic
+	}
+	return &d.inline.Extent, &d.inline.Graphic
+}
+
+// ImageCrop is the part of an image hidden at each edge, in percent of the width or height
+// of the image.
+type ImageCrop struct {
+	Left   float64
+	Top    float64
+	Right  float64
+	Bottom float64
+}
+
+// ImageOptions describes the size, rotation and cropping of an image added with
+// Paragraph.AddInlineImageWithOptions.
+type ImageOptions struct {
+	// Width and Height are the displayed size of the image. When both are zero, the image is
+	// displayed at its natural size scaled by ScalePercent. When only one is zero, it is
+	// derived from the other with KeepAspectRatio, and is the natural size otherwise.
+	Width  units.Emu
+	Height units.Emu
+
+	// ScalePercent scales the natural size of the image when Width and Height are zero;
+	// 0 for 100%.
+	ScalePercent float64
+
+	// KeepAspectRatio derives a zero Width or Height from the other one, see
+	// Drawing.KeepAspectRatio.
+	KeepAspectRatio bool
+
+	// Rotation is the clockwise rotation of the image in degrees.
+	Rotation float64
+
+	// Crop hides the edges of the image, see Drawing.Crop.
+	Crop ImageCrop
+}
+
+// AddInlineImageWithOptions adds the image read from r to the paragraph, inline with its
+// text, sized, rotated and cropped as set by opts.
+//
+// See RootDoc.AddImage for details.
+//
+// Example:
+//
+//	p := document.AddEmptyParagraph()
+//	img, err := p.AddInlineImageWithOptions(f, docx.ImageFormatPNG, docx.ImageOptions{
+//		Width:           units.Cm(5).ToEmu(),
+//		KeepAspectRatio: true,
+//		Rotation:        90,
+//	})
+func (p *Paragraph) AddInlineImageWithOptions(r io.Reader, format ImageFormat, opts ImageOptions) (*Drawing, error) {
+	d, err := p.AddInlineImage(r, format)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Crop(opts.Crop).KeepAspectRatio(opts.KeepAspectRatio)
+	if opts.Width > 0 || opts.Height > 0 {
+		d.SetSize(int64(opts.Width), int64(opts.Height))
+	} else if opts.ScalePercent > 0 {
+		d.Scale(opts.ScalePercent)
+	}
+	return d.Rotate(opts.Rotation), nil
+}
+
+// Scale sets the displayed size of the drawing to its natural size, less the cropped edges,
+// scaled by percent. Values of zero or less are ignored.
+//
+// Returns:
+//   - *Drawing: The drawing instance for method chaining.
+//
+// Example:
+//
+//	img.Scale(50) // Half the natural size
+func (d *Drawing) Scale(percent float64) *Drawing {
+	if percent <= 0 {
+		return d
+	}
+
+	visibleWidth, visibleHeight := d.crop.visible()
+	width := float64(d.natWidth) * visibleWidth * percent / 100
+	height := float64(d.natHeight) * visibleHeight * percent / 100
+	d.setExtent(units.Emu(width+0.5), units.Emu(height+0.5))
+	return d
+}
+
+// Rotate sets the clockwise rotation of the drawing in degrees, replacing any previous
+// rotation. Zero removes the rotation.
+//
+// Returns:
+//   - *Drawing: The drawing instance for method chaining.
+func (d *Drawing) Rotate(degrees float64) *Drawing {
+	_, graphic := d.parts()
+	if graphic.Data == nil || graphic.Data.Pic == nil {
+		return d
+	}
+
+	degrees = math.Mod(degrees, 360)
+	if degrees < 0 {
+		degrees += 360
+	}
+
+	xfrm := graphic.Data.Pic.PicShapeProp.TransformGroup
+	if xfrm == nil {
+		xfrm = dmlpic.NewTransformGroup()
+		graphic.Data.Pic.PicShapeProp.TransformGroup = xfrm
+	}
+	// The angle is in 60000ths of a degree
+	if rot := int(math.Round(degrees * 60000)); rot > 0 && rot < 360*60000 {
+		xfrm.Rotation = &rot
+	} else {
+		xfrm.Rotation = nil
+	}
+	return d
+}
+
+// Crop hides the edges of the image, in percent of its width or height, replacing any
+// previous cropping. As in Word, the displayed size shrinks with the visible part of the
+// image, keeping its scale. Crops that are negative or leave nothing visible are ignored.
+//
+// Returns:
+//   - *Drawing: The drawing instance for method chaining.
+//
+// Example:
+//
+//	img.Crop(docx.ImageCrop{Left: 10, Right: 10}) // Keep the middle 80% of the width
+func (d *Drawing) Crop(crop ImageCrop) *Drawing {
+	if crop.Left < 0 || crop.Top < 0 || crop.Right < 0 || crop.Bottom < 0 ||
+		crop.Left+crop.Right >= 100 || crop.Top+crop.Bottom >= 100 {
+		return d
+	}
+	_, graphic := d.parts()
+	if graphic.Data == nil || graphic.Data.Pic == nil {
+		return d
+	}
+
+	oldWidth, oldHeight := d.crop.visible()
+	newWidth, newHeight := crop.visible()
+	width, height := d.Size()
+	d.setExtent(units.Emu(float64(width)*newWidth/oldWidth+0.5), units.Emu(float64(height)*newHeight/oldHeight+0.5))
+
+	// The edges are in 1000ths of a percent
+	edge := func(percent float64) *int {
+		if value := int(math.Round(percent * 1000)); value > 0 {
+			return &value
+		}
+		return nil
+	}
+	rect := &dmlct.RelativeRect{Left: edge(crop.Left), Top: edge(crop.Top), Right: edge(crop.Right), Bottom: edge(crop.Bottom)}
+	if rect.Left == nil && rect.Top == nil && rect.Right == nil && rect.Bottom == nil {
+		rect = nil
+	}
+	graphic.Data.Pic.BlipFill.SrcRect = rect
+	d.crop = crop
+	return d
+}
+
+// visible returns the visible fraction of the width and height of a cropped image.
+func (c ImageCrop) visible() (float64, float64) {
+	return 1 - (c.Left+c.Right)/100, 1 - (c.Top+c.Bottom)/100
+}
+
+// visiblePixels returns the dimensions in pixels of the visible part of the image.
+func (d *Drawing) visiblePixels() (float64, float64) {
+	width, height := d.crop.visible()
+	return float64(d.pxWidth) * width, float64(d.pxHeight) * height
+}
@@ -0,0 +1,361 @@
+package docx
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/xml"
+	"errors"
+	"hash/crc32"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/MamaShip/godocx/common/constants"
+	"github.com/MamaShip/godocx/common/units"
+	"github.com/MamaShip/godocx/dml/dmlst"
+	"github.com/stretchr/testify/assert"
+)
+
+func encodeTestImage(t *testing.T, format ImageFormat, width, height int) []byte {
+	t.Helper()
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+
+	var buf bytes.Buffer
+	var err error
+	switch format {
+	case ImageFormatPNG:
+		err = png.Encode(&buf, img)
+	case ImageFormatJPEG:
+		err = jpeg.Encode(&buf, img, nil)
+	case ImageFormatGIF:
+		err = gif.Encode(&buf, img, nil)
+	}
+	if err != nil {
+		t.Fatalf("encode %s: %v", format, err)
+	}
+	return buf.Bytes()
+}
+
+func TestAddImage(t *testing.T) {
+	for _, format := range []ImageFormat{ImageFormatPNG, ImageFormatJPEG, ImageFormatGIF} {
+		t.Run(string(format), func(t *testing.T) {
+			rd := setupRootDoc(t)
+
+			d, err := rd.AddImage(bytes.NewReader(encodeTestImage(t, format, 192, 96)), format)
+			if err != nil {
+				t.Fatalf("AddImage: %v", err)
+			}
+
+			w, h := d.PixelSize()
+			assert.Equal(t, 192, w)
+			assert.Equal(t, 96, h)
+
+			ew, eh := d.Size()
+			assert.Equal(t, units.Inch(2).ToEmu(), ew)
+			assert.Equal(t, units.Inch(1).ToEmu(), eh)
+
+			fileName := "image2." + string(format)
+			_, ok := rd.FileMap.Load("word/media/" + fileName)
+			assert.True(t, ok, "media part should be stored")
+
+			rels := rd.Document.DocRels.Relationships
+			if assert.Len(t, rels, 1) {
+				assert.Equal(t, constants.SourceRelationshipImage, rels[0].Type)
+				assert.Equal(t, "media/"+fileName, rels[0].Target)
+			}
+
+			assert.Contains(t, rd.ContentType.Default, Default{Extension: string(format), ContentType: "image/" + string(format)})
+			assert.Len(t, rd.Document.Body.Children, 1)
+			assert.Same(t, rd.Document.Body.Children[0].Para, d.Paragraph())
+		})
+	}
+}
+
+func TestAddInlineImageContentTypesNotDuplicated(t *testing.T) {
+	rd := setupRootDoc(t)
+	p := rd.AddParagraph("Icons: ")
+
+	for i := 0; i < 2; i++ {
+		if _, err := p.AddInlineImage(bytes.NewReader(encodeTestImage(t, ImageFormatPNG, 16, 16)), ImageFormatPNG); err != nil {
+			t.Fatalf("AddInlineImage: %v", err)
+		}
+	}
+
+	assert.Len(t, rd.ContentType.Default, 1)
+	assert.Len(t, rd.ContentType.Override, 2)
+	assert.Len(t, p.ct.Children, 3)
+}
+
+func TestAddImageErrors(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	_, err := rd.AddImage(strings.NewReader("BM..."), ImageFormat("bmp"))
+	assert.True(t, errors.Is(err, ErrUnsupportedImageFormat))
+
+	_, err = rd.AddImage(strings.NewReader("not a png"), ImageFormatPNG)
+	assert.Error(t, err)
+
+	assert.Empty(t, rd.Document.Body.Children)
+	assert.Empty(t, rd.Document.DocRels.Relationships)
+}
+
+func TestAddInlineImage_Resolution(t *testing.T) {
+	// 200 x 100 pixels at 200 DPI is one inch by half an inch
+	pngBytes := encodeTestImage(t, ImageFormatPNG, 200, 100)
+	phys := make([]byte, 9)
+	binary.BigEndian.PutUint32(phys, 7874) // Pixels per meter
+	binary.BigEndian.PutUint32(phys[4:], 7874)
+	phys[8] = 1
+	chunk := append([]byte{0, 0, 0, 9}, "pHYs"...)
+	chunk = append(chunk, phys...)
+	crc := make([]byte, 4)
+	binary.BigEndian.PutUint32(crc, crc32.ChecksumIEEE(chunk[4:]))
+	chunk = append(chunk, crc...)
+	ihdrEnd := 8 + 12 + 13
+	pngBytes = append(pngBytes[:ihdrEnd:ihdrEnd], append(chunk, pngBytes[ihdrEnd:]...)...)
+
+	jpegBytes := encodeTestImage(t, ImageFormatJPEG, 200, 100)
+	jfif := []byte{0xFF, 0xE0, 0, 16, 'J', 'F', 'I', 'F', 0, 1, 1, 1, 0, 200, 0, 200, 0, 0}
+	jpegBytes = append(jpegBytes[:2:2], append(jfif, jpegBytes[2:]...)...)
+
+	for format, imgBytes := range map[ImageFormat][]byte{ImageFormatPNG: pngBytes, ImageFormatJPEG: jpegBytes} {
+		t.Run(string(format), func(t *testing.T) {
+			rd := setupRootDoc(t)
+			d, err := rd.AddEmptyParagraph().AddInlineImage(bytes.NewReader(imgBytes), format)
+			if err != nil {
+				t.Fatalf("AddInlineImage: %v", err)
+			}
+
+			w, h := d.Size()
+			assert.InDelta(t, int64(units.Inch(1).ToEmu()), int64(w), 200)
+			assert.InDelta(t, int64(units.Inch(0.5).ToEmu()), int64(h), 100)
+		})
+	}
+}
+
+func TestAddInlineImageFile(t *testing.T) {
+	dir := t.TempDir()
+	rd := setupRootDoc(t)
+	p := rd.AddParagraph("Logo: ")
+
+	for i, format := range []ImageFormat{ImageFormatPNG, ImageFormatJPEG, ImageFormatGIF} {
+		path := filepath.Join(dir, "image"+strconv.Itoa(i)) // No extension: the format is detected
+		if err := os.WriteFile(path, encodeTestImage(t, format, 96, 48), 0o600); err != nil {
+			t.Fatal(err)
+		}
+
+		d, err := p.AddInlineImageFile(path)
+		if err != nil {
+			t.Fatalf("AddInlineImageFile %s: %v", format, err)
+		}
+		w, h := d.PixelSize()
+		assert.Equal(t, 96, w)
+		assert.Equal(t, 48, h)
+		_, ok := rd.FileMap.Load("word/media/image" + strconv.Itoa(i+2) + "." + string(format))
+		assert.True(t, ok, "media part should be stored as %s", format)
+	}
+	assert.Len(t, p.ct.Children, 4)
+
+	bmp := filepath.Join(dir, "image.bmp")
+	if err := os.WriteFile(bmp, []byte("BM..."), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	_, err := p.AddInlineImageFile(bmp)
+	assert.True(t, errors.Is(err, ErrUnsupportedImageFormat))
+
+	_, err = p.AddInlineImageFile(filepath.Join(dir, "missing.png"))
+	assert.Error(t, err)
+	assert.Len(t, p.ct.Children, 4)
+}
+
+func TestDrawing_SetSize(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	d, err := rd.AddImage(bytes.NewReader(encodeTestImage(t, ImageFormatPNG, 400, 200)), ImageFormatPNG)
+	if err != nil {
+		t.Fatalf("AddImage: %v", err)
+	}
+
+	assertExtent := func(width, height units.Emu) {
+		t.Helper()
+		w, h := d.Size()
+		assert.Equal(t, width, w)
+		assert.Equal(t, height, h)
+
+		ext := d.inline.Graphic.Data.Pic.PicShapeProp.TransformGroup.Extent
+		assert.Equal(t, uint64(width), ext.Width, "a:ext width should match wp:extent")
+		assert.Equal(t, uint64(height), ext.Height, "a:ext height should match wp:extent")
+	}
+
+	d.SetSize(914400, 457200)
+	assertExtent(914400, 457200)
+
+	// Without aspect ratio, a zero dimension is left unchanged
+	d.SetSize(1828800, 0)
+	assertExtent(1828800, 457200)
+
+	d.KeepAspectRatio(true).SetSize(1828800, 0)
+	assertExtent(1828800, 914400)
+
+	d.SetSize(0, 360000)
+	assertExtent(720000, 360000)
+
+	d.SetSizeCM(4, 0)
+	assertExtent(1440000, 720000)
+
+	d.KeepAspectRatio(false).SetSizeCM(3, 3)
+	assertExtent(1080000, 1080000)
+}
+
+func TestDrawing_SetSizeWritesExtent(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	d, err := rd.AddImage(bytes.NewReader(encodeTestImage(t, ImageFormatPNG, 10, 10)), ImageFormatPNG)
+	if err != nil {
+		t.Fatalf("AddImage: %v", err)
+	}
+	d.SetSize(1000, 2000)
+
+	out, err := xml.Marshal(d.para.ct)
+	if err != nil {
+		t.Fatalf("marshal paragraph: %v", err)
+	}
+	assert.Contains(t, string(out), `<wp:extent cx="1000" cy="2000"></wp:extent>`)
+}
+
+func TestDrawing_Float(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	p := rd.AddParagraph("Text around the logo")
+	d, err := p.AddInlineImage(bytes.NewReader(encodeTestImage(t, ImageFormatPNG, 96, 96)), ImageFormatPNG)
+	if err != nil {
+		t.Fatalf("AddInlineImage: %v", err)
+	}
+	assert.False(t, d.IsFloating())
+
+	d.Float(FloatOptions{
+		HorizontalFrom: dmlst.RelFromHPage,
+		VerticalFrom:   dmlst.RelFromVMargin,
+		X:              units.Inch(0.5).ToEmu(),
+		Y:              -units.Inch(0.25).ToEmu(),
+	}).SetSize(int64(units.Inch(2).ToEmu()), int64(units.Inch(2).ToEmu()))
+	assert.True(t, d.IsFloating())
+
+	out, err := xml.Marshal(p.ct)
+	if err != nil {
+		t.Fatalf("marshal paragraph: %v", err)
+	}
+	xmlStr := string(out)
+	assert.NotContains(t, xmlStr, "<wp:inline")
+	assert.Contains(t, xmlStr, `<wp:anchor behindDoc="0" distT="0" distB="0" distL="0" distR="0" locked="0" layoutInCell="1" allowOverlap="1" relativeHeight="2">`+
+		`<wp:simplePos x="0" y="0"></wp:simplePos>`+
+		`<wp:positionH relativeFrom="page"><wp:posOffset>457200</wp:posOffset></wp:positionH>`+
+		`<wp:positionV relativeFrom="margin"><wp:posOffset>-228600</wp:posOffset></wp:positionV>`+
+		`<wp:extent cx="1828800" cy="1828800"></wp:extent><wp:effectExtent l="0" t="0" r="0" b="0"></wp:effectExtent>`+
+		`<wp:wrapSquare wrapText="bothSides"></wp:wrapSquare><wp:docPr id="2" name="Image2"></wp:docPr>`)
+	assert.Contains(t, xmlStr, `<a:ext cx="1828800" cy="1828800"></a:ext>`)
+
+	tests := []struct {
+		wrap     TextWrap
+		expected string
+	}{
+		{TextWrapTight, `<wp:wrapTight wrapText="bothSides"><wp:wrapPolygon><wp:start x="0" y="0"></wp:start><wp:lineTo x="0" y="21600"></wp:lineTo>`},
+		{TextWrapTopAndBottom, `<wp:wrapTopAndBottom></wp:wrapTopAndBottom>`},
+		{TextWrapBehindText, `<wp:wrapNone></wp:wrapNone>`},
+		{TextWrapInFrontOfText, `<wp:wrapNone></wp:wrapNone>`},
+	}
+	for _, tt := range tests {
+		d.Float(FloatOptions{Wrap: tt.wrap})
+
+		out, err := xml.Marshal(p.ct)
+		if err != nil {
+			t.Fatalf("marshal paragraph: %v", err)
+		}
+		xmlStr := string(out)
+		assert.Equal(t, 1, strings.Count(xmlStr, "<wp:anchor"), "floating again moves the image")
+		assert.Equal(t, 1, strings.Count(xmlStr, "<wp:wrap")-strings.Count(xmlStr, "<wp:wrapPolygon"))
+		assert.Contains(t, xmlStr, tt.expected)
+		assert.Contains(t, xmlStr, `<wp:positionH relativeFrom="column"><wp:posOffset>0</wp:posOffset></wp:positionH>`)
+		assert.Equal(t, tt.wrap == TextWrapBehindText, strings.Contains(xmlStr, `behindDoc="1"`))
+	}
+}
+
+func TestAddInlineImageWithOptions(t *testing.T) {
+	img := encodeTestImage(t, ImageFormatPNG, 200, 100) // 2.08 x 1.04 inches at 96 DPI
+
+	tests := []struct {
+		name          string
+		opts          ImageOptions
+		width, height units.Emu
+		contains      []string
+	}{
+		{"Natural size", ImageOptions{}, 200 * emuPerPixel, 100 * emuPerPixel, nil},
+		{"Scaled", ImageOptions{ScalePercent: 50}, 100 * emuPerPixel, 50 * emuPerPixel, nil},
+		{"Width with aspect ratio", ImageOptions{Width: units.Cm(4).ToEmu(), KeepAspectRatio: true}, units.Cm(4).ToEmu(), units.Cm(2).ToEmu(), nil},
+		{"Width only", ImageOptions{Width: units.Pt(72).ToEmu()}, units.Inch(1).ToEmu(), 100 * emuPerPixel, nil},
+		{
+			"Rotated and cropped",
+			ImageOptions{Rotation: -90, Crop: ImageCrop{Left: 25, Right: 25, Bottom: 10}},
+			100 * emuPerPixel, 90 * emuPerPixel,
+			[]string{`<a:srcRect l="25000" b="10000" r="25000"></a:srcRect>`, `<a:xfrm rot="16200000"><a:ext cx="952500" cy="857250"></a:ext></a:xfrm>`},
+		},
+		{
+			"Cropped with aspect ratio",
+			ImageOptions{Height: 50 * emuPerPixel, KeepAspectRatio: true, Crop: ImageCrop{Left: 50}},
+			50 * emuPerPixel, 50 * emuPerPixel,
+			[]string{`<a:srcRect l="50000"></a:srcRect>`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rd := setupRootDoc(t)
+			p := rd.AddEmptyParagraph()
+			d, err := p.AddInlineImageWithOptions(bytes.NewReader(img), ImageFormatPNG, tt.opts)
+			if err != nil {
+				t.Fatalf("AddInlineImageWithOptions: %v", err)
+			}
+
+			w, h := d.Size()
+			assert.Equal(t, tt.width, w)
+			assert.Equal(t, tt.height, h)
+
+			out, err := xml.Marshal(p.ct)
+			if err != nil {
+				t.Fatalf("marshal paragraph: %v", err)
+			}
+			for _, fragment := range tt.contains {
+				assert.Contains(t, string(out), fragment)
+			}
+			if tt.opts.Rotation == 0 {
+				assert.NotContains(t, string(out), "rot=")
+			}
+		})
+	}
+}
+
+func TestDrawing_CropInvalid(t *testing.T) {
+	rd := setupRootDoc(t)
+	d, err := rd.AddImage(bytes.NewReader(encodeTestImage(t, ImageFormatPNG, 100, 100)), ImageFormatPNG)
+	if err != nil {
+		t.Fatalf("AddImage: %v", err)
+	}
+
+	d.Crop(ImageCrop{Left: 60, Right: 40}).Crop(ImageCrop{Top: -1})
+	assert.Nil(t, d.inline.Graphic.Data.Pic.BlipFill.SrcRect)
+	w, _ := d.Size()
+	assert.Equal(t, units.Emu(100*emuPerPixel), w)
+
+	// Removing the crop restores the size
+	d.Crop(ImageCrop{Left: 20}).Crop(ImageCrop{})
+	w, _ = d.Size()
+	assert.Equal(t, units.Emu(100*emuPerPixel), w)
+	assert.Nil(t, d.inline.Graphic.Data.Pic.BlipFill.SrcRect)
+}
@@ -16,7 +16,15 @@ import (
 //
 // This function generates a new relationship ID, creates a Relationship object with the specified link as the target,
 // and appends it to the document's relationships collection (DocRels.Relationships). It returns the generated ID of the relationship.
+// When shareLinkRels is enabled on the root document, an existing relationship to the same target is reused instead.
 func (doc *Document) addLinkRelation(link string) string {
+	if doc.Root != nil && doc.Root.shareLinkRels {
+		for _, rel := range doc.DocRels.Relationships {
+			if rel.Type == constants.SourceRelationshipHyperLink && rel.TargetMode == "External" && rel.Target == link {
+				return rel.ID
+			}
+		}
+	}
 
 	rID := doc.IncRelationID()
 
@@ -55,3 +63,21 @@ func (doc *Document) addRelation(relType string, fileName string) string {
 
 	return "rId" + strconv.Itoa(rID)
 }
+
+// hasRelation reports whether the document has a relationship of the given type.
+func (doc *Document) hasRelation(relType string) bool {
+	for _, rel := range doc.DocRels.Relationships {
+		if rel.Type == relType {
+			return true
+		}
+	}
+	return false
+}
+
+// ShareHyperlinkRelationships controls whether hyperlinks to the same URL share a single relationship.
+//
+// By default every hyperlink gets its own relationship. When sharing is enabled, links added
+// afterwards reuse an existing external relationship with the same target.
+func (rd *RootDoc) ShareHyperlinkRelationships(share bool) {
+	rd.shareLinkRels = share
+}
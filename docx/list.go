@@ -0,0 +1,277 @@
+package docx
+
+import (
+	"github.com/MamaShip/godocx/common/constants"
+)
+
+// ListType identifies the kind of list created by the list helpers.
+// The values match the abstract numbering ids accepted by NewListInstance.
+type ListType int
+
+const (
+	ListNumbered ListType = 1 // Ordered list (1., a., i., ...)
+	ListBullet   ListType = 2 // Unordered list (bullets)
+)
+
+// maxListLevel is the deepest level supported by the built-in list definitions.
+const maxListLevel = 8
+
+// ListItem represents a single entry of a list together with its nesting level.
+// Level 0 is the outermost level; values are clamped to the range 0-8.
+type ListItem struct {
+	Text  string
+	Level int
+}
+
+// AddNumberedList adds one numbered paragraph per item to the document.
+//
+// Repeated calls continue the same list; use NewList to start a new one.
+//
+// Example:
+//
+//	paras := document.AddNumberedList([]string{"First", "Second", "Third"})
+//	paras[0].Style("Strong")
+func (rd *RootDoc) AddNumberedList(items []string) []*Paragraph {
+	return rd.AddListItems(ListNumbered, toListItems(items))
+}
+
+// AddBulletList adds one bulleted paragraph per item to the document.
+//
+// Repeated calls continue the same list; use NewList to start a new one.
+func (rd *RootDoc) AddBulletList(items []string) []*Paragraph {
+	return rd.AddListItems(ListBullet, toListItems(items))
+}
+
+// SetBulletList makes the paragraph an item of the current bullet list, the one AddBulletList
+// adds items to, at the given nesting level. Level 0 is the outermost level; values are
+// clamped to the range 0-8.
+//
+// Returns:
+//   - *Paragraph: The paragraph instance for method chaining.
+//
+// Example:
+//
+//	document.AddParagraph("Milk").SetBulletList(0)
+//	document.AddParagraph("Semi-skimmed").SetBulletList(1)
+func (p *Paragraph) SetBulletList(level int) *Paragraph {
+	p.Numbering(p.root.Numbering.defaultInstance(ListBullet), clampListLevel(level))
+	return p
+}
+
+// SetNumberedList makes the paragraph an item of the list with the given numId, such as a list
+// created with NewList or NewCustomList, at the given nesting level. A numId of 0 selects the
+// current numbered list, the one AddNumberedList adds items to. Level 0 is the outermost
+// level; values are clamped to the range 0-8.
+//
+// Returns:
+//   - *Paragraph: The paragraph instance for method chaining.
+//
+// Example:
+//
+//	steps := document.NewList(docx.ListNumbered)
+//	document.AddParagraph("Open the lid").SetNumberedList(steps, 0)
+//	document.AddParagraph("Remove the filter").SetNumberedList(steps, 0)
+func (p *Paragraph) SetNumberedList(numID int, level int) *Paragraph {
+	if numID == 0 {
+		numID = p.root.Numbering.defaultInstance(ListNumbered)
+	}
+	p.Numbering(numID, clampListLevel(level))
+	return p
+}
+
+// AddListItems adds one list paragraph per item to the document, using the item level for nesting.
+//
+// Example:
+//
+//	document.AddListItems(docx.ListNumbered, []docx.ListItem{
+//		{Text: "Fruits", Level: 0},
+//		{Text: "Apple", Level: 1},
+//		{Text: "Vegetables", Level: 0},
+//	})
+func (rd *RootDoc) AddListItems(listType ListType, items []ListItem) []*Paragraph {
+	return rd.AddListItemsTo(rd.Numbering.defaultInstance(listType), items)
+}
+
+// AddListItemsTo adds one list paragraph per item to the list with the given numId,
+// such as a list created with NewCustomList, using the item level for nesting.
+func (rd *RootDoc) AddListItemsTo(numID int, items []ListItem) []*Paragraph {
+	paras := make([]*Paragraph, 0, len(items))
+	for _, item := range items {
+		p := rd.AddParagraph(item.Text)
+		p.Numbering(numID, clampListLevel(item.Level))
+		paras = append(paras, p)
+	}
+
+	return paras
+}
+
+// NewList starts a new list of the given type and returns its numId.
+// Subsequent calls to AddNumberedList, AddBulletList and AddListItems for
+// the same list type add items to this new list, restarting the numbering.
+func (rd *RootDoc) NewList(listType ListType) int {
+	return rd.Numbering.newDefaultInstance(listType)
+}
+
+// ContinueList makes the list with the given numId the one the list helpers add items to,
+// so that AddNumberedList, AddBulletList and AddListItems continue its numbering, e.g. after
+// NewList started another list of the same type. Paragraphs added between the items of a
+// list do not interrupt its numbering.
+//
+// Returns ErrUnknownList if numId is not a built-in numbered or bullet list created by the
+// numbering manager; items can still be added to other lists with AddListItemsTo.
+//
+// Example:
+//
+//	steps := document.NewList(docx.ListNumbered)
+//	document.AddNumberedList([]string{"Open the lid", "Remove the filter"})
+//	document.NewList(docx.ListNumbered)
+//	document.AddNumberedList([]string{"Unrelated"})
+//	document.ContinueList(steps)
+//	document.AddNumberedList([]string{"Rinse the filter"}) // 3. Rinse the filter
+func (rd *RootDoc) ContinueList(numID int) error {
+	return rd.Numbering.continueInstance(numID)
+}
+
+// NewCustomList starts a new list whose levels are formatted as given, and returns its numId.
+// levels[i] formats level i; levels that are not given use the formats of the built-in
+// numbered list, or of the built-in bullet list if level 0 is a bullet.
+//
+// Example:
+//
+//	checklist := document.NewCustomList([]docx.LevelFormat{
+//		{Format: stypes.NumFmtBullet, Text: "\uF0FC", Font: "Wingdings"}, // Check mark
+//		{Format: stypes.NumFmtBullet, Text: "–"},
+//	})
+//	document.AddListItemsTo(checklist, []docx.ListItem{{Text: "Done"}, {Text: "Detail", Level: 1}})
+func (rd *RootDoc) NewCustomList(levels []LevelFormat) int {
+	return rd.Numbering.NewCustomListInstance(levels)
+}
+
+// NewLegalList starts a new legal style outline numbered 1, 1.1, 1.1.1 and so on, where each
+// level repeats the numbers of its parents, and returns its numId.
+//
+// Example:
+//
+//	outline := document.NewLegalList()
+//	document.AddListItemsTo(outline, []docx.ListItem{
+//		{Text: "Definitions", Level: 0}, // 1
+//		{Text: "Terms", Level: 1},       // 1.1
+//		{Text: "Scope", Level: 0},       // 2
+//	})
+func (rd *RootDoc) NewLegalList() int {
+	return rd.Numbering.NewCustomListInstance(legalLevelFormats())
+}
+
+// NewListAt starts a new list of the given type whose first item is numbered start,
+// and returns its numId. Like NewList, it becomes the list used by the list helpers.
+//
+// Example:
+//
+//	document.NewListAt(docx.ListNumbered, 5)
+//	document.AddNumberedList([]string{"Fifth", "Sixth"}) // 5. Fifth, 6. Sixth
+func (rd *RootDoc) NewListAt(listType ListType, start int) int {
+	numID := rd.Numbering.newDefaultInstance(listType)
+	_ = rd.Numbering.RestartAt(numID, start)
+	return numID
+}
+
+// defaultInstance returns the list instance used by the list helpers for the given type,
+// creating it on first use.
+func (nm *NumberingManager) defaultInstance(listType ListType) int {
+	nm.mu.Lock()
+	numID, ok := nm.defaults[listType]
+	nm.mu.Unlock()
+
+	if ok {
+		return numID
+	}
+
+	return nm.newDefaultInstance(listType)
+}
+
+// newDefaultInstance creates a new list instance and makes it the default for the given type.
+func (nm *NumberingManager) newDefaultInstance(listType ListType) int {
+	numID := nm.NewListInstance(int(listType))
+
+	nm.mu.Lock()
+	defer nm.mu.Unlock()
+
+	if nm.defaults == nil {
+		nm.defaults = make(map[ListType]int)
+	}
+	nm.defaults[listType] = numID
+
+	return numID
+}
+
+// continueInstance makes the instance the default for the list type of its abstract numbering.
+func (nm *NumberingManager) continueInstance(numID int) error {
+	nm.mu.Lock()
+	defer nm.mu.Unlock()
+
+	for _, inst := range nm.numbering.Instances {
+		if inst.NumId != numID {
+			continue
+		}
+
+		var listType ListType
+		switch inst.AbstractNumId {
+		case nm.normalizeAbstract(int(ListNumbered)):
+			listType = ListNumbered
+		case nm.normalizeAbstract(int(ListBullet)):
+			listType = ListBullet
+		default:
+			return ErrUnknownList
+		}
+
+		if nm.defaults == nil {
+			nm.defaults = make(map[ListType]int)
+		}
+		nm.defaults[listType] = numID
+		return nil
+	}
+
+	return ErrUnknownList
+}
+
+// registerNumberingPart adds the document relationship and content type override
+// for word/numbering.xml unless they are already present.
+func (rd *RootDoc) registerNumberingPart() {
+	if rd.Document != nil {
+		found := false
+		for _, rel := range rd.Document.DocRels.Relationships {
+			if rel.Type == constants.SourceRelationshipNumbering {
+				found = true
+				break
+			}
+		}
+		if !found {
+			rd.Document.addRelation(constants.SourceRelationshipNumbering, "numbering.xml")
+		}
+	}
+
+	for _, override := range rd.ContentType.Override {
+		if override.PartName == "/word/numbering.xml" {
+			return
+		}
+	}
+	_ = rd.ContentType.AddOverride("/word/numbering.xml", constants.ContentTypeNumbering)
+}
+
+func toListItems(items []string) []ListItem {
+	listItems := make([]ListItem, 0, len(items))
+	for _, text := range items {
+		listItems = append(listItems, ListItem{Text: text})
+	}
+	return listItems
+}
+
+func clampListLevel(level int) int {
+	if level < 0 {
+		return 0
+	}
+	if level > maxListLevel {
+		return maxListLevel
+	}
+	return level
+}
@@ -0,0 +1,319 @@
+package docx
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/MamaShip/godocx/common/constants"
+	"github.com/MamaShip/godocx/wml/stypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func newListTestDoc() *RootDoc {
+	rd := NewRootDoc()
+	rd.Document = &Document{Body: &Body{}}
+	return rd
+}
+
+func TestAddNumberedList(t *testing.T) {
+	rd := newListTestDoc()
+
+	paras := rd.AddNumberedList([]string{"One", "Two", "Three"})
+	assert.Len(t, paras, 3)
+	assert.Len(t, rd.Document.Body.Children, 3)
+
+	numID := paras[0].ct.Property.NumProp.NumID.Val
+	for _, p := range paras {
+		assert.Equal(t, numID, p.ct.Property.NumProp.NumID.Val)
+		assert.Equal(t, 0, p.ct.Property.NumProp.ILvl.Val)
+	}
+
+	// Repeated calls continue the same list
+	more := rd.AddNumberedList([]string{"Four"})
+	assert.Equal(t, numID, more[0].ct.Property.NumProp.NumID.Val)
+
+	// Bullet lists get their own instance
+	bullets := rd.AddBulletList([]string{"Dot"})
+	assert.NotEqual(t, numID, bullets[0].ct.Property.NumProp.NumID.Val)
+}
+
+func TestNewListRestartsNumbering(t *testing.T) {
+	rd := newListTestDoc()
+
+	first := rd.AddNumberedList([]string{"A"})
+	newID := rd.NewList(ListNumbered)
+	second := rd.AddNumberedList([]string{"B"})
+
+	assert.NotEqual(t, first[0].ct.Property.NumProp.NumID.Val, newID)
+	assert.Equal(t, newID, second[0].ct.Property.NumProp.NumID.Val)
+}
+
+func TestAddListItemsLevels(t *testing.T) {
+	rd := newListTestDoc()
+
+	paras := rd.AddListItems(ListBullet, []ListItem{
+		{Text: "Top", Level: 0},
+		{Text: "Nested", Level: 1},
+		{Text: "Too deep", Level: 12},
+		{Text: "Negative", Level: -1},
+	})
+
+	levels := []int{0, 1, 8, 0}
+	for i, p := range paras {
+		assert.Equal(t, levels[i], p.ct.Property.NumProp.ILvl.Val)
+	}
+}
+
+func TestParagraphSetList(t *testing.T) {
+	rd := newListTestDoc()
+
+	bullet := rd.AddParagraph("Milk").SetBulletList(0)
+	nested := rd.AddParagraph("Semi-skimmed").SetBulletList(12)
+	assert.Equal(t, rd.AddBulletList([]string{"Eggs"})[0].ct.Property.NumProp.NumID.Val, bullet.ct.Property.NumProp.NumID.Val)
+	assert.Equal(t, 8, nested.ct.Property.NumProp.ILvl.Val)
+
+	first := rd.AddParagraph("First").SetNumberedList(0, 0)
+	assert.Equal(t, rd.AddNumberedList([]string{"Second"})[0].ct.Property.NumProp.NumID.Val, first.ct.Property.NumProp.NumID.Val)
+	assert.NotEqual(t, bullet.ct.Property.NumProp.NumID.Val, first.ct.Property.NumProp.NumID.Val)
+
+	steps := rd.NewList(ListNumbered)
+	step := rd.AddParagraph("Step").SetNumberedList(steps, 1)
+	assert.Equal(t, steps, step.ct.Property.NumProp.NumID.Val)
+	assert.Equal(t, 1, step.ct.Property.NumProp.ILvl.Val)
+}
+
+func TestAddListCreatesNumberingPart(t *testing.T) {
+	rd := newListTestDoc()
+	rd.AddBulletList([]string{"Item"})
+
+	if err := rd.Numbering.applyToFileMap(); err != nil {
+		t.Fatalf("apply numbering: %v", err)
+	}
+
+	v, ok := rd.FileMap.Load("word/numbering.xml")
+	if !ok {
+		t.Fatalf("word/numbering.xml not generated")
+	}
+	assert.True(t, strings.Contains(string(v.([]byte)), `w:abstractNumId w:val="202"`))
+
+	rels := 0
+	for _, rel := range rd.Document.DocRels.Relationships {
+		if rel.Type == constants.SourceRelationshipNumbering {
+			rels++
+			assert.Equal(t, "numbering.xml", rel.Target)
+		}
+	}
+	assert.Equal(t, 1, rels)
+	assert.Contains(t, rd.ContentType.Override, Override{
+		PartName:    "/word/numbering.xml",
+		ContentType: constants.ContentTypeNumbering,
+	})
+
+	// Applying again must not duplicate the relationship or the override
+	if err := rd.Numbering.applyToFileMap(); err != nil {
+		t.Fatalf("apply numbering: %v", err)
+	}
+	assert.Len(t, rd.ContentType.Override, 1)
+}
+
+func TestNewListAt(t *testing.T) {
+	rd := newListTestDoc()
+
+	numID := rd.NewListAt(ListNumbered, 5)
+	paras := rd.AddNumberedList([]string{"Fifth", "Sixth"})
+	assert.Equal(t, numID, paras[0].ct.Property.NumProp.NumID.Val)
+
+	if err := rd.Numbering.applyToFileMap(); err != nil {
+		t.Fatalf("apply numbering: %v", err)
+	}
+	v, _ := rd.FileMap.Load("word/numbering.xml")
+	content := string(v.([]byte))
+
+	// The list uses the decimal "%1." level, restarted at 5
+	assert.Contains(t, content, `<w:num w:numId="`+strconv.Itoa(numID)+`"><w:abstractNumId w:val="201"/><w:lvlOverride w:ilvl="0"><w:startOverride w:val="5"/></w:lvlOverride></w:num>`)
+	assert.Contains(t, content, `<w:lvl w:ilvl="0"><w:start w:val="1"/><w:numFmt w:val="decimal"/><w:lvlText w:val="%1."/>`)
+}
+
+func TestRestartAt(t *testing.T) {
+	rd := newListTestDoc()
+
+	first := rd.NewList(ListNumbered)
+	rd.AddNumberedList([]string{"One", "Two"})
+	continued := rd.NewList(ListNumbered)
+	assert.NoError(t, rd.Numbering.RestartAt(continued, 0))
+	rd.AddNumberedList([]string{"Three"})
+
+	if err := rd.Numbering.applyToFileMap(); err != nil {
+		t.Fatalf("apply numbering: %v", err)
+	}
+	v, _ := rd.FileMap.Load("word/numbering.xml")
+	content := string(v.([]byte))
+	assert.Contains(t, content, `<w:num w:numId="`+strconv.Itoa(first)+`"><w:abstractNumId w:val="201"/><w:lvlOverride w:ilvl="0"><w:startOverride w:val="1"/></w:lvlOverride></w:num>`)
+	assert.Contains(t, content, `<w:num w:numId="`+strconv.Itoa(continued)+`"><w:abstractNumId w:val="201"/></w:num>`)
+
+	// Changes after the numbering part was written update it in place
+	assert.NoError(t, rd.Numbering.RestartAt(first, 3))
+	if err := rd.Numbering.applyToFileMap(); err != nil {
+		t.Fatalf("apply numbering: %v", err)
+	}
+	v, _ = rd.FileMap.Load("word/numbering.xml")
+	content = string(v.([]byte))
+	assert.Equal(t, 1, strings.Count(content, `<w:num w:numId="`+strconv.Itoa(first)+`">`))
+	assert.Contains(t, content, `<w:startOverride w:val="3"/>`)
+	assert.NotContains(t, content, `<w:startOverride w:val="1"/>`)
+
+	assert.ErrorIs(t, rd.Numbering.RestartAt(999, 1), ErrUnknownList)
+}
+
+func TestNewCustomList(t *testing.T) {
+	rd := newListTestDoc()
+
+	checklist := rd.NewCustomList([]LevelFormat{
+		{Format: stypes.NumFmtBullet, Text: "\uF0FC", Font: "Wingdings"},
+		{Format: stypes.NumFmtBullet, Text: "-", Indent: 1080},
+	})
+	paras := rd.AddListItemsTo(checklist, []ListItem{{Text: "Done"}, {Text: "Detail", Level: 1}})
+	assert.Equal(t, checklist, paras[1].ct.Property.NumProp.NumID.Val)
+	assert.Equal(t, 1, paras[1].ct.Property.NumProp.ILvl.Val)
+
+	steps := rd.NewCustomList([]LevelFormat{{Format: stypes.NumFmtUpperRoman, Text: "Step %1:", Start: 3}})
+
+	if err := rd.Numbering.applyToFileMap(); err != nil {
+		t.Fatalf("apply numbering: %v", err)
+	}
+	v, _ := rd.FileMap.Load("word/numbering.xml")
+	content := string(v.([]byte))
+
+	assert.Contains(t, content, `<w:abstractNum w:abstractNumId="301"><w:multiLevelType w:val="hybridMultilevel"/>`+
+		`<w:lvl w:ilvl="0"><w:start w:val="1"/><w:numFmt w:val="bullet"/><w:lvlText w:val="`+"\uF0FC"+`"/><w:lvlJc w:val="left"/>`+
+		`<w:pPr><w:tabs><w:tab w:val="num" w:pos="360"/></w:tabs><w:ind w:left="360" w:hanging="360"/></w:pPr>`+
+		`<w:rPr><w:rFonts w:ascii="Wingdings" w:hAnsi="Wingdings" w:hint="default"/></w:rPr></w:lvl>`)
+	assert.Contains(t, content, `<w:lvl w:ilvl="1"><w:start w:val="1"/><w:numFmt w:val="bullet"/><w:lvlText w:val="-"/><w:lvlJc w:val="left"/><w:pPr><w:tabs><w:tab w:val="num" w:pos="1080"/></w:tabs><w:ind w:left="1080" w:hanging="360"/></w:pPr></w:lvl>`)
+	assert.Contains(t, content, `<w:num w:numId="`+strconv.Itoa(checklist)+`"><w:abstractNumId w:val="301"/>`)
+
+	// Levels not given fall back to the built-in formats
+	assert.Contains(t, content, `<w:abstractNum w:abstractNumId="302"><w:multiLevelType w:val="hybridMultilevel"/><w:lvl w:ilvl="0"><w:start w:val="3"/><w:numFmt w:val="upperRoman"/><w:lvlText w:val="Step %1:"/>`)
+	assert.Contains(t, content, `<w:lvl w:ilvl="1"><w:start w:val="1"/><w:numFmt w:val="lowerLetter"/><w:lvlText w:val="%2."/>`)
+	assert.Contains(t, content, `<w:num w:numId="`+strconv.Itoa(steps)+`"><w:abstractNumId w:val="302"/>`)
+
+	// Abstract numberings precede the instances
+	assert.Less(t, strings.LastIndex(content, "<w:abstractNum "), strings.Index(content, "<w:num "))
+
+	// Applying again does not duplicate the definitions
+	if err := rd.Numbering.applyToFileMap(); err != nil {
+		t.Fatalf("apply numbering: %v", err)
+	}
+	v, _ = rd.FileMap.Load("word/numbering.xml")
+	assert.Equal(t, 1, strings.Count(string(v.([]byte)), `<w:abstractNum w:abstractNumId="301"`))
+}
+
+func TestCustomListSuffix(t *testing.T) {
+	rd := newListTestDoc()
+
+	rd.NewCustomList([]LevelFormat{
+		{Format: stypes.NumFmtDecimal, Text: "%1.", Suffix: stypes.LevelSuffixSpace},
+		{Format: stypes.NumFmtDecimal, Text: "%1.%2", Suffix: stypes.LevelSuffixTab, Indent: 1080, Hanging: 720},
+	})
+
+	if err := rd.Numbering.applyToFileMap(); err != nil {
+		t.Fatalf("apply numbering: %v", err)
+	}
+	v, _ := rd.FileMap.Load("word/numbering.xml")
+	content := string(v.([]byte))
+
+	// A space after the number needs no tab stop
+	assert.Contains(t, content, `<w:lvl w:ilvl="0"><w:start w:val="1"/><w:numFmt w:val="decimal"/><w:suff w:val="space"/><w:lvlText w:val="%1."/><w:lvlJc w:val="left"/>`+
+		`<w:pPr><w:ind w:left="360" w:hanging="360"/></w:pPr></w:lvl>`)
+	assert.Contains(t, content, `<w:lvl w:ilvl="1"><w:start w:val="1"/><w:numFmt w:val="decimal"/><w:lvlText w:val="%1.%2"/><w:lvlJc w:val="left"/>`+
+		`<w:pPr><w:tabs><w:tab w:val="num" w:pos="1080"/></w:tabs><w:ind w:left="1080" w:hanging="720"/></w:pPr></w:lvl>`)
+}
+
+func TestCustomListRestart(t *testing.T) {
+	rd := newListTestDoc()
+
+	numID := rd.NewCustomList([]LevelFormat{
+		{Format: stypes.NumFmtUpperRoman, Text: "%1."},
+		{Format: stypes.NumFmtDecimal, Text: "%2.", Restart: -1},
+		{Format: stypes.NumFmtLowerLetter, Text: "%3)", Restart: 1},
+	})
+	rd.AddParagraph("Part").SetNumberedList(numID, 0)
+
+	if err := rd.Numbering.applyToFileMap(); err != nil {
+		t.Fatalf("apply numbering: %v", err)
+	}
+	v, _ := rd.FileMap.Load("word/numbering.xml")
+	content := string(v.([]byte))
+
+	assert.Contains(t, content, `<w:lvl w:ilvl="0"><w:start w:val="1"/><w:numFmt w:val="upperRoman"/><w:lvlText w:val="%1."/>`)
+	assert.Contains(t, content, `<w:lvl w:ilvl="1"><w:start w:val="1"/><w:numFmt w:val="decimal"/><w:lvlRestart w:val="0"/><w:lvlText w:val="%2."/>`)
+	assert.Contains(t, content, `<w:lvl w:ilvl="2"><w:start w:val="1"/><w:numFmt w:val="lowerLetter"/><w:lvlRestart w:val="1"/><w:lvlText w:val="%3)"/>`)
+}
+
+// listLabel returns the label Word shows for a decimal list item, given the level text and
+// the current number of each level.
+func listLabel(text string, numbers []int) string {
+	for lvl := len(numbers); lvl > 0; lvl-- {
+		text = strings.ReplaceAll(text, "%"+strconv.Itoa(lvl), strconv.Itoa(numbers[lvl-1]))
+	}
+	return text
+}
+
+func TestNewLegalList(t *testing.T) {
+	rd := newListTestDoc()
+
+	outline := rd.NewLegalList()
+	paras := rd.AddListItemsTo(outline, []ListItem{{Text: "Definitions"}, {Text: "Terms", Level: 1}, {Text: "Notices", Level: 2}})
+	assert.Equal(t, outline, paras[1].ct.Property.NumProp.NumID.Val)
+	assert.Equal(t, 1, paras[1].ct.Property.NumProp.ILvl.Val)
+
+	levels := rd.Numbering.customAbstracts[301]
+	assert.Equal(t, "%1.%2.%3", levels[2].Text)
+	assert.Equal(t, "1.1", listLabel(levels[1].Text, []int{1, 1}))
+	assert.Equal(t, "1.1.1", listLabel(levels[2].Text, []int{1, 1, 1}))
+
+	if err := rd.Numbering.applyToFileMap(); err != nil {
+		t.Fatalf("apply numbering: %v", err)
+	}
+	v, _ := rd.FileMap.Load("word/numbering.xml")
+	content := string(v.([]byte))
+
+	assert.Contains(t, content, `<w:lvl w:ilvl="0"><w:start w:val="1"/><w:numFmt w:val="decimal"/><w:lvlText w:val="%1"/>`)
+	assert.Contains(t, content, `<w:lvl w:ilvl="1"><w:start w:val="1"/><w:numFmt w:val="decimal"/><w:lvlText w:val="%1.%2"/><w:lvlJc w:val="left"/>`+
+		`<w:pPr><w:tabs><w:tab w:val="num" w:pos="576"/></w:tabs><w:ind w:left="576" w:hanging="576"/></w:pPr></w:lvl>`)
+	assert.Contains(t, content, `<w:num w:numId="`+strconv.Itoa(outline)+`"><w:abstractNumId w:val="301"/>`)
+}
+
+func TestListContinuesAcrossParagraphs(t *testing.T) {
+	rd := newListTestDoc()
+
+	before := rd.AddNumberedList([]string{"One", "Two"})
+	note := rd.AddParagraph("An interrupting paragraph")
+	after := rd.AddNumberedList([]string{"Three"})
+
+	numID := before[0].ct.Property.NumProp.NumID.Val
+	assert.Equal(t, numID, after[0].ct.Property.NumProp.NumID.Val)
+	assert.Nil(t, note.ct.Property)
+	assert.Len(t, rd.Numbering.numbering.Instances, 1)
+}
+
+func TestContinueList(t *testing.T) {
+	rd := newListTestDoc()
+
+	steps := rd.NewList(ListNumbered)
+	rd.AddNumberedList([]string{"Open the lid", "Remove the filter"})
+	other := rd.NewList(ListNumbered)
+	rd.AddNumberedList([]string{"Unrelated"})
+
+	assert.NoError(t, rd.ContinueList(steps))
+	resumed := rd.AddNumberedList([]string{"Rinse the filter"})
+	assert.Equal(t, steps, resumed[0].ct.Property.NumProp.NumID.Val)
+	assert.NotEqual(t, other, steps)
+
+	// No new instance was created, so the numbering goes on from the earlier items
+	assert.Len(t, rd.Numbering.numbering.Instances, 2)
+
+	custom := rd.NewCustomList([]LevelFormat{{Format: stypes.NumFmtBullet, Text: "-"}})
+	assert.ErrorIs(t, rd.ContinueList(custom), ErrUnknownList)
+	assert.ErrorIs(t, rd.ContinueList(999), ErrUnknownList)
+}
@@ -0,0 +1,47 @@
+package docx
+
+import (
+	"bytes"
+	"encoding/xml"
+
+	"github.com/MamaShip/godocx/wml/ctypes"
+)
+
+// Logger receives diagnostic messages while a document is parsed, such as the parts that
+// were loaded and the unknown elements that were skipped.
+//
+// The arguments following the message are alternating keys and values, so a *slog.Logger
+// can be used directly.
+//
+// Example:
+//
+//	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug}))
+//	document, err := godocx.OpenDocumentWithLogger("report.docx", logger)
+type Logger interface {
+	Debug(msg string, args ...any)
+}
+
+// SetLogger sets the logger receiving diagnostic messages. A nil logger disables them.
+func (rd *RootDoc) SetLogger(logger Logger) {
+	rd.logger = logger
+}
+
+// LogDebug forwards a diagnostic message to the logger set with SetLogger, if any.
+func (rd *RootDoc) LogDebug(msg string, args ...any) {
+	if rd != nil && rd.logger != nil {
+		rd.logger.Debug(msg, args...)
+	}
+}
+
+// newDecoder returns a decoder of the content that reports the unknown elements skipped
+// within paragraphs, runs and tables to the logger, if any, until release is called.
+func (rd *RootDoc) newDecoder(content []byte) (d *xml.Decoder, release func()) {
+	d = xml.NewDecoder(bytes.NewReader(content))
+	if rd == nil || rd.logger == nil {
+		return d, func() {}
+	}
+
+	return d, ctypes.NotifySkipped(d, func(element, parent string) {
+		rd.LogDebug("skipped unknown element", "element", element, "parent", parent)
+	})
+}
@@ -0,0 +1,66 @@
+package docx_test
+
+import (
+	"strings"
+	"testing"
+
+	godocx "github.com/MamaShip/godocx"
+	"github.com/MamaShip/godocx/packager"
+	"github.com/MamaShip/godocx/wml/stypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type logEntry struct {
+	msg  string
+	args []any
+}
+
+type recordingLogger struct {
+	entries []logEntry
+}
+
+func (l *recordingLogger) Debug(msg string, args ...any) {
+	l.entries = append(l.entries, logEntry{msg: msg, args: args})
+}
+
+func TestUnpackWithLogger_LogsSkippedElements(t *testing.T) {
+	rd, err := godocx.NewDocument()
+	require.NoError(t, err)
+	rd.AddParagraph("Hello")
+
+	files, _ := writeParts(t, rd)
+	files["word/document.xml"] = []byte(strings.Replace(string(files["word/document.xml"]),
+		"<w:body>", "<w:body><w:customXml><w:p></w:p></w:customXml>", 1))
+	files["word/document.xml"] = []byte(strings.Replace(string(files["word/document.xml"]),
+		"<w:r>", `<w:proofErr w:type="spellStart"/><w:r><w:rPr><w14:ligatures w14:val="standard"/></w:rPr>`, 1))
+
+	content := zipParts(t, files)
+
+	logger := &recordingLogger{}
+	reopened, err := packager.UnpackWithLogger(&content, logger)
+	require.NoError(t, err)
+	assert.Len(t, reopened.Document.Body.Children, 1)
+
+	assert.Contains(t, logger.entries, logEntry{"skipped unknown element", []any{"element", "customXml", "parent", "body"}})
+	assert.Contains(t, logger.entries, logEntry{"skipped unknown element", []any{"element", "proofErr", "parent", "p"}})
+	assert.Contains(t, logger.entries, logEntry{"skipped unknown element", []any{"element", "ligatures", "parent", "rPr"}})
+	assert.Equal(t, "Hello", reopened.PlainText())
+	assert.Contains(t, logger.entries, logEntry{"parsed part", []any{"part", "word/document.xml"}})
+
+	// Without a logger, parsing stays silent
+	_, err = packager.Unpack(&content)
+	require.NoError(t, err)
+}
+
+func TestAddBreak_LogsInvalidType(t *testing.T) {
+	rd, err := godocx.NewDocument()
+	require.NoError(t, err)
+	logger := &recordingLogger{}
+	rd.SetLogger(logger)
+
+	invalid := stypes.BreakType("section")
+	rd.AddParagraph("Text").AddText("").AddBreak(&invalid)
+
+	assert.Equal(t, []logEntry{{"replaced invalid break type by a line break", []any{"type", "section"}}}, logger.entries)
+}
@@ -0,0 +1,714 @@
+package docx
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/MamaShip/godocx/common/constants"
+	"github.com/MamaShip/godocx/internal"
+	"github.com/MamaShip/godocx/wml/ctypes"
+	"github.com/MamaShip/godocx/wml/stypes"
+)
+
+// ErrNoDocument is returned when a document to append has no main document part.
+var ErrNoDocument = errors.New("document has no main document part")
+
+// AppendOptions configures how a document is appended by AppendDocumentWith.
+type AppendOptions struct {
+	// SectionBreak, if set, ends the current section before the appended content, so that it
+	// starts e.g. on a new page (stypes.SectionMarkNextPage). The new section has the page
+	// layout of the current one. Empty appends the content to the current section.
+	SectionBreak stypes.SectionMark
+}
+
+var (
+	mergeNumPattern         = regexp.MustCompile(`(?s)<w:num\b[^>]*\bw:numId="(\d+)"[^>]*>.*?</w:num>`)
+	mergeAbstractNumPattern = regexp.MustCompile(`(?s)<w:abstractNum\b[^>]*\bw:abstractNumId="(\d+)".*?</w:abstractNum>`)
+	mergeAbstractRefPattern = regexp.MustCompile(`<w:abstractNumId w:val="(\d+)"`)
+	mergeAbstractIDPattern  = regexp.MustCompile(`w:abstractNumId="\d+"`)
+	mergeNumIDPattern       = regexp.MustCompile(`w:numId="(\d+)"`)
+	mergeLvlPattern         = regexp.MustCompile(`(?s)<w:lvl\b[^>]*\bw:ilvl="(\d+)".*?</w:lvl>`)
+	mergeStartPattern       = regexp.MustCompile(`<w:start w:val="(\d+)"`)
+	mergeLvlOverridePattern = regexp.MustCompile(`<w:lvlOverride\b[^>]*\bw:ilvl="(\d+)"`)
+)
+
+// uncopiedReferences are the elements referencing parts of the appended document that are
+// not copied, removed from the appended content.
+var uncopiedReferences = map[string]bool{
+	"w:headerReference":   true,
+	"w:footerReference":   true,
+	"w:footnoteReference": true,
+	"w:endnoteReference":  true,
+	"w:commentRangeStart": true,
+	"w:commentRangeEnd":   true,
+	"w:commentReference":  true,
+}
+
+// AppendDocument appends the content of the other document to the end of this one.
+// See AppendDocumentWith for details.
+func (rd *RootDoc) AppendDocument(other *RootDoc) error {
+	return rd.AppendDocumentWith(other, AppendOptions{})
+}
+
+// AppendDocumentWith appends the content of the body of the other document to the end of
+// this one, with what it needs to render the same:
+//   - the images and other parts it references are copied, and its hyperlinks are added,
+//     under new relationship IDs;
+//   - the lists it uses are copied to the numbering part under new numbering IDs, so they
+//     neither collide with nor continue the lists of this document;
+//   - the styles it uses that this document lacks are copied, along with the styles they
+//     are based on. Styles with the same ID as a style of this document take its formatting.
+//
+// Bookmark and drawing IDs are renumbered to stay unique. The page layout of the last
+// section of the other document is not copied, nor are its headers, footers, comments
+// and notes: references to its headers and footers are removed from its section breaks,
+// and references to its notes and comments from its paragraphs.
+//
+// The other document is not modified, and can be appended several times.
+//
+// Returns:
+//   - error: ErrNoDocument if either document has no main document part, or an error if a
+//     part referenced by the other document is missing.
+//
+// Example:
+//
+//	report, _ := godocx.OpenDocument("report.docx")
+//	annex, _ := godocx.OpenDocument("annex.docx")
+//	err := report.AppendDocumentWith(annex, docx.AppendOptions{SectionBreak: stypes.SectionMarkNextPage})
+func (rd *RootDoc) AppendDocumentWith(other *RootDoc, opts AppendOptions) error {
+	if rd.Document == nil || rd.Document.Body == nil || other == nil || other.Document == nil || other.Document.Body == nil {
+		return ErrNoDocument
+	}
+
+	m := newDocumentMerger(rd, other)
+
+	// Lists created through the numbering managers are written to the numbering parts
+	// first, so that the numbering IDs are allocated against complete parts
+	for _, doc := range []*RootDoc{rd, other} {
+		if doc.Numbering != nil {
+			if err := doc.Numbering.applyToFileMap(); err != nil {
+				return err
+			}
+		}
+	}
+
+	children, err := m.copyChildren(other.Document.Body.Children)
+	if err != nil {
+		return err
+	}
+
+	if err := m.copyStyles(); err != nil {
+		return err
+	}
+
+	if opts.SectionBreak != "" {
+		rd.AddSectionBreak(opts.SectionBreak)
+	}
+	rd.Document.Body.Children = append(rd.Document.Body.Children, children...)
+	return nil
+}
+
+// documentMerger copies content of another document into a document, mapping the IDs of
+// the other document to new IDs of the document.
+//
+// It also copies content within a document, e.g. the items of a repeating section, see
+// newContentCopier.
+type documentMerger struct {
+	rd, other *RootDoc
+
+	// within is set when the content is copied within the document: the relationships,
+	// lists and styles of the copies are those of the content, and their comments are copied
+	within bool
+
+	relIDs      map[string]string // Relationship IDs
+	numIDs      map[string]string // Numbering instance IDs (w:numId)
+	abstractIDs map[string]string // Abstract numbering IDs
+	reused      map[string]bool   // Abstract numberings of the other document found in the document
+	bookmarkIDs map[string]string
+	drawingIDs  map[string]string // Drawing object IDs (wp:docPr and cNvPr)
+	sdtIDs      map[string]string // Content control IDs
+
+	// Copies within the document only
+	commentIDs    map[string]string
+	bookmarkNames map[string]string
+	usedNames     map[string]bool // Names of the bookmarks of the document and of the copies
+
+	styles    []string // IDs of the styles used by the copied content, in order of use
+	styleSeen map[string]bool
+}
+
+// newDocumentMerger returns a merger copying content of the other document into the document.
+func newDocumentMerger(rd, other *RootDoc) *documentMerger {
+	m := &documentMerger{
+		rd:          rd,
+		other:       other,
+		relIDs:      make(map[string]string),
+		numIDs:      map[string]string{"0": "0"},
+		abstractIDs: make(map[string]string),
+		reused:      make(map[string]bool),
+		styleSeen:   make(map[string]bool),
+	}
+	m.newCopy()
+	return m
+}
+
+// newContentCopier returns a merger copying content within the document. The copies share
+// the relationships, lists and styles of the content, while their bookmarks, drawings,
+// content controls and comments get new IDs and their bookmarks new names.
+//
+// The IDs are mapped once per copy: newCopy is called before each copy of the same content.
+func (rd *RootDoc) newContentCopier() *documentMerger {
+	m := newDocumentMerger(rd, rd)
+	m.within = true
+	return m
+}
+
+// newCopy starts a new copy, whose bookmarks, drawings, content controls and comments get
+// IDs other than those of the previous copies.
+func (m *documentMerger) newCopy() {
+	m.bookmarkIDs = make(map[string]string)
+	m.drawingIDs = make(map[string]string)
+	m.sdtIDs = make(map[string]string)
+	m.commentIDs = make(map[string]string)
+	m.bookmarkNames = make(map[string]string)
+}
+
+// copyChildren returns a copy of body children of the other document, with the IDs mapped.
+func (m *documentMerger) copyChildren(children []DocumentChild) ([]DocumentChild, error) {
+	copied := make([]DocumentChild, 0, len(children))
+	for _, child := range children {
+		c, err := m.copyChild(child)
+		if err != nil {
+			return nil, err
+		}
+		copied = append(copied, c)
+	}
+	return copied, nil
+}
+
+// copyRows returns a copy of table rows of the other document, with the IDs mapped.
+func (m *documentMerger) copyRows(rows []ctypes.RowContent) ([]ctypes.RowContent, error) {
+	content, err := xml.Marshal(ctypes.Table{RowContents: rows})
+	if err != nil {
+		return nil, err
+	}
+
+	if content, err = m.rewrite(content, make(map[string]bool)); err != nil {
+		return nil, err
+	}
+
+	table := &ctypes.Table{}
+	if err = m.rd.decodeCopy(content, table); err != nil {
+		return nil, err
+	}
+	return table.RowContents, nil
+}
+
+// copyChild returns a copy of a body child of the other document, with the IDs mapped.
+func (m *documentMerger) copyChild(child DocumentChild) (DocumentChild, error) {
+	content, err := xml.Marshal(Body{Children: []DocumentChild{child}})
+	if err != nil {
+		return DocumentChild{}, err
+	}
+
+	prefixes := make(map[string]bool)
+	content, err = m.rewrite(content, prefixes)
+	if err != nil {
+		return DocumentChild{}, err
+	}
+
+	if child.Raw != nil {
+		fragment := strings.TrimSuffix(strings.TrimPrefix(string(content), "<w:body>"), "</w:body>")
+		names := make([]string, 0, len(prefixes))
+		for prefix := range prefixes {
+			names = append(names, prefix)
+		}
+		raw, err := ctypes.NewRawXML(fragment, names)
+		if err != nil {
+			return DocumentChild{}, err
+		}
+		return DocumentChild{Raw: raw}, nil
+	}
+
+	body := NewBody(m.rd)
+	if err = m.rd.decodeCopy(content, body); err != nil {
+		return DocumentChild{}, err
+	}
+	if len(body.Children) != 1 {
+		return DocumentChild{}, fmt.Errorf("unexpected content when copying a document element: %d elements", len(body.Children))
+	}
+	return body.Children[0], nil
+}
+
+// decodeCopy decodes the encoded copy of an element of the document, e.g. a w:body, into v.
+func (rd *RootDoc) decodeCopy(content []byte, v any) error {
+	// The namespaces of the document are declared for the elements matched by namespace
+	var root strings.Builder
+	root.WriteString("<w:document")
+	for _, attr := range docAttrs {
+		root.WriteString(" " + attr.Name.Local + `="` + escapeAttr(attr.Value) + `"`)
+	}
+	root.WriteString(">")
+
+	d := xml.NewDecoder(strings.NewReader(root.String() + string(content) + "</w:document>"))
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		if start, ok := token.(xml.StartElement); ok && start.Name.Local != "document" {
+			return d.DecodeElement(v, &start)
+		}
+	}
+}
+
+// rewrite returns the content with the IDs mapped, collecting the styles it uses and the
+// namespace prefixes of its elements and attributes.
+func (m *documentMerger) rewrite(content []byte, prefixes map[string]bool) ([]byte, error) {
+	d := xml.NewDecoder(bytes.NewReader(content))
+	var out bytes.Buffer
+	e := xml.NewEncoder(&out)
+
+	skip := 0
+	for {
+		token, err := d.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			name := prefixedName(t.Name)
+			// Headers, footers, notes and comments of the other document are not copied
+			if skip > 0 || (!m.within && uncopiedReferences[name]) {
+				skip++
+				continue
+			}
+
+			prefixes[t.Name.Space] = true
+			start := xml.StartElement{Name: xml.Name{Local: name}}
+			for _, attr := range t.Attr {
+				prefixes[attr.Name.Space] = true
+				value, err := m.mapAttr(name, attr)
+				if err != nil {
+					return nil, err
+				}
+				start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: prefixedName(attr.Name)}, Value: value})
+			}
+			token = start
+		case xml.EndElement:
+			if skip > 0 {
+				skip--
+				continue
+			}
+			token = xml.EndElement{Name: xml.Name{Local: prefixedName(t.Name)}}
+		default:
+			if skip > 0 {
+				continue
+			}
+		}
+
+		if err := e.EncodeToken(token); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := e.Flush(); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+// mapAttr returns the value of the attribute of the element in the document.
+func (m *documentMerger) mapAttr(element string, attr xml.Attr) (string, error) {
+	name := prefixedName(attr.Name)
+
+	switch {
+	case m.within && (attr.Name.Space == "r" || (element == "w:numId" && name == "w:val")):
+		// Copies within the document share the relationships and lists of the content
+		return attr.Value, nil
+	case m.within && element == "w:bookmarkStart" && name == "w:name":
+		return m.bookmarkName(attr.Value), nil
+	case m.within && (element == "w:commentRangeStart" || element == "w:commentRangeEnd" || element == "w:commentReference") && name == "w:id":
+		return m.commentID(attr.Value)
+	case attr.Name.Space == "r":
+		return m.relID(attr.Value)
+	case element == "w:numId" && name == "w:val":
+		return m.numID(attr.Value)
+	case (element == "w:bookmarkStart" || element == "w:bookmarkEnd") && name == "w:id":
+		return mapID(m.bookmarkIDs, attr.Value, func() string { return strconv.Itoa(m.rd.nextBookmarkID()) }), nil
+	case element == "w:id" && name == "w:val": // Content control ID
+		return mapID(m.sdtIDs, attr.Value, func() string { return strconv.Itoa(m.rd.nextContentControlID()) }), nil
+	case (attr.Name.Local == "id" && attr.Name.Space == "") && (strings.HasSuffix(element, ":docPr") || strings.HasSuffix(element, ":cNvPr")):
+		return mapID(m.drawingIDs, attr.Value, func() string { return strconv.FormatUint(uint64(m.rd.nextDrawingID()), 10) }), nil
+	case (element == "w:pStyle" || element == "w:rStyle" || element == "w:tblStyle") && name == "w:val":
+		m.useStyle(attr.Value)
+	}
+
+	return attr.Value, nil
+}
+
+// mapID returns the ID mapped to old, mapping it to a new ID if needed.
+func mapID(ids map[string]string, old string, newID func() string) string {
+	if id, ok := ids[old]; ok {
+		return id
+	}
+	ids[old] = newID()
+	return ids[old]
+}
+
+// bookmarkName returns the name of the copy of the bookmark with the given name, a name not
+// used by the other bookmarks of the document, e.g. "Total_2" for "Total".
+func (m *documentMerger) bookmarkName(old string) string {
+	if m.usedNames == nil {
+		m.usedNames = make(map[string]bool)
+		m.rd.walkParagraphs(func(p *ctypes.Paragraph) {
+			for _, child := range p.Children {
+				if child.BookmarkStart != nil {
+					m.usedNames[child.BookmarkStart.Name] = true
+				}
+			}
+		})
+	}
+
+	return mapID(m.bookmarkNames, old, func() string {
+		for i := 2; ; i++ {
+			name := old + "_" + strconv.Itoa(i)
+			if !m.usedNames[name] {
+				m.usedNames[name] = true
+				return name
+			}
+		}
+	})
+}
+
+// commentID returns the ID of the copy of the comment with the given ID, adding the copy to
+// the comments of the document if needed.
+func (m *documentMerger) commentID(old string) (string, error) {
+	if id, ok := m.commentIDs[old]; ok {
+		return id, nil
+	}
+
+	var comment *Comment
+	part := m.rd.commentsPart(false)
+	if part != nil {
+		for _, c := range part.comments {
+			if strconv.Itoa(c.ID) == old {
+				comment = c
+				break
+			}
+		}
+	}
+	if comment == nil {
+		// A dangling reference is kept as is, for Validate to report
+		m.commentIDs[old] = old
+		return old, nil
+	}
+
+	children, err := m.copyChildren(comment.Children)
+	if err != nil {
+		return "", err
+	}
+	walkChildrenParagraphs(children, func(p *ctypes.Paragraph) {
+		p.ParaID = nil
+	})
+
+	copied := &Comment{
+		root:     m.rd,
+		ID:       part.nextID(),
+		Author:   comment.Author,
+		Initials: comment.Initials,
+		Date:     comment.Date,
+		Children: children,
+		resolved: comment.resolved,
+		parent:   comment.parent,
+	}
+	part.comments = append(part.comments, copied)
+
+	m.commentIDs[old] = strconv.Itoa(copied.ID)
+	return m.commentIDs[old], nil
+}
+
+// relID returns the ID of the relationship of the document matching the relationship of the
+// other document, adding it and copying its target part if needed.
+func (m *documentMerger) relID(old string) (string, error) {
+	if id, ok := m.relIDs[old]; ok {
+		return id, nil
+	}
+
+	var rel *Relationship
+	for _, r := range m.other.Document.DocRels.Relationships {
+		if r.ID == old {
+			rel = r
+			break
+		}
+	}
+	if rel == nil {
+		// A dangling reference is kept as is, for Validate to report
+		return old, nil
+	}
+
+	var id string
+	if rel.TargetMode == "External" {
+		id = "rId" + strconv.Itoa(m.rd.Document.IncRelationID())
+		m.rd.Document.DocRels.Relationships = append(m.rd.Document.DocRels.Relationships, &Relationship{
+			ID:         id,
+			Type:       rel.Type,
+			Target:     rel.Target,
+			TargetMode: rel.TargetMode,
+		})
+	} else {
+		target, err := m.copyPart(rel.Target)
+		if err != nil {
+			return "", err
+		}
+		id = m.rd.Document.addRelation(rel.Type, target)
+	}
+
+	m.relIDs[old] = id
+	return id, nil
+}
+
+// copyPart copies the part of the other document at the target, relative to the word
+// folder, and returns the target of the copy.
+func (m *documentMerger) copyPart(target string) (string, error) {
+	partPath := "word/" + target
+	if strings.HasPrefix(target, "/") {
+		partPath = strings.TrimPrefix(target, "/")
+	}
+
+	content, ok := m.other.FileMap.Load(partPath)
+	if !ok {
+		return "", fmt.Errorf("part %s of the appended document is missing", partPath)
+	}
+
+	// Parts with the same name are renamed, e.g. media/image1.png to media/image1_2.png
+	newPath := partPath
+	ext := path.Ext(partPath)
+	for i := 2; ; i++ {
+		if _, exists := m.rd.FileMap.Load(newPath); !exists {
+			break
+		}
+		newPath = strings.TrimSuffix(partPath, ext) + "_" + strconv.Itoa(i) + ext
+	}
+	m.rd.FileMap.Store(newPath, content)
+
+	for _, override := range m.other.ContentType.Override {
+		if override.PartName == "/"+partPath {
+			_ = m.rd.ContentType.AddOverride("/"+newPath, override.ContentType)
+		}
+	}
+	for _, def := range m.other.ContentType.Default {
+		if strings.EqualFold("."+def.Extension, ext) {
+			_ = m.rd.ContentType.AddExtension(def.Extension, def.ContentType)
+		}
+	}
+
+	return strings.TrimPrefix(newPath, "word/"), nil
+}
+
+// numID returns the numbering instance ID of the document matching the instance of the
+// other document, copying the instance and its abstract numbering if needed.
+func (m *documentMerger) numID(old string) (string, error) {
+	if id, ok := m.numIDs[old]; ok {
+		return id, nil
+	}
+
+	otherNumbering := ""
+	if content, ok := m.other.FileMap.Load("word/numbering.xml"); ok {
+		otherNumbering = string(content.([]byte))
+	}
+	var num string
+	for _, match := range mergeNumPattern.FindAllStringSubmatch(otherNumbering, -1) {
+		if match[1] == old {
+			num = match[0]
+			break
+		}
+	}
+	if num == "" {
+		// A dangling reference is kept as is, for Validate to report
+		m.numIDs[old] = old
+		return old, nil
+	}
+
+	numbering := m.numbering()
+
+	// Abstract numbering, reusing an identical definition of the document
+	abstractRef := mergeAbstractRefPattern.FindStringSubmatch(num)
+	if abstractRef != nil {
+		oldAbstract := abstractRef[1]
+		if _, ok := m.abstractIDs[oldAbstract]; !ok {
+			m.abstractIDs[oldAbstract], m.reused[oldAbstract] = m.copyAbstractNum(&numbering, otherNumbering, oldAbstract)
+		}
+		num = mergeAbstractRefPattern.ReplaceAllLiteralString(num, `<w:abstractNumId w:val="`+m.abstractIDs[oldAbstract]+`"`)
+
+		// Instances of the same abstract numbering continue each other unless restarted
+		if m.reused[oldAbstract] {
+			num = strings.TrimSuffix(num, "</w:num>") + restartOverrides(num, otherNumbering, oldAbstract) + "</w:num>"
+		}
+	}
+
+	// Instance
+	maxID := 0
+	for _, match := range mergeNumIDPattern.FindAllStringSubmatch(numbering, -1) {
+		if v, err := strconv.Atoi(match[1]); err == nil && v > maxID {
+			maxID = v
+		}
+	}
+	id := strconv.Itoa(maxID + 1)
+	num = strings.Replace(num, `w:numId="`+old+`"`, `w:numId="`+id+`"`, 1)
+
+	insertAt := strings.Index(numbering, "<w:numIdMacAtCleanup")
+	if insertAt < 0 {
+		insertAt = strings.LastIndex(numbering, "</w:numbering>")
+	}
+	numbering = numbering[:insertAt] + num + numbering[insertAt:]
+	m.rd.FileMap.Store("word/numbering.xml", []byte(numbering))
+
+	m.numIDs[old] = id
+	return id, nil
+}
+
+// copyAbstractNum adds the abstract numbering of the other document to the numbering part,
+// unless the part has an identical one, and returns its ID in the document and whether the
+// identical one was used.
+func (m *documentMerger) copyAbstractNum(numbering *string, otherNumbering, old string) (string, bool) {
+	var abstract string
+	for _, match := range mergeAbstractNumPattern.FindAllStringSubmatch(otherNumbering, -1) {
+		if match[1] == old {
+			abstract = match[0]
+			break
+		}
+	}
+	if abstract == "" {
+		return old, false
+	}
+
+	normalized := mergeAbstractIDPattern.ReplaceAllLiteralString(abstract, "")
+	maxID := 0
+	for _, match := range mergeAbstractNumPattern.FindAllStringSubmatch(*numbering, -1) {
+		if mergeAbstractIDPattern.ReplaceAllLiteralString(match[0], "") == normalized {
+			return match[1], true
+		}
+		if v, err := strconv.Atoi(match[1]); err == nil && v > maxID {
+			maxID = v
+		}
+	}
+
+	id := strconv.Itoa(maxID + 1)
+	abstract = strings.Replace(abstract, `w:abstractNumId="`+old+`"`, `w:abstractNumId="`+id+`"`, 1)
+
+	// Abstract numberings come before the instances
+	insertAt := strings.Index(*numbering, "<w:num ")
+	if insertAt < 0 {
+		insertAt = strings.LastIndex(*numbering, "</w:numbering>")
+	}
+	*numbering = (*numbering)[:insertAt] + abstract + (*numbering)[insertAt:]
+	return id, false
+}
+
+// restartOverrides returns the w:lvlOverride elements restarting each level of the abstract
+// numbering of the other document at its start value, except the levels the instance
+// already overrides.
+func restartOverrides(num, otherNumbering, abstractID string) string {
+	overridden := make(map[string]bool)
+	for _, match := range mergeLvlOverridePattern.FindAllStringSubmatch(num, -1) {
+		overridden[match[1]] = true
+	}
+
+	var sb strings.Builder
+	for _, abstract := range mergeAbstractNumPattern.FindAllStringSubmatch(otherNumbering, -1) {
+		if abstract[1] != abstractID {
+			continue
+		}
+		for _, lvl := range mergeLvlPattern.FindAllStringSubmatch(abstract[0], -1) {
+			if overridden[lvl[1]] {
+				continue
+			}
+			start := "0"
+			if match := mergeStartPattern.FindStringSubmatch(lvl[0]); match != nil {
+				start = match[1]
+			}
+			sb.WriteString(`<w:lvlOverride w:ilvl="` + lvl[1] + `"><w:startOverride w:val="` + start + `"/></w:lvlOverride>`)
+		}
+		break
+	}
+	return sb.String()
+}
+
+// numbering returns the numbering part of the document, creating it if needed.
+func (m *documentMerger) numbering() string {
+	if content, ok := m.rd.FileMap.Load("word/numbering.xml"); ok {
+		return string(content.([]byte))
+	}
+
+	m.rd.registerNumberingPart()
+	return string(constants.XMLHeader) + `<w:numbering xmlns:w="` + constants.XMLNS_W + `"></w:numbering>`
+}
+
+func (m *documentMerger) useStyle(id string) {
+	if !m.styleSeen[id] {
+		m.styleSeen[id] = true
+		m.styles = append(m.styles, id)
+	}
+}
+
+// copyStyles copies the styles used by the copied content that the document lacks, and the
+// styles they depend on.
+func (m *documentMerger) copyStyles() error {
+	if m.other.DocStyles == nil {
+		return nil
+	}
+
+	existing := make(map[string]bool)
+	if m.rd.DocStyles != nil {
+		for _, style := range m.rd.DocStyles.StyleList {
+			if style.ID != nil {
+				existing[*style.ID] = true
+			}
+		}
+	}
+
+	for i := 0; i < len(m.styles); i++ {
+		id := m.styles[i]
+		if existing[id] {
+			continue
+		}
+
+		for _, style := range m.other.DocStyles.StyleList {
+			if style.ID == nil || *style.ID != id {
+				continue
+			}
+
+			copied := internal.DeepCopy(style)
+			for _, ref := range []*ctypes.CTString{copied.BasedOn, copied.Next, copied.Link} {
+				if ref != nil {
+					m.useStyle(ref.Val)
+				}
+			}
+			if copied.ParaProp != nil && copied.ParaProp.NumProp != nil && copied.ParaProp.NumProp.NumID != nil {
+				numID, err := m.numID(strconv.Itoa(copied.ParaProp.NumProp.NumID.Val))
+				if err != nil {
+					return err
+				}
+				copied.ParaProp.NumProp.NumID.Val, _ = strconv.Atoi(numID)
+			}
+
+			styles := m.rd.ensureStyles()
+			styles.StyleList = append(styles.StyleList, copied)
+			existing[id] = true
+			break
+		}
+	}
+	return nil
+}
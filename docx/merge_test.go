@@ -0,0 +1,146 @@
+package docx_test
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	godocx "github.com/MamaShip/godocx"
+	"github.com/MamaShip/godocx/common/units"
+	"github.com/MamaShip/godocx/docx"
+	"github.com/MamaShip/godocx/packager"
+	"github.com/MamaShip/godocx/wml/stypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppendDocument(t *testing.T) {
+	report, err := godocx.NewDocument()
+	require.NoError(t, err)
+	report.AddParagraph("Report").AddBookmark("intro")
+	report.AddNumberedList([]string{"First", "Second"})
+	_, err = report.AddPicture("../godocx.png", units.Inch(1), units.Inch(1))
+	require.NoError(t, err)
+
+	annex, err := godocx.NewDocument()
+	require.NoError(t, err)
+	require.NoError(t, annex.AddParagraphStyle(docx.StyleDefinition{ID: "AnnexBody", BasedOn: "AnnexBase", Italic: true}))
+	require.NoError(t, annex.AddParagraphStyle(docx.StyleDefinition{ID: "AnnexBase", Color: "808080"}))
+	annexTitle := annex.AddParagraph("Annex").AddBookmark("annex")
+	annexTitle.Style("AnnexBody")
+	annex.AddParagraph("See ").AddLink("example", "https://example.com")
+	annex.AddNumberedList([]string{"Step"})
+	_, err = annex.AddPicture("../godocx.png", units.Inch(1), units.Inch(1))
+	require.NoError(t, err)
+	annex.AddTable(1, 1).Cell(0, 0).AddParagraph("Cell")
+
+	require.NoError(t, report.AppendDocumentWith(annex, docx.AppendOptions{SectionBreak: stypes.SectionMarkNextPage}))
+	require.NoError(t, report.AppendDocument(annex))
+	assert.Empty(t, report.Validate())
+
+	files, _ := writeParts(t, report)
+	document := string(files["word/document.xml"])
+	rels := string(files["word/_rels/document.xml.rels"])
+
+	// Images: one copy of the annex image per append, each with its own relationship
+	embeds := regexp.MustCompile(`r:embed="(rId\d+)"`).FindAllStringSubmatch(document, -1)
+	require.Len(t, embeds, 3)
+	targets := map[string]bool{}
+	for _, embed := range embeds {
+		target := regexp.MustCompile(`Id="` + embed[1] + `" Type="[^"]*/image" Target="([^"]+)"`).FindStringSubmatch(rels)
+		require.NotNil(t, target, embed[1])
+		require.Contains(t, files, "word/"+target[1])
+		targets[target[1]] = true
+	}
+	assert.Len(t, targets, 3)
+	assert.Equal(t, 2, strings.Count(rels, `Target="https://example.com" TargetMode="External"`))
+
+	// Lists: each appended list is a new instance
+	numIDs := regexp.MustCompile(`<w:numId w:val="(\d+)">`).FindAllStringSubmatch(document, -1)
+	require.Len(t, numIDs, 4)
+	assert.Equal(t, numIDs[0][1], numIDs[1][1])
+	assert.NotEqual(t, numIDs[0][1], numIDs[2][1])
+	assert.NotEqual(t, numIDs[2][1], numIDs[3][1])
+	numbering := string(files["word/numbering.xml"])
+	for _, id := range numIDs {
+		assert.Equal(t, 1, strings.Count(numbering, `<w:num w:numId="`+id[1]+`">`), id[1])
+	}
+
+	// Styles: copied once, with the style they are based on
+	styles := string(files["word/styles.xml"])
+	assert.Equal(t, 1, strings.Count(styles, `w:styleId="AnnexBody"`))
+	assert.Equal(t, 1, strings.Count(styles, `w:styleId="AnnexBase"`))
+
+	// Bookmark and drawing IDs stay unique
+	for _, pattern := range []string{`<w:bookmarkStart w:id="(\d+)"`, `<wp:docPr id="(\d+)"`} {
+		seen := map[string]bool{}
+		for _, match := range regexp.MustCompile(pattern).FindAllStringSubmatch(document, -1) {
+			assert.False(t, seen[match[1]], "duplicate ID %s of %s", match[1], pattern)
+			seen[match[1]] = true
+		}
+	}
+
+	// Only the first append starts a new section
+	assert.Equal(t, 1, strings.Count(document, `<w:type w:val="nextPage">`)+strings.Count(document, `<w:type w:val="nextPage"/>`))
+	assert.Equal(t, 2, strings.Count(document, "<w:sectPr"))
+	assert.ErrorIs(t, report.AppendDocument(nil), docx.ErrNoDocument)
+}
+
+func TestAppendDocument_RestartsReusedNumbering(t *testing.T) {
+	report, err := godocx.NewDocument()
+	require.NoError(t, err)
+	report.AddNumberedList([]string{"First", "Second"})
+
+	annex, err := godocx.NewDocument()
+	require.NoError(t, err)
+	annex.AddNumberedList([]string{"Step"})
+
+	// A list instance without overrides, as written by most editors
+	files, _ := writeParts(t, annex)
+	files["word/numbering.xml"] = regexp.MustCompile(`<w:lvlOverride\b.*?</w:lvlOverride>`).ReplaceAll(files["word/numbering.xml"], nil)
+	content := zipParts(t, files)
+	annex, err = packager.Unpack(&content)
+	require.NoError(t, err)
+
+	require.NoError(t, report.AppendDocument(annex))
+
+	files, _ = writeParts(t, report)
+	numIDs := regexp.MustCompile(`<w:numId w:val="(\d+)">`).FindAllStringSubmatch(string(files["word/document.xml"]), -1)
+	require.Len(t, numIDs, 3)
+	numbering := string(files["word/numbering.xml"])
+	abstractOf := func(numID string) string {
+		match := regexp.MustCompile(`<w:num w:numId="` + numID + `"><w:abstractNumId w:val="(\d+)"/>`).FindStringSubmatch(numbering)
+		require.NotNil(t, match, numID)
+		return match[1]
+	}
+	assert.Equal(t, abstractOf(numIDs[0][1]), abstractOf(numIDs[2][1]))
+	num := regexp.MustCompile(`<w:num w:numId="` + numIDs[2][1] + `">.*?</w:num>`).FindString(numbering)
+	assert.Contains(t, num, `<w:lvlOverride w:ilvl="0"><w:startOverride w:val="1"/></w:lvlOverride>`)
+	assert.Contains(t, num, `<w:lvlOverride w:ilvl="8">`)
+}
+
+func TestAppendDocument_NotesAndComments(t *testing.T) {
+	report, err := godocx.NewDocument()
+	require.NoError(t, err)
+	require.NoError(t, report.AddParagraph("Report").AddFootnote("Report note"))
+
+	annex, err := godocx.NewDocument()
+	require.NoError(t, err)
+	p := annex.AddParagraph("Annex")
+	require.NoError(t, p.AddFootnote("First annex note"))
+	require.NoError(t, p.AddFootnote("Second annex note"))
+	require.NoError(t, p.AddEndnote("Annex endnote"))
+	annex.AddComment("Jane Doe", "JD", p.AddText(" reviewed"))
+
+	// The notes and comments of the annex are not copied, nor are the references to them
+	require.NoError(t, report.AppendDocument(annex))
+	assert.Empty(t, report.Validate())
+	assert.Equal(t, "Report\nAnnex reviewed", report.PlainText())
+
+	files, _ := writeParts(t, report)
+	document := string(files["word/document.xml"])
+	assert.Equal(t, 1, strings.Count(document, "<w:footnoteReference "))
+	for _, name := range []string{"w:endnoteReference", "w:commentRangeStart", "w:commentRangeEnd", "w:commentReference"} {
+		assert.NotContains(t, document, "<"+name, name)
+	}
+}
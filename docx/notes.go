@@ -0,0 +1,413 @@
+package docx
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/MamaShip/godocx/common/constants"
+	"github.com/MamaShip/godocx/internal"
+	"github.com/MamaShip/godocx/wml/ctypes"
+	"github.com/MamaShip/godocx/wml/stypes"
+)
+
+// ErrRunNotInDocument is returned when a note is added to a run that is not part of a
+// paragraph of the document body.
+var ErrRunNotInDocument = errors.New("run is not part of the document body")
+
+// ErrNoteInHeaderFooter is returned when a note is added to a paragraph of a header or a
+// footer, where Word does not allow notes.
+var ErrNoteInHeaderFooter = errors.New("notes cannot be added to headers and footers")
+
+// noteKind describes the part and styles used by footnotes or endnotes.
+type noteKind struct {
+	name        string // footnote or endnote, the element name without prefix
+	path        string // Path of the part created when the document has none
+	relType     string
+	contentType string
+	textStyle   string // Paragraph style of the note text
+	refStyle    string // Character style of the note number
+}
+
+var (
+	footnoteKind = noteKind{
+		name:        "footnote",
+		path:        "word/footnotes.xml",
+		relType:     constants.SourceRelationshipFootnotes,
+		contentType: constants.ContentTypeFootnotes,
+		textStyle:   "FootnoteText",
+		refStyle:    "FootnoteReference",
+	}
+
+	endnoteKind = noteKind{
+		name:        "endnote",
+		path:        "word/endnotes.xml",
+		relType:     constants.SourceRelationshipEndnotes,
+		contentType: constants.ContentTypeEndnotes,
+		textStyle:   "EndnoteText",
+		refStyle:    "EndnoteReference",
+	}
+)
+
+// AddFootnote adds a footnote with the given text to the document and inserts its
+// reference mark right after the run, using the FootnoteReference character style.
+//
+// The footnotes part is created on first use, with the separator notes Word expects.
+// The notes already present in a loaded document keep their IDs.
+//
+// Returns:
+//   - error: ErrRunNotInDocument if the run is not part of a paragraph of the document body,
+//     or the parse error of the footnotes part of a loaded document, which is then left as is.
+//
+// Example:
+//
+//	run := document.AddParagraph("").AddText("The figures are unaudited")
+//	err := run.AddFootnote("Source: internal accounts, 2023.")
+func (r *Run) AddFootnote(text string) error {
+	return r.addNote(footnoteKind, text)
+}
+
+// AddEndnote adds an endnote with the given text to the document and inserts its
+// reference mark right after the run, using the EndnoteReference character style.
+//
+// See AddFootnote for the handling of the endnotes part.
+func (r *Run) AddEndnote(text string) error {
+	return r.addNote(endnoteKind, text)
+}
+
+func (r *Run) addNote(kind noteKind, text string) error {
+	if r.root == nil {
+		return ErrRunNotInDocument
+	}
+
+	para, idx := r.root.findRun(r.ct)
+	if para == nil {
+		return ErrRunNotInDocument
+	}
+
+	id, err := r.root.addNote(kind, text)
+	if err != nil {
+		return err
+	}
+	insertParagraphChild(para, idx+1, ctypes.ParagraphChild{Run: r.root.noteReference(kind, id)})
+
+	return nil
+}
+
+// AddFootnote adds a footnote with the given text to the document and appends its reference
+// mark to the end of the paragraph. See Run.AddFootnote to place the mark after a run.
+//
+// Returns:
+//   - error: ErrNoteInHeaderFooter if the paragraph is part of a header or a footer, or the
+//     parse error of the footnotes part of a loaded document, which is then left as is.
+//
+// Example:
+//
+//	err := document.AddParagraph("The figures are unaudited.").AddFootnote("Source: internal accounts.")
+func (p *Paragraph) AddFootnote(text string) error {
+	return p.addNote(footnoteKind, text)
+}
+
+// AddEndnote adds an endnote with the given text to the document and appends its reference
+// mark to the end of the paragraph. See Run.AddEndnote to place the mark after a run.
+func (p *Paragraph) AddEndnote(text string) error {
+	return p.addNote(endnoteKind, text)
+}
+
+func (p *Paragraph) addNote(kind noteKind, text string) error {
+	if p.hf != nil {
+		return ErrNoteInHeaderFooter
+	}
+
+	id, err := p.root.addNote(kind, text)
+	if err != nil {
+		return err
+	}
+	p.ct.Children = append(p.ct.Children, ctypes.ParagraphChild{Run: p.root.noteReference(kind, id)})
+	return nil
+}
+
+// noteReference returns a run holding the reference mark of the footnote or endnote.
+func (rd *RootDoc) noteReference(kind noteKind, id int) *ctypes.Run {
+	refRun := &ctypes.Run{}
+	if kind.name == "footnote" {
+		refRun.Children = []ctypes.RunChild{{FootnoteReference: ctypes.NewFtnEdnRef(id)}}
+	} else {
+		refRun.Children = []ctypes.RunChild{{EndnoteReference: ctypes.NewFtnEdnRef(id)}}
+	}
+	newRun(rd, refRun).Style(kind.refStyle)
+	return refRun
+}
+
+// findRun returns the paragraph of the document body holding the run and the index of
+// the paragraph child containing it, or nil if the run is not found.
+func (rd *RootDoc) findRun(run *ctypes.Run) (*ctypes.Paragraph, int) {
+	var (
+		found *ctypes.Paragraph
+		index int
+	)
+
+	rd.walkParagraphs(func(p *ctypes.Paragraph) {
+		if found != nil {
+			return
+		}
+		for i, child := range p.Children {
+			if childHasRun(child, run) {
+				found, index = p, i
+				return
+			}
+		}
+	})
+
+	return found, index
+}
+
+// childHasRun reports whether the paragraph child is the run, or an insertion, content control
+// or hyperlink containing it.
+func childHasRun(child ctypes.ParagraphChild, run *ctypes.Run) bool {
+	if child.Run == run {
+		return true
+	}
+	if child.Ins != nil {
+		for _, insRun := range child.Ins.Runs {
+			if insRun == run {
+				return true
+			}
+		}
+	}
+	if child.Sdt != nil {
+		for _, sdtChild := range child.Sdt.Content {
+			if childHasRun(sdtChild, run) {
+				return true
+			}
+		}
+	}
+	if child.Link == nil {
+		return false
+	}
+	if child.Link.Run == run {
+		return true
+	}
+	for _, linkChild := range child.Link.Children {
+		if childHasRun(linkChild, run) {
+			return true
+		}
+	}
+	return false
+}
+
+// note is a footnote or an endnote of the document (w:footnote or w:endnote).
+type note struct {
+	ID       int
+	Type     string          // Type of a special note, e.g. "separator"; empty for the notes of the text
+	Children []DocumentChild // Content of the note
+}
+
+// notesPart holds the footnotes or endnotes of the document, stored in the footnotes or
+// endnotes part.
+type notesPart struct {
+	kind  noteKind
+	path  string
+	notes []*note
+}
+
+// addNote appends a note with the given text to the footnotes or endnotes part,
+// creating the part if needed, and returns its ID.
+func (rd *RootDoc) addNote(kind noteKind, text string) (int, error) {
+	part, err := rd.notesPart(kind, true)
+	if err != nil {
+		return 0, err
+	}
+
+	rd.ensureStyle(kind.textStyle, stypes.StyleTypeParagraph)
+	rd.ensureStyle(kind.refStyle, stypes.StyleTypeCharacter)
+
+	refRun := &ctypes.Run{}
+	if kind.name == "footnote" {
+		refRun.Children = []ctypes.RunChild{{FootnoteRef: &ctypes.Empty{}}}
+	} else {
+		refRun.Children = []ctypes.RunChild{{EndnoteRef: &ctypes.Empty{}}}
+	}
+	newRun(rd, refRun).Style(kind.refStyle)
+
+	p := newParagraph(rd)
+	p.Style(kind.textStyle)
+	p.ct.Children = []ctypes.ParagraphChild{
+		{Run: refRun},
+		{Run: &ctypes.Run{Children: []ctypes.RunChild{{Text: ctypes.TextFromString(" " + rd.applyRunOptions(text))}}}},
+	}
+
+	n := &note{ID: part.nextID(), Children: []DocumentChild{{Para: p}}}
+	part.notes = append(part.notes, n)
+	return n.ID, nil
+}
+
+// notesPart returns the footnotes or endnotes part of the document, parsing it from the file
+// map the first time it is requested. If the document has none, a new part holding the
+// separator notes Word expects is registered when create is set and nil is returned otherwise.
+//
+// A part that cannot be parsed is left as loaded, so that it is saved unchanged, and the
+// parse error is returned.
+func (rd *RootDoc) notesPart(kind noteKind, create bool) (*notesPart, error) {
+	loaded := &rd.footnotes
+	if kind.name == endnoteKind.name {
+		loaded = &rd.endnotes
+	}
+	if *loaded != nil {
+		return *loaded, nil
+	}
+
+	for _, rel := range rd.Document.DocRels.Relationships {
+		if rel.Type != kind.relType {
+			continue
+		}
+
+		path := "word/" + rel.Target
+		if strings.HasPrefix(rel.Target, "/") {
+			path = strings.TrimPrefix(rel.Target, "/")
+		}
+
+		part := &notesPart{kind: kind, path: path}
+		if content, ok := rd.FileMap.Load(path); ok {
+			notes, err := rd.parseNotes(kind, content.([]byte))
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s", err, path)
+			}
+			part.notes = notes
+		} else {
+			part.notes = rd.separatorNotes()
+		}
+
+		*loaded = part
+		return part, nil
+	}
+
+	if !create {
+		return nil, nil
+	}
+
+	rd.Document.addRelation(kind.relType, strings.TrimPrefix(kind.path, "word/"))
+	_ = rd.ContentType.AddOverride("/"+kind.path, kind.contentType)
+
+	*loaded = &notesPart{kind: kind, path: kind.path, notes: rd.separatorNotes()}
+	return *loaded, nil
+}
+
+// separatorNotes returns the separator (-1) and continuation separator (0) notes of a new
+// footnotes or endnotes part.
+func (rd *RootDoc) separatorNotes() []*note {
+	separator := func(noteType string, id int, mark ctypes.RunChild) *note {
+		p := newParagraph(rd)
+		p.ct.Property = &ctypes.ParagraphProp{Spacing: &ctypes.Spacing{
+			After:    internal.ToPtr(uint64(0)),
+			Line:     internal.ToPtr(240),
+			LineRule: internal.ToPtr(stypes.LineSpacingRuleAuto),
+		}}
+		p.ct.Children = []ctypes.ParagraphChild{{Run: &ctypes.Run{Children: []ctypes.RunChild{mark}}}}
+		return &note{ID: id, Type: noteType, Children: []DocumentChild{{Para: p}}}
+	}
+
+	return []*note{
+		separator("separator", -1, ctypes.RunChild{Separator: &ctypes.Empty{}}),
+		separator("continuationSeparator", 0, ctypes.RunChild{ContSeparator: &ctypes.Empty{}}),
+	}
+}
+
+// nextID returns an ID not used by the notes of the part, starting at 1 after the
+// separator notes.
+func (np *notesPart) nextID() int {
+	id := 1
+	for _, existing := range np.notes {
+		if existing.ID >= id {
+			id = existing.ID + 1
+		}
+	}
+	return id
+}
+
+// parseNotes decodes the w:footnote or w:endnote elements of a footnotes or endnotes part.
+func (rd *RootDoc) parseNotes(kind noteKind, content []byte) ([]*note, error) {
+	var notes []*note
+
+	d, release := rd.newDecoder(content)
+	defer release()
+	for {
+		token, err := d.Token()
+		if err != nil {
+			if err == io.EOF {
+				return notes, nil
+			}
+			return notes, err
+		}
+
+		elem, ok := token.(xml.StartElement)
+		if !ok || elem.Name.Local != kind.name {
+			continue
+		}
+
+		n := &note{}
+		for _, attr := range elem.Attr {
+			switch attr.Name.Local {
+			case "id":
+				n.ID, _ = strconv.Atoi(attr.Value)
+			case "type":
+				n.Type = attr.Value
+			}
+		}
+
+		body := NewBody(rd)
+		if err := body.UnmarshalXML(d, elem); err != nil {
+			return notes, err
+		}
+		n.Children = body.Children
+		notes = append(notes, n)
+	}
+}
+
+// MarshalXML implements the xml.Marshaler interface for the footnotes or endnotes part.
+func (np notesPart) MarshalXML(e *xml.Encoder, start xml.StartElement) (err error) {
+	start.Name.Local = "w:" + np.kind.name + "s"
+	start.Attr = append(start.Attr, docAttrs...)
+
+	if err = e.EncodeToken(start); err != nil {
+		return err
+	}
+
+	for _, n := range np.notes {
+		if err = n.MarshalXML(e, xml.StartElement{Name: xml.Name{Local: "w:" + np.kind.name}}); err != nil {
+			return err
+		}
+	}
+
+	return e.EncodeToken(xml.EndElement{Name: start.Name})
+}
+
+// MarshalXML implements the xml.Marshaler interface for the note type. The element name,
+// w:footnote or w:endnote, is taken from start.
+func (n note) MarshalXML(e *xml.Encoder, start xml.StartElement) (err error) {
+	start.Attr = nil
+	if n.Type != "" {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "w:type"}, Value: n.Type})
+	}
+	start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "w:id"}, Value: strconv.Itoa(n.ID)})
+
+	if err = e.EncodeToken(start); err != nil {
+		return err
+	}
+
+	// A note holds at least one paragraph
+	if len(n.Children) == 0 {
+		if err = (&ctypes.Paragraph{}).MarshalXML(e, xml.StartElement{}); err != nil {
+			return err
+		}
+	}
+
+	if err = marshalChildren(e, n.Children); err != nil {
+		return err
+	}
+
+	return e.EncodeToken(xml.EndElement{Name: start.Name})
+}
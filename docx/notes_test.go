@@ -0,0 +1,139 @@
+package docx_test
+
+import (
+	"strings"
+	"testing"
+
+	godocx "github.com/MamaShip/godocx"
+	"github.com/MamaShip/godocx/docx"
+	"github.com/MamaShip/godocx/packager"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddFootnote(t *testing.T) {
+	rd, err := godocx.NewDocument()
+	require.NoError(t, err)
+
+	p := rd.AddParagraph("")
+	require.NoError(t, p.AddText("Revenue grew").AddFootnote("Unaudited figures."))
+	p.AddText(" in every region")
+
+	files, _ := writeParts(t, rd)
+
+	document := string(files["word/document.xml"])
+	assert.Contains(t, document, `<w:t>Revenue grew</w:t></w:r><w:r><w:rPr><w:rStyle w:val="FootnoteReference"></w:rStyle></w:rPr><w:footnoteReference w:id="1"></w:footnoteReference></w:r><w:r><w:t xml:space="preserve"> in every region</w:t>`)
+
+	footnotes := string(files["word/footnotes.xml"])
+	assert.Equal(t, 1, strings.Count(footnotes, `w:type="separator" w:id="-1"`))
+	assert.Equal(t, 1, strings.Count(footnotes, `w:type="continuationSeparator" w:id="0"`))
+	assert.Contains(t, footnotes, `<w:footnote w:id="1"><w:p><w:pPr><w:pStyle w:val="FootnoteText"></w:pStyle></w:pPr>`+
+		`<w:r><w:rPr><w:rStyle w:val="FootnoteReference"></w:rStyle></w:rPr><w:footnoteRef></w:footnoteRef></w:r><w:r><w:t xml:space="preserve"> Unaudited figures.</w:t></w:r></w:p></w:footnote>`)
+
+	assert.Contains(t, string(files["word/_rels/document.xml.rels"]), `Target="footnotes.xml"`)
+	assert.Contains(t, string(files["[Content_Types].xml"]), `PartName="/word/footnotes.xml"`)
+
+	styles := string(files["word/styles.xml"])
+	assert.Contains(t, styles, `w:styleId="FootnoteReference"`)
+	assert.Contains(t, styles, `w:styleId="FootnoteText"`)
+}
+
+func TestAddFootnote_RoundTripKeepsIDs(t *testing.T) {
+	rd, err := godocx.NewDocument()
+	require.NoError(t, err)
+
+	require.NoError(t, rd.AddParagraph("").AddText("First").AddFootnote("One"))
+	require.NoError(t, rd.AddParagraph("").AddText("Second").AddFootnote("Two"))
+
+	_, content := writeParts(t, rd)
+	reopened, err := packager.Unpack(&content)
+	require.NoError(t, err)
+
+	require.NoError(t, reopened.AddParagraph("").AddText("Third").AddFootnote("Three"))
+
+	files, _ := writeParts(t, reopened)
+
+	footnotes := string(files["word/footnotes.xml"])
+	assert.Equal(t, 1, strings.Count(footnotes, `w:type="separator"`))
+	assert.Regexp(t, `<w:footnote w:id="1">.*One.*<w:footnote w:id="2">.*Two.*<w:footnote w:id="3">.*Three`, footnotes)
+
+	document := string(files["word/document.xml"])
+	assert.Contains(t, document, `<w:footnoteReference w:id="1"></w:footnoteReference>`)
+	assert.Contains(t, document, `<w:footnoteReference w:id="2"></w:footnoteReference>`)
+	assert.Contains(t, document, `<w:footnoteReference w:id="3"></w:footnoteReference>`)
+	assert.Equal(t, 1, strings.Count(string(files["word/_rels/document.xml.rels"]), `Target="footnotes.xml"`))
+	assert.Equal(t, 1, strings.Count(string(files["[Content_Types].xml"]), `PartName="/word/footnotes.xml"`))
+}
+
+func TestAddFootnote_UnparsablePartIsKept(t *testing.T) {
+	rd, err := godocx.NewDocument()
+	require.NoError(t, err)
+	require.NoError(t, rd.AddParagraph("").AddText("First").AddFootnote("One"))
+	require.NoError(t, rd.AddParagraph("").AddText("Second").AddFootnote("Two"))
+
+	files, _ := writeParts(t, rd)
+	footnotes := strings.Replace(string(files["word/footnotes.xml"]),
+		`<w:pStyle w:val="FootnoteText"></w:pStyle>`, `<w:pStyle w:val="FootnoteText"></w:pStyle><w:ind w:left="1cm"/>`, 1)
+	files["word/footnotes.xml"] = []byte(footnotes)
+	content := zipParts(t, files)
+
+	reopened, err := packager.Unpack(&content)
+	require.NoError(t, err)
+	third := reopened.AddParagraph("")
+	assert.Error(t, third.AddText("Third").AddFootnote("Three"))
+	assert.Error(t, third.AddFootnote("Three"))
+
+	// The footnotes are saved as loaded and no reference is added
+	files, _ = writeParts(t, reopened)
+	assert.Equal(t, footnotes, string(files["word/footnotes.xml"]))
+	assert.Equal(t, 2, strings.Count(string(files["word/document.xml"]), "<w:footnoteReference "))
+}
+
+func TestAddEndnote(t *testing.T) {
+	rd, err := godocx.NewDocument()
+	require.NoError(t, err)
+
+	cell := rd.AddTable().AddRow().AddCell()
+	require.NoError(t, cell.AddParagraph("").AddText("In a table").AddEndnote("See appendix."))
+
+	files, _ := writeParts(t, rd)
+
+	assert.Contains(t, string(files["word/document.xml"]), `<w:rStyle w:val="EndnoteReference"></w:rStyle></w:rPr><w:endnoteReference w:id="1"></w:endnoteReference>`)
+	endnotes := string(files["word/endnotes.xml"])
+	assert.Contains(t, endnotes, `<w:endnote w:type="separator" w:id="-1">`)
+	assert.Contains(t, endnotes, `<w:endnoteRef></w:endnoteRef></w:r><w:r><w:t xml:space="preserve"> See appendix.</w:t>`)
+	assert.NotContains(t, files, "word/footnotes.xml")
+}
+
+func TestAddFootnote_RunNotInDocument(t *testing.T) {
+	rd, err := godocx.NewDocument()
+	require.NoError(t, err)
+
+	run := rd.AddHeader(docx.HeaderFooterDefault).AddParagraph("").AddText("Header")
+	assert.ErrorIs(t, run.AddFootnote("Not allowed"), docx.ErrRunNotInDocument)
+}
+
+func TestParagraph_AddFootnoteAndEndnote(t *testing.T) {
+	rd, err := godocx.NewDocument()
+	require.NoError(t, err)
+
+	p := rd.AddParagraph("The figures are unaudited.")
+	require.NoError(t, p.AddFootnote("Source: internal accounts."))
+	require.NoError(t, p.AddEndnote("See the appendix."))
+	require.NoError(t, rd.AddParagraph("Growth was strong.").AddFootnote("Year on year."))
+
+	header := rd.AddHeader(docx.HeaderFooterDefault).AddParagraph("Header")
+	assert.ErrorIs(t, header.AddFootnote("Not allowed"), docx.ErrNoteInHeaderFooter)
+
+	files, _ := writeParts(t, rd)
+
+	document := string(files["word/document.xml"])
+	assert.Contains(t, document, `<w:t>The figures are unaudited.</w:t></w:r>`+
+		`<w:r><w:rPr><w:rStyle w:val="FootnoteReference"></w:rStyle></w:rPr><w:footnoteReference w:id="1"></w:footnoteReference></w:r>`+
+		`<w:r><w:rPr><w:rStyle w:val="EndnoteReference"></w:rStyle></w:rPr><w:endnoteReference w:id="1"></w:endnoteReference></w:r></w:p>`)
+	assert.Contains(t, document, `<w:footnoteReference w:id="2"></w:footnoteReference></w:r></w:p>`)
+
+	assert.Regexp(t, `<w:footnote w:id="1">.*Source: internal accounts\..*<w:footnote w:id="2">.*Year on year\.`, string(files["word/footnotes.xml"]))
+	assert.Contains(t, string(files["word/endnotes.xml"]), `See the appendix.`)
+	assert.NotContains(t, string(files["word/footnotes.xml"]), "Not allowed")
+}
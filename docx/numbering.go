@@ -2,11 +2,15 @@ package docx
 
 import (
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
+
+	"github.com/MamaShip/godocx/wml/stypes"
 )
 
 // NumInstance represents a w:num element that creates an instance of abstract numbering
@@ -14,8 +18,16 @@ type NumInstance struct {
 	XMLName       xml.Name `xml:"w:num"`
 	NumId         int      `xml:"w:numId,attr"`
 	AbstractNumId int      `xml:"w:abstractNumId"`
+
+	// Start is the number of the first item at level 0 (w:startOverride).
+	// Zero leaves the level unchanged, so numbering continues from earlier instances
+	// of the same abstract numbering.
+	Start int `xml:"-"`
 }
 
+// ErrUnknownList is returned when a numId was not created by the numbering manager.
+var ErrUnknownList = errors.New("unknown list instance")
+
 // Numbering represents the numbering part of a Word document
 type Numbering struct {
 	XMLName   xml.Name       `xml:"w:numbering"`
@@ -28,6 +40,14 @@ type NumberingManager struct {
 	numbering *Numbering
 	nextNumId int
 	rootDoc   *RootDoc
+
+	// defaults holds the list instance reused by AddNumberedList and AddBulletList, per list type
+	defaults map[ListType]int
+
+	// customAbstracts holds the level formats of the lists created with NewCustomListInstance,
+	// by abstract numbering ID
+	customAbstracts map[int][]LevelFormat
+	nextAbstractId  int
 }
 
 // NewNumberingManager creates a new numbering manager
@@ -58,12 +78,86 @@ func (nm *NumberingManager) NewListInstance(abstractNumId int) int {
 		XMLName:       xml.Name{Local: "w:num"},
 		NumId:         numId,
 		AbstractNumId: nm.normalizeAbstract(abstractNumId),
+		Start:         1,
 	}
 
 	nm.numbering.Instances = append(nm.numbering.Instances, instance)
 	return numId
 }
 
+// NewCustomListInstance creates a new abstract numbering with the given level formats and an
+// instance of it. Returns the numId that can be used with paragraph.Numbering().
+//
+// levels[i] formats level i; levels that are not given use the default ordered formats, or
+// the default bullets if level 0 is a bullet.
+func (nm *NumberingManager) NewCustomListInstance(levels []LevelFormat) int {
+	nm.mu.Lock()
+	defer nm.mu.Unlock()
+
+	nm.ensureNextNumIdFromTemplate()
+	nm.ensureNextAbstractIdFromTemplate()
+
+	listType := ListNumbered
+	if len(levels) > 0 && levels[0].Format == stypes.NumFmtBullet {
+		listType = ListBullet
+	}
+
+	formats := make([]LevelFormat, maxListLevel+1)
+	for lvl := range formats {
+		if lvl < len(levels) {
+			formats[lvl] = levels[lvl]
+		} else {
+			formats[lvl] = defaultLevelFormat(listType, lvl)
+		}
+	}
+
+	abstractId := nm.nextAbstractId
+	nm.nextAbstractId++
+	if nm.customAbstracts == nil {
+		nm.customAbstracts = make(map[int][]LevelFormat)
+	}
+	nm.customAbstracts[abstractId] = formats
+
+	numId := nm.nextNumId
+	nm.nextNumId++
+	nm.numbering.Instances = append(nm.numbering.Instances, &NumInstance{
+		XMLName:       xml.Name{Local: "w:num"},
+		NumId:         numId,
+		AbstractNumId: abstractId,
+		Start:         1,
+	})
+	return numId
+}
+
+// RestartAt sets the number of the first level 0 item of the list instance.
+//
+// A start of zero or less removes the restart, so the list continues the numbering of the
+// previous instances of the same abstract numbering, e.g. a list "continued" after a
+// paragraph of explanation.
+//
+// Returns ErrUnknownList if numId was not created by NewListInstance.
+//
+// Example:
+//
+//	numId := doc.NewListInstance(1)
+//	doc.Numbering.RestartAt(numId, 5) // First item is numbered "5."
+func (nm *NumberingManager) RestartAt(numId int, start int) error {
+	nm.mu.Lock()
+	defer nm.mu.Unlock()
+
+	if start < 0 {
+		start = 0
+	}
+
+	for _, inst := range nm.numbering.Instances {
+		if inst.NumId == numId {
+			inst.Start = start
+			return nil
+		}
+	}
+	return ErrUnknownList
+}
+
 // GetNumberingXML returns the XML representation of the numbering part
 func (nm *NumberingManager) GetNumberingXML() ([]byte, error) {
 	nm.mu.Lock()
@@ -98,7 +192,11 @@ func (ni NumInstance) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 		return err
 	}
 
-	// Ensure numbering restarts at 1 for level 0 in this instance
+	if ni.Start <= 0 {
+		return e.EncodeToken(xml.EndElement{Name: start.Name})
+	}
+
+	// Restart numbering of level 0 for this instance
 	lvlOverride := xml.StartElement{
 		Name: xml.Name{Local: "w:lvlOverride"},
 		Attr: []xml.Attr{{Name: xml.Name{Local: "w:ilvl"}, Value: "0"}},
@@ -108,7 +206,7 @@ func (ni NumInstance) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	}
 	startOverride := xml.StartElement{
 		Name: xml.Name{Local: "w:startOverride"},
-		Attr: []xml.Attr{{Name: xml.Name{Local: "w:val"}, Value: "1"}},
+		Attr: []xml.Attr{{Name: xml.Name{Local: "w:val"}, Value: strconv.Itoa(ni.Start)}},
 	}
 	if err := e.EncodeElement("", startOverride); err != nil {
 		return err
@@ -147,26 +245,26 @@ func (nm *NumberingManager) applyToFileMap() error {
 		}
 	}
 
-	// Build the XML snippet only for missing instances to keep operation idempotent
+	// Build the XML snippet only for missing instances to keep operation idempotent;
+	// instances written by an earlier call are updated in place below
 	var sb strings.Builder
 	for _, inst := range nm.numbering.Instances {
 		if _, found := existingIDs[inst.NumId]; found {
 			continue
 		}
-		// <w:num w:numId="X"><w:abstractNumId w:val="Y"/><w:lvlOverride w:ilvl="0"><w:startOverride w:val="1"/></w:lvlOverride></w:num>
-		sb.WriteString("<w:num w:numId=\"")
-		sb.WriteString(fmt.Sprintf("%d", inst.NumId))
-		sb.WriteString("\"><w:abstractNumId w:val=\"")
-		sb.WriteString(fmt.Sprintf("%d", inst.AbstractNumId))
-		sb.WriteString("\"/>")
-		sb.WriteString("<w:lvlOverride w:ilvl=\"0\"><w:startOverride w:val=\"1\"/></w:lvlOverride>")
-		sb.WriteString("</w:num>")
+		sb.WriteString(numInstanceXML(inst))
 	}
 	instancesXML := sb.String()
 
 	if existing, ok := nm.rootDoc.FileMap.Load(numberingPath); ok {
 		// Insert before closing tag of w:numbering
 		content := string(existing.([]byte))
+		for _, inst := range nm.numbering.Instances {
+			if _, found := existingIDs[inst.NumId]; found {
+				numRe := regexp.MustCompile(`<w:num w:numId="` + strconv.Itoa(inst.NumId) + `">.*?</w:num>`)
+				content = numRe.ReplaceAllLiteralString(content, numInstanceXML(inst))
+			}
+		}
 		// Ensure our multilevel abstract definitions exist
 		content = nm.ensureMultilevelAbstracts(content)
 		if instancesXML == "" {
@@ -185,13 +283,32 @@ func (nm *NumberingManager) applyToFileMap() error {
 	}
 
 	// If numbering.xml doesn't exist (unlikely with the default template), create a minimal one
-	minimal := `<?xml version="1.0" encoding="UTF-8"?>` +
+	minimal := nm.ensureMultilevelAbstracts(`<?xml version="1.0" encoding="UTF-8"?>` +
 		`<w:numbering xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main">` +
-		nm.multilevelAbstractsXML() + instancesXML + `</w:numbering>`
+		instancesXML + `</w:numbering>`)
 	nm.rootDoc.FileMap.Store(numberingPath, []byte(minimal))
+	nm.rootDoc.registerNumberingPart()
 	return nil
 }
 
+// numInstanceXML returns the w:num element of the instance, e.g.
+// <w:num w:numId="X"><w:abstractNumId w:val="Y"/><w:lvlOverride w:ilvl="0"><w:startOverride w:val="1"/></w:lvlOverride></w:num>
+func numInstanceXML(inst *NumInstance) string {
+	var sb strings.Builder
+	sb.WriteString("<w:num w:numId=\"")
+	sb.WriteString(strconv.Itoa(inst.NumId))
+	sb.WriteString("\"><w:abstractNumId w:val=\"")
+	sb.WriteString(strconv.Itoa(inst.AbstractNumId))
+	sb.WriteString("\"/>")
+	if inst.Start > 0 {
+		sb.WriteString("<w:lvlOverride w:ilvl=\"0\"><w:startOverride w:val=\"")
+		sb.WriteString(strconv.Itoa(inst.Start))
+		sb.WriteString("\"/></w:lvlOverride>")
+	}
+	sb.WriteString("</w:num>")
+	return sb.String()
+}
+
 // normalizeAbstract maps simple ids used by API to internal multilevel abstract ids.
 // 1 -> decimal multilevel, 2 -> bullet multilevel; others remain unchanged.
 func (nm *NumberingManager) normalizeAbstract(abstractNumId int) int {
@@ -227,13 +344,41 @@ func (nm *NumberingManager) ensureNextNumIdFromTemplate() {
 	}
 }
 
+// ensureNextAbstractIdFromTemplate raises nextAbstractId above the abstract numbering IDs
+// used by the template and the built-in definitions
+func (nm *NumberingManager) ensureNextAbstractIdFromTemplate() {
+	if nm.nextAbstractId == 0 {
+		nm.nextAbstractId = 301
+	}
+
+	existing, ok := nm.rootDoc.FileMap.Load("word/numbering.xml")
+	if !ok {
+		return
+	}
+	re := regexp.MustCompile(`<w:abstractNum w:abstractNumId="(\d+)"`)
+	for _, m := range re.FindAllStringSubmatch(string(existing.([]byte)), -1) {
+		if v, err := strconv.Atoi(m[1]); err == nil && v >= nm.nextAbstractId {
+			nm.nextAbstractId = v + 1
+		}
+	}
+}
+
 // ensureMultilevelAbstracts injects multilevel abstract definitions used by normalizeAbstract
+// and the definitions of the custom lists
 func (nm *NumberingManager) ensureMultilevelAbstracts(content string) string {
-	if strings.Contains(content, `w:abstractNumId="201"`) && strings.Contains(content, `w:abstractNumId="202"`) {
+	var insert string
+	if !strings.Contains(content, `w:abstractNumId="201"`) || !strings.Contains(content, `w:abstractNumId="202"`) {
+		insert = nm.multilevelAbstractsXML()
+	}
+	for _, abstractId := range nm.sortedCustomAbstracts() {
+		if !strings.Contains(content, `<w:abstractNum w:abstractNumId="`+strconv.Itoa(abstractId)+`"`) {
+			insert += abstractNumXML(abstractId, nm.customAbstracts[abstractId])
+		}
+	}
+	if insert == "" {
 		return content
 	}
 
-	insert := nm.multilevelAbstractsXML()
 	// Place right after opening <w:numbering ...>
 	start := strings.Index(content, "<w:numbering")
 	if start >= 0 {
@@ -249,21 +394,137 @@ func (nm *NumberingManager) ensureMultilevelAbstracts(content string) string {
 
 func (nm *NumberingManager) multilevelAbstractsXML() string {
 	// Build 9 levels for decimal and bullet schemes
-	var dec, bul strings.Builder
-	dec.WriteString(`<w:abstractNum w:abstractNumId="201"><w:multiLevelType w:val="hybridMultilevel"/>`)
-	bul.WriteString(`<w:abstractNum w:abstractNumId="202"><w:multiLevelType w:val="hybridMultilevel"/>`)
-	for lvl := 0; lvl < 9; lvl++ {
-		pos := 360 * (lvl + 1)
-		// Ordered: cycle formats per level: decimal, lowerLetter, lowerRoman
-		fmtVal := orderedNumFmtForLevel(lvl)
-		dec.WriteString(`<w:lvl w:ilvl="` + strconv.Itoa(lvl) + `"><w:start w:val="1"/><w:numFmt w:val="` + fmtVal + `"/><w:lvlText w:val="%` + strconv.Itoa(lvl+1) + `."/><w:lvlJc w:val="left"/><w:pPr><w:tabs><w:tab w:val="num" w:pos="` + strconv.Itoa(pos) + `"/></w:tabs><w:ind w:left="` + strconv.Itoa(pos) + `" w:hanging="360"/></w:pPr></w:lvl>`)
+	dec := make([]LevelFormat, maxListLevel+1)
+	bul := make([]LevelFormat, maxListLevel+1)
+	for lvl := range dec {
+		dec[lvl] = defaultLevelFormat(ListNumbered, lvl)
+		bul[lvl] = defaultLevelFormat(ListBullet, lvl)
+	}
+	return abstractNumXML(201, dec) + abstractNumXML(202, bul)
+}
+
+// sortedCustomAbstracts returns the IDs of the custom abstract numberings in increasing order.
+func (nm *NumberingManager) sortedCustomAbstracts() []int {
+	ids := make([]int, 0, len(nm.customAbstracts))
+	for id := range nm.customAbstracts {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+// defaultLevelFormat returns the format of a level of the built-in lists.
+func defaultLevelFormat(listType ListType, level int) LevelFormat {
+	if listType == ListBullet {
 		// Bullets: cycle glyphs (•, o, square) and fonts (Symbol, Symbol, Wingdings)
-		glyph, font := bulletGlyphForLevel(lvl)
-		bul.WriteString(`<w:lvl w:ilvl="` + strconv.Itoa(lvl) + `"><w:start w:val="1"/><w:numFmt w:val="bullet"/><w:lvlText w:val="` + glyph + `"/><w:lvlJc w:val="left"/><w:pPr><w:tabs><w:tab w:val="num" w:pos="` + strconv.Itoa(pos) + `"/></w:tabs><w:ind w:left="` + strconv.Itoa(pos) + `" w:hanging="360"/></w:pPr><w:rPr><w:rFonts w:ascii="` + font + `" w:hAnsi="` + font + `" w:hint="default"/></w:rPr></w:lvl>`)
+		glyph, font := bulletGlyphForLevel(level)
+		return LevelFormat{Format: stypes.NumFmtBullet, Text: glyph, Font: font}
+	}
+
+	// Ordered: cycle formats per level: decimal, lowerLetter, lowerRoman
+	return LevelFormat{
+		Format: stypes.NumFmt(orderedNumFmtForLevel(level)),
+		Text:   "%" + strconv.Itoa(level+1) + ".",
 	}
-	dec.WriteString(`</w:abstractNum>`)
-	bul.WriteString(`</w:abstractNum>`)
-	return dec.String() + bul.String()
+}
+
+// abstractNumXML returns the w:abstractNum element with the given levels.
+func abstractNumXML(abstractId int, levels []LevelFormat) string {
+	var sb strings.Builder
+	sb.WriteString(`<w:abstractNum w:abstractNumId="` + strconv.Itoa(abstractId) + `"><w:multiLevelType w:val="hybridMultilevel"/>`)
+	for lvl, f := range levels {
+		sb.WriteString(f.levelXML(lvl))
+	}
+	sb.WriteString(`</w:abstractNum>`)
+	return sb.String()
+}
+
+// LevelFormat describes the numbering or bullet of one level of a list created with
+// RootDoc.NewCustomList.
+type LevelFormat struct {
+	Format  stypes.NumFmt // Number format, e.g. stypes.NumFmtDecimal, or stypes.NumFmtBullet for bullets
+	Text    string        // Level text (w:lvlText): "%1." shows the level 0 number and a dot; the bullet character for bullets
+	Font    string        // Font of the number or bullet, e.g. "Symbol" or "Wingdings"; empty for the paragraph font
+	Start   int           // First number of the level; 0 means 1
+	Indent  int           // Left indentation in twips; 0 for 360 per level
+	Hanging int           // Space between the number and the text in twips (w:hanging); 0 for 360
+
+	// Suffix is the content between the number and the text (w:suff); empty for a tab.
+	// With a space or nothing the number is not followed by a tab, so no tab stop is set for it.
+	Suffix stypes.LevelSuffix
+
+	// Restart sets when the numbering of the level starts over (w:lvlRestart): 0 after any item
+	// of a higher level, as in Word; n > 0 only after the items of level n-1, e.g. 1 for the
+	// items of level 0; a negative value never, so the level keeps counting through the list.
+	Restart int
+}
+
+// levelXML returns the w:lvl element for the given level.
+func (f LevelFormat) levelXML(level int) string {
+	start := f.Start
+	if start <= 0 {
+		start = 1
+	}
+	numFmt := f.Format
+	if numFmt == "" {
+		numFmt = stypes.NumFmtDecimal
+	}
+	pos := f.Indent
+	if pos <= 0 {
+		pos = 360 * (level + 1)
+	}
+	hanging := f.Hanging
+	if hanging <= 0 {
+		hanging = 360
+	}
+
+	var sb strings.Builder
+	sb.WriteString(`<w:lvl w:ilvl="` + strconv.Itoa(level) + `"><w:start w:val="` + strconv.Itoa(start) + `"/>`)
+	sb.WriteString(`<w:numFmt w:val="` + string(numFmt) + `"/>`)
+	switch {
+	case f.Restart < 0:
+		sb.WriteString(`<w:lvlRestart w:val="0"/>`)
+	case f.Restart > 0:
+		sb.WriteString(`<w:lvlRestart w:val="` + strconv.Itoa(f.Restart) + `"/>`)
+	}
+	if f.Suffix != "" && f.Suffix != stypes.LevelSuffixTab {
+		sb.WriteString(`<w:suff w:val="` + string(f.Suffix) + `"/>`)
+	}
+	sb.WriteString(`<w:lvlText w:val="` + escapeAttr(f.Text) + `"/><w:lvlJc w:val="left"/><w:pPr>`)
+	if f.Suffix == "" || f.Suffix == stypes.LevelSuffixTab {
+		// The tab after the number stops at the text position
+		sb.WriteString(`<w:tabs><w:tab w:val="num" w:pos="` + strconv.Itoa(pos) + `"/></w:tabs>`)
+	}
+	sb.WriteString(`<w:ind w:left="` + strconv.Itoa(pos) + `" w:hanging="` + strconv.Itoa(hanging) + `"/></w:pPr>`)
+	if f.Font != "" {
+		font := escapeAttr(f.Font)
+		sb.WriteString(`<w:rPr><w:rFonts w:ascii="` + font + `" w:hAnsi="` + font + `" w:hint="default"/></w:rPr>`)
+	}
+	sb.WriteString(`</w:lvl>`)
+	return sb.String()
+}
+
+// legalLevelFormats returns the levels of a legal style outline: 1, 1.1, 1.1.1 and so on,
+// each level indented by a quarter inch more than its parent.
+func legalLevelFormats() []LevelFormat {
+	levels := make([]LevelFormat, maxListLevel+1)
+	text := ""
+	for lvl := range levels {
+		if lvl > 0 {
+			text += "."
+		}
+		text += "%" + strconv.Itoa(lvl+1)
+		width := 432 + 144*lvl
+		levels[lvl] = LevelFormat{Format: stypes.NumFmtDecimal, Text: text, Indent: width, Hanging: width}
+	}
+	return levels
+}
+
+// escapeAttr escapes the value for use in an XML attribute.
+func escapeAttr(value string) string {
+	var sb strings.Builder
+	_ = xml.EscapeText(&sb, []byte(value))
+	return sb.String()
 }
 
 // orderedNumFmtForLevel returns the WordprocessingML numFmt for a given level,
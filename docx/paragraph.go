@@ -21,6 +21,8 @@ import (
 type Paragraph struct {
 	root *RootDoc         // root is a reference to the root document.
 	ct   ctypes.Paragraph // ct holds the underlying Paragraph Complex Type.
+
+	hf *headerFooter // hf is the header or footer holding the paragraph, nil for the main document.
 }
 
 func (p *Paragraph) unmarshalXML(d *xml.Decoder, start xml.StartElement) error {
@@ -67,6 +69,29 @@ func (p *Paragraph) GetCT() *ctypes.Paragraph {
 	return &p.ct
 }
 
+// Clone returns a deep copy of the paragraph, with its properties and runs, that is not
+// part of the document; add it with Body.AppendParagraph. Changing the copy does not
+// affect the original.
+//
+// Markers with document-wide IDs, such as bookmarks, comment ranges and note references,
+// are copied with the same IDs. The Word 2010 paragraph identifier (w14:paraId) is not
+// copied, as it must be unique within the document; Word assigns a new one.
+//
+// Example:
+//
+//	template := document.AddParagraph("Signed: ")
+//	template.Justification(stypes.JustificationRight)
+//	for _, name := range names {
+//		p := template.Clone()
+//		p.AddText(name).Bold(true)
+//		document.Document.Body.AppendParagraph(p)
+//	}
+func (p *Paragraph) Clone() *Paragraph {
+	ct := internal.DeepCopy(p.ct)
+	ct.ParaID = nil
+	return &Paragraph{root: p.root, ct: ct}
+}
+
 // AddParagraph adds a new paragraph with the specified text to the document.
 // It returns the created Paragraph instance.
 //
@@ -129,16 +154,24 @@ func (p *Paragraph) LineSpacing(line int, lineRule stypes.LineSpacingRule) *Para
 
 // Style sets the paragraph style.
 //
+// If value is the ID of a built-in style such as "Heading1", "Title" or "Quote" and the
+// styles part of the document lacks it, a minimal definition of the style is added.
+// Other styles can be defined with RootDoc.AddParagraphStyle.
+//
 // Parameters:
-//   - value: A string representing the style value. It can be any valid style defined in the WordprocessingML specification.
+//   - value: A string representing the style ID.
 //
 // Example:
 //
 //	p1 := document.AddParagraph("Example para")
-//	paragraph.Style("List Number")
+//	p1.Style("ListNumber")
 func (p *Paragraph) Style(value string) {
 	p.ensureProp()
 	p.ct.Property.Style = ctypes.NewParagraphStyle(value)
+
+	if p.root != nil {
+		p.root.ensureStyle(value, stypes.StyleTypeParagraph)
+	}
 }
 
 // Justification sets the paragraph justification type.
@@ -209,7 +242,74 @@ func (p *Paragraph) Indent(indentProp *ctypes.Indent) {
 	p.ct.Property.Indent = indentProp
 }
 
+// Indentation sets the left and right indentation of the paragraph (w:ind), in twips.
+// The first line and hanging indentation are kept.
+//
+// Returns:
+//   - *Paragraph: The paragraph instance for method chaining.
+//
+// Example:
+//
+//	quote := document.AddParagraph("To be, or not to be")
+//	quote.Indentation(720, 720)
+func (p *Paragraph) Indentation(left, right int) *Paragraph {
+	ind := p.ensureIndent()
+	ind.Left = &left
+	ind.Right = &right
+	ind.LeftChars, ind.RightChars = nil, nil
+	return p
+}
+
+// FirstLineIndent indents the first line of the paragraph by the given amount in twips,
+// relative to the left indentation. It replaces any hanging indentation, since the two
+// cannot be combined; zero or less removes the first line indentation.
+//
+// Returns:
+//   - *Paragraph: The paragraph instance for method chaining.
+func (p *Paragraph) FirstLineIndent(value int) *Paragraph {
+	ind := p.ensureIndent()
+	ind.Hanging, ind.HangingChars = nil, nil
+	ind.FirstLine, ind.FirstLineChars = nil, nil
+	if value > 0 {
+		ind.FirstLine = internal.ToPtr(uint64(value))
+	}
+	return p
+}
+
+// HangingIndent moves the first line of the paragraph left by the given amount in twips,
+// relative to the left indentation, as used by numbered outlines and bibliographies.
+// It replaces any first line indentation, since the two cannot be combined; zero or less
+// removes the hanging indentation.
+//
+// Returns:
+//   - *Paragraph: The paragraph instance for method chaining.
+//
+// Example:
+//
+//	p := document.AddParagraph("1.\tA numbered item that wraps onto the next line")
+//	p.Indentation(720, 0).HangingIndent(360)
+func (p *Paragraph) HangingIndent(value int) *Paragraph {
+	ind := p.ensureIndent()
+	ind.FirstLine, ind.FirstLineChars = nil, nil
+	ind.Hanging, ind.HangingChars = nil, nil
+	if value > 0 {
+		ind.Hanging = internal.ToPtr(uint64(value))
+	}
+	return p
+}
+
+// ensureIndent returns the indentation of the paragraph, creating it if needed.
+func (p *Paragraph) ensureIndent() *ctypes.Indent {
+	p.ensureProp()
+	if p.ct.Property.Indent == nil {
+		p.ct.Property.Indent = &ctypes.Indent{}
+	}
+	return p.ct.Property.Indent
+}
+
 // Appends a new text to the Paragraph.
+// The text is processed according to the document RunOptions (see RootDoc.SetRunOptions).
+//
 // Example:
 //
 //	paragraph := AddParagraph()
@@ -221,7 +321,7 @@ func (p *Paragraph) Indent(indentProp *ctypes.Indent) {
 // Returns:
 //   - *Run: The newly created Run instance added to the Paragraph.
 func (p *Paragraph) AddText(text string) *Run {
-	t := ctypes.TextFromString(text)
+	t := ctypes.TextFromString(p.applyRunOptions(text))
 
 	runChildren := []ctypes.RunChild{}
 	runChildren = append(runChildren, ctypes.RunChild{
@@ -231,7 +331,7 @@ func (p *Paragraph) AddText(text string) *Run {
 		Children: runChildren,
 	}
 
-	p.ct.Children = append(p.ct.Children, ctypes.ParagraphChild{Run: run})
+	p.appendRun(run)
 
 	return newRun(p.root, run)
 }
@@ -256,11 +356,28 @@ func (p *Paragraph) AddRun() *Run {
 
 	run := &ctypes.Run{}
 
-	p.ct.Children = append(p.ct.Children, ctypes.ParagraphChild{Run: run})
+	p.appendRun(run)
 
 	return newRun(p.root, run)
 }
 
+// AppendRun adds the run at the end of the paragraph, e.g. a copy made with Run.Clone.
+// A run must not be added twice.
+func (p *Paragraph) AppendRun(r *Run) *Paragraph {
+	p.appendRun(r.ct)
+	return p
+}
+
+// appendRun adds the run at the end of the paragraph, as a tracked insertion while changes
+// are tracked, see RootDoc.BeginTrackedChanges.
+func (p *Paragraph) appendRun(run *ctypes.Run) {
+	if p.root != nil && p.root.tracking != nil {
+		p.ct.Children = append(p.ct.Children, ctypes.ParagraphChild{Ins: p.root.trackedChange(run)})
+		return
+	}
+	p.ct.Children = append(p.ct.Children, ctypes.ParagraphChild{Run: run})
+}
+
 // GetStyle retrieves the style information applied to the Paragraph.
 //
 // Returns:
@@ -280,7 +397,69 @@ func (p *Paragraph) GetStyle() (*ctypes.Style, error) {
 }
 
 func (p *Paragraph) AddLink(text string, link string) *Hyperlink {
-	rId := p.root.Document.addLinkRelation(link)
+	return newHyperlink(p.root, p.addHyperlink(text, link))
+}
+
+// AddHyperlink adds a clickable link to an external URL to the paragraph.
+//
+// The link text is placed in a run styled with the Hyperlink character style,
+// wrapped in a w:hyperlink element that references the URL through an external relationship.
+//
+// Parameters:
+//   - text: The text displayed for the link.
+//   - url: The target URL of the link.
+//
+// Returns:
+//   - *Run: The run holding the link text, for further formatting.
+//
+// Example:
+//
+//	p := document.AddParagraph("Visit ")
+//	p.AddHyperlink("our website", "https://example.com").Bold(true)
+func (p *Paragraph) AddHyperlink(text string, url string) *Run {
+	link := p.addHyperlink(text, url)
+	return newRun(p.root, link.Run)
+}
+
+// LinkRun turns a run of the paragraph into a clickable link to an external URL, keeping its
+// text and formatting. The run gets the Hyperlink character style unless it has a style.
+//
+// Returns:
+//   - *Run: The run, or nil if it is not a direct child of the paragraph.
+//
+// Example:
+//
+//	p := document.AddParagraph("See ")
+//	site := p.AddText("example.com").Bold(true)
+//	p.LinkRun(site, "https://example.com")
+func (p *Paragraph) LinkRun(run *Run, url string) *Run {
+	for i, child := range p.ct.Children {
+		if child.Run == nil || child.Run != run.ct {
+			continue
+		}
+
+		if run.ct.Property == nil || run.ct.Property.Style == nil {
+			run.Style(constants.HyperLinkStyle)
+		}
+		p.ct.Children[i] = ctypes.ParagraphChild{Link: &ctypes.Hyperlink{ID: p.linkRelation(url), Run: run.ct}}
+		return run
+	}
+	return nil
+}
+
+// linkRelation adds an external relationship to the URL to the part of the paragraph and
+// returns its ID.
+func (p *Paragraph) linkRelation(link string) string {
+	if p.hf != nil {
+		return p.hf.addRelation(Relationship{Type: constants.SourceRelationshipHyperLink, Target: link, TargetMode: "External"})
+	}
+	return p.root.Document.addLinkRelation(link)
+}
+
+// addHyperlink appends a w:hyperlink element with a single styled run to the paragraph.
+func (p *Paragraph) addHyperlink(text string, link string) *ctypes.Hyperlink {
+	rId := p.linkRelation(link)
+	p.root.ensureStyle(constants.HyperLinkStyle, stypes.StyleTypeCharacter)
 
 	runChildren := []ctypes.RunChild{}
 	runChildren = append(runChildren, ctypes.RunChild{
@@ -302,7 +481,7 @@ func (p *Paragraph) AddLink(text string, link string) *Hyperlink {
 
 	p.ct.Children = append(p.ct.Children, ctypes.ParagraphChild{Link: hyperLink})
 
-	return newHyperlink(p.root, hyperLink)
+	return hyperLink
 }
 
 // AddDrawing adds a new drawing (image) to the Paragraph.
@@ -310,14 +489,12 @@ func (p *Paragraph) AddLink(text string, link string) *Hyperlink {
 // Parameters:
 //   - rID: The relationship ID of the image in the document.
 //   - imgCount: The count of images in the document.
-//   - width: The width of the image in inches.
-//   - height: The height of the image in inches.
+//   - eWidth: The width of the image in EMUs.
+//   - eHeight: The height of the image in EMUs.
 //
 // Returns:
 //   - *dml.Inline: The created Inline instance representing the added drawing.
-func (p *Paragraph) addDrawing(rID string, imgCount uint, width units.Inch, height units.Inch) *dml.Inline {
-	eWidth := width.ToEmu()
-	eHeight := height.ToEmu()
+func (p *Paragraph) addDrawing(rID string, imgCount uint, eWidth units.Emu, eHeight units.Emu) *dml.Inline {
 
 	inline := dml.NewInline(
 		*dmlct.NewPostvSz2D(eWidth, eHeight),
@@ -343,7 +520,8 @@ func (p *Paragraph) addDrawing(rID string, imgCount uint, width units.Inch, heig
 
 	p.ct.Children = append(p.ct.Children, ctypes.ParagraphChild{Run: run})
 
-	return &inline
+	// The element of the drawing, so that changes to it are written
+	return &drawing.Inline[0]
 }
 
 func (p *Paragraph) AddPicture(path string, width units.Inch, height units.Inch) (*PicMeta, error) {
@@ -353,7 +531,18 @@ func (p *Paragraph) AddPicture(path string, width units.Inch, height units.Inch)
 		return nil, err
 	}
 
-	imgExt := filepath.Ext(path)
+	return p.addPictureBytes(imgBytes, filepath.Ext(path), width.ToEmu(), height.ToEmu())
+}
+
+// addPictureBytes stores the image bytes as a new media part and adds an inline drawing
+// referencing it to the paragraph.
+//
+// Parameters:
+//   - imgBytes: The encoded image.
+//   - imgExt: The file extension of the image, including the leading dot (e.g. ".png").
+//   - width: The width of the image in EMUs.
+//   - height: The height of the image in EMUs.
+func (p *Paragraph) addPictureBytes(imgBytes []byte, imgExt string, width units.Emu, height units.Emu) (*PicMeta, error) {
 	p.root.ImageCount += 1
 	fileName := fmt.Sprintf("image%d%s", p.root.ImageCount, imgExt)
 	fileIdxPath := fmt.Sprintf("%s%s", constants.MediaPath, fileName)
@@ -379,7 +568,12 @@ func (p *Paragraph) AddPicture(path string, width units.Inch, height units.Inch)
 
 	relName := fmt.Sprintf("media/%s", fileName)
 
-	rID := p.root.Document.addRelation(constants.SourceRelationshipImage, relName)
+	var rID string
+	if p.hf != nil {
+		rID = p.hf.addRelation(Relationship{Type: constants.SourceRelationshipImage, Target: relName})
+	} else {
+		rID = p.root.Document.addRelation(constants.SourceRelationshipImage, relName)
+	}
 
 	inline := p.addDrawing(rID, p.root.ImageCount, width, height)
 
@@ -391,8 +585,10 @@ func (p *Paragraph) AddPicture(path string, width units.Inch, height units.Inch)
 
 // Border sets the paragraph border properties.
 //
-// This function assigns border definitions to the paragraph,
-// allowing customization of borders on all sides.
+// This function merges border definitions into the paragraph, allowing customization
+// of borders on all sides. Edges set in border replace the corresponding edges of the
+// paragraph, while edges left nil keep their current value, so Border can be combined
+// with BottomBorder and TopBorder in any order. Passing nil removes all borders.
 //
 // Parameters:
 //   - border: A pointer to a ctypes.ParaBorder instance representing the border properties.
@@ -413,13 +609,41 @@ func (p *Paragraph) AddPicture(path string, width units.Inch, height units.Inch)
 //	p.Border(border)
 func (p *Paragraph) Border(border *ctypes.ParaBorder) *Paragraph {
 	p.ensureProp()
-	p.ct.Property.Border = border
+
+	if border == nil {
+		p.ct.Property.Border = nil
+		return p
+	}
+
+	merged := &ctypes.ParaBorder{}
+	if p.ct.Property.Border != nil {
+		*merged = *p.ct.Property.Border
+	}
+
+	for _, edge := range []struct {
+		dst **ctypes.Border
+		src *ctypes.Border
+	}{
+		{&merged.Top, border.Top},
+		{&merged.Left, border.Left},
+		{&merged.Right, border.Right},
+		{&merged.Bottom, border.Bottom},
+		{&merged.Between, border.Between},
+		{&merged.Bar, border.Bar},
+	} {
+		if edge.src != nil {
+			*edge.dst = edge.src
+		}
+	}
+
+	p.ct.Property.Border = merged
 	return p
 }
 
 // BottomBorder sets the bottom border of the paragraph.
 //
 // This is a convenience method for creating horizontal lines or underlines for paragraphs.
+// Only the bottom edge is replaced; other edges set with Border or TopBorder are kept.
 //
 // Parameters:
 //   - style: The border style (e.g., stypes.BorderStyleSingle, stypes.BorderStyleDouble).
@@ -434,18 +658,203 @@ func (p *Paragraph) Border(border *ctypes.ParaBorder) *Paragraph {
 //	p := document.AddEmptyParagraph()
 //	p.BottomBorder(stypes.BorderStyleSingle, 6, "auto")
 func (p *Paragraph) BottomBorder(style stypes.BorderStyle, size int, color string) *Paragraph {
-	p.ensureProp()
+	return p.Border(&ctypes.ParaBorder{Bottom: newEdgeBorder(style, size, color)})
+}
 
-	if p.ct.Property.Border == nil {
-		p.ct.Property.Border = &ctypes.ParaBorder{}
-	}
+// TopBorder sets the top border of the paragraph.
+//
+// This is a convenience method for creating horizontal lines above paragraphs.
+// Only the top edge is replaced; other edges set with Border or BottomBorder are kept.
+//
+// Parameters:
+//   - style: The border style (e.g., stypes.BorderStyleSingle, stypes.BorderStyleDouble).
+//   - size: The border width in eighths of a point (e.g., 6 = 0.75pt, 12 = 1.5pt).
+//   - color: The border color in hex format (e.g., "FF0000" for red) or "auto" for automatic color.
+//
+// Returns:
+//   - *Paragraph: The paragraph instance for method chaining.
+//
+// Example:
+//
+//	p := document.AddEmptyParagraph()
+//	p.TopBorder(stypes.BorderStyleSingle, 6, "auto")
+func (p *Paragraph) TopBorder(style stypes.BorderStyle, size int, color string) *Paragraph {
+	return p.Border(&ctypes.ParaBorder{Top: newEdgeBorder(style, size, color)})
+}
 
-	p.ct.Property.Border.Bottom = &ctypes.Border{
+// newEdgeBorder returns a border edge with the spacing used by the edge setters.
+func newEdgeBorder(style stypes.BorderStyle, size int, color string) *ctypes.Border {
+	return &ctypes.Border{
 		Val:   style,
 		Size:  &size,
 		Space: internal.ToPtr("1"),
 		Color: &color,
 	}
+}
+
+// AddTabStop adds a custom tab stop to the paragraph (w:pPr/w:tabs).
+//
+// Tab stops are kept in the order they are added.
+//
+// Parameters:
+//   - position: The position of the tab stop from the start of the paragraph, in twips.
+//   - align: The alignment of the text at the tab stop, e.g. stypes.CustTabStopRight.
+//   - leader: The character filling the space before the tab stop, e.g. stypes.CustLeadCharDot,
+//     or stypes.CustLeadCharInvalid for none.
+//
+// Returns:
+//   - *Paragraph: The paragraph instance for method chaining.
+//
+// Example:
+//
+//	p := document.AddEmptyParagraph()
+//	p.AddTabStop(9360, stypes.CustTabStopRight, stypes.CustLeadCharDot)
+//	p.AddText("Name").AddTab()
+//	p.AddText("Date")
+func (p *Paragraph) AddTabStop(position int, align stypes.CustTabStop, leader stypes.CustLeadChar) *Paragraph {
+	p.ensureProp()
+
+	tab := ctypes.Tab{Val: align, Position: position}
+	if leader != stypes.CustLeadCharInvalid {
+		tab.LeaderChar = &leader
+	}
+	p.ct.Property.Tabs.Tab = append(p.ct.Property.Tabs.Tab, tab)
+
+	return p
+}
+
+// AddRawRunXML appends a fragment of WordprocessingML, such as one or more w:r elements,
+// to the paragraph. The fragment is written as is, as an escape hatch for content the typed
+// API does not cover; it is not visible to the other methods, e.g. Text.
+//
+// See Body.AddRawXML for the namespace prefixes allowed.
+//
+// Returns:
+//   - error: ctypes.ErrInvalidRawXML if the fragment is not well-formed or uses an undeclared prefix.
+//
+// Example:
+//
+//	err := p.AddRawRunXML(`<w:r><w:sym w:font="Wingdings" w:char="F04A"/></w:r>`)
+func (p *Paragraph) AddRawRunXML(fragment string) error {
+	raw, err := ctypes.NewRawXML(fragment, documentPrefixes())
+	if err != nil {
+		return err
+	}
+
+	p.ct.Children = append(p.ct.Children, ctypes.ParagraphChild{Raw: raw})
+	return nil
+}
+
+// Shading sets the background shading of the paragraph (w:shd), which covers the full width
+// between the paragraph indents, unlike the highlight of a run.
+//
+// Parameters:
+//   - shdType: The shading pattern; stypes.ShdClear shows the fill color alone.
+//   - color: The color of the pattern, as a hex value or "auto".
+//   - fill: The background color, as a hex value or "auto".
+//
+// Returns:
+//   - *Paragraph: The paragraph instance for method chaining.
+//
+// Example:
+//
+//	callout := document.AddParagraph("Note: the service restarts at midnight.")
+//	callout.Shading(stypes.ShdClear, "auto", "F2F2F2")
+func (p *Paragraph) Shading(shdType stypes.Shading, color, fill string) *Paragraph {
+	p.ensureProp()
+	p.ct.Property.Shading = ctypes.NewShading().SetShadingType(shdType).SetColor(color).SetFill(fill)
+	return p
+}
+
+// KeepNext sets whether the paragraph is kept on the same page as the next one (w:keepNext),
+// e.g. to avoid a heading at the bottom of a page.
+//
+// Returns:
+//   - *Paragraph: The paragraph instance for method chaining.
+func (p *Paragraph) KeepNext(value bool) *Paragraph {
+	p.ensureProp()
+	p.ct.Property.KeepNext = ctypes.OnOffFromBool(value)
+	return p
+}
+
+// KeepLines sets whether all the lines of the paragraph are kept on the same page (w:keepLines).
+// Set on the paragraphs of a table row, it also prevents the row from breaking across pages.
+//
+// Returns:
+//   - *Paragraph: The paragraph instance for method chaining.
+func (p *Paragraph) KeepLines(value bool) *Paragraph {
+	p.ensureProp()
+	p.ct.Property.KeepLines = ctypes.OnOffFromBool(value)
+	return p
+}
+
+// WidowControl sets whether the first and last lines of the paragraph are prevented from
+// appearing alone at the bottom or top of a page (w:widowControl).
+//
+// Returns:
+//   - *Paragraph: The paragraph instance for method chaining.
+func (p *Paragraph) WidowControl(value bool) *Paragraph {
+	p.ensureProp()
+	p.ct.Property.WindowControl = ctypes.OnOffFromBool(value)
+	return p
+}
+
+// PageBreakBefore sets whether the paragraph starts on a new page (w:pageBreakBefore).
+//
+// Returns:
+//   - *Paragraph: The paragraph instance for method chaining.
+func (p *Paragraph) PageBreakBefore(value bool) *Paragraph {
+	p.ensureProp()
+	p.ct.Property.PageBreakBefore = ctypes.OnOffFromBool(value)
+	return p
+}
+
+// OverflowPunctuation sets whether punctuation may extend past the end of a line
+// instead of being wrapped to the next one (w:overflowPunct).
+//
+// Returns:
+//   - *Paragraph: The paragraph instance for method chaining.
+func (p *Paragraph) OverflowPunctuation(value bool) *Paragraph {
+	p.ensureProp()
+	p.ct.Property.OverflowPunct = ctypes.OnOffFromBool(value)
+	return p
+}
 
+// Kinsoku sets whether East Asian typography rules for the first and last characters
+// of a line are applied when breaking lines (w:kinsoku).
+//
+// Returns:
+//   - *Paragraph: The paragraph instance for method chaining.
+func (p *Paragraph) Kinsoku(value bool) *Paragraph {
+	p.ensureProp()
+	p.ct.Property.Kinsoku = ctypes.OnOffFromBool(value)
+	return p
+}
+
+// WordWrap sets whether Latin text is wrapped at word boundaries (w:wordWrap).
+// Disabling it allows long tokens such as URLs to break at any character.
+//
+// Returns:
+//   - *Paragraph: The paragraph instance for method chaining.
+func (p *Paragraph) WordWrap(value bool) *Paragraph {
+	p.ensureProp()
+	p.ct.Property.WordWrap = ctypes.OnOffFromBool(value)
+	return p
+}
+
+// BiDi sets whether the paragraph is a right to left paragraph (w:bidi), e.g. for Arabic or
+// Hebrew text: its lines start on the right, and its indentation and justification are
+// mirrored. Mark the runs of right to left text with Run.RTL.
+//
+// Returns:
+//   - *Paragraph: The paragraph instance for method chaining.
+//
+// Example:
+//
+//	p := document.AddParagraph("").BiDi(true)
+//	p.AddText("مرحبا بالعالم").RTL(true)
+func (p *Paragraph) BiDi(value bool) *Paragraph {
+	p.ensureProp()
+	p.ct.Property.Bidi = ctypes.OnOffFromBool(value)
 	return p
 }
@@ -1,8 +1,11 @@
 package docx
 
 import (
+	"encoding/xml"
+	"strings"
 	"testing"
 
+	"github.com/MamaShip/godocx/internal"
 	"github.com/MamaShip/godocx/wml/ctypes"
 	"github.com/MamaShip/godocx/wml/stypes"
 	"github.com/stretchr/testify/assert"
@@ -171,3 +174,171 @@ func TestParagraph_AddRun(t *testing.T) {
 
 	assert.Equal(t, 0, len(p.ct.Children[0].Run.Children), "Expected the new Run to have no initial Children")
 }
+
+func TestParagraph_LineBreakControls(t *testing.T) {
+	p := &Paragraph{}
+	p.OverflowPunctuation(false).Kinsoku(true).WordWrap(false)
+
+	out, err := xml.Marshal(p.ct)
+	if err != nil {
+		t.Fatalf("marshal paragraph: %v", err)
+	}
+	xmlStr := string(out)
+
+	assert.True(t, strings.Contains(xmlStr, `<w:kinsoku w:val="true"></w:kinsoku><w:wordWrap w:val="false"></w:wordWrap><w:overflowPunct w:val="false"></w:overflowPunct>`), xmlStr)
+}
+
+func TestParagraphAddTabStop(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	p := rd.AddEmptyParagraph().
+		AddTabStop(4680, stypes.CustTabStopCenter, stypes.CustLeadCharInvalid).
+		AddTabStop(9360, stypes.CustTabStopRight, stypes.CustLeadCharDot)
+	p.AddText("Name").AddTab()
+	p.AddText("Date")
+
+	out, err := xml.Marshal(rd.Document)
+	assert.NoError(t, err)
+	xmlStr := string(out)
+	assert.Contains(t, xmlStr, `<w:tabs><w:tab w:val="center" w:pos="4680"></w:tab><w:tab w:val="right" w:pos="9360" w:leader="dot"></w:tab></w:tabs>`)
+	assert.Contains(t, xmlStr, `<w:r><w:t>Name</w:t><w:tab></w:tab></w:r>`)
+
+	doc, err := LoadDocXml(rd, "word/document.xml", out)
+	assert.NoError(t, err)
+	tabs := doc.Body.Children[0].Para.ct.Property.Tabs.Tab
+	if assert.Len(t, tabs, 2) {
+		assert.Equal(t, stypes.CustTabStopCenter, tabs[0].Val)
+		assert.Equal(t, 4680, tabs[0].Position)
+		assert.Nil(t, tabs[0].LeaderChar)
+		assert.Equal(t, stypes.CustTabStopRight, tabs[1].Val)
+		assert.Equal(t, 9360, tabs[1].Position)
+		assert.Equal(t, stypes.CustLeadCharDot, *tabs[1].LeaderChar)
+	}
+}
+
+func TestParagraphIndentation(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	p := rd.AddParagraph("Quote").Indentation(720, 360).FirstLineIndent(240)
+	ind := p.ct.Property.Indent
+	assert.Equal(t, 720, *ind.Left)
+	assert.Equal(t, 360, *ind.Right)
+	assert.Equal(t, uint64(240), *ind.FirstLine)
+
+	// Hanging and first line indentation are mutually exclusive
+	p.HangingIndent(360)
+	assert.Nil(t, ind.FirstLine)
+	assert.Equal(t, uint64(360), *ind.Hanging)
+
+	p.FirstLineIndent(120)
+	assert.Nil(t, ind.Hanging)
+	assert.Equal(t, uint64(120), *ind.FirstLine)
+
+	// Changing the sides keeps the first line
+	p.Indentation(1440, 0)
+	assert.Equal(t, uint64(120), *ind.FirstLine)
+
+	out, err := xml.Marshal(p.ct)
+	assert.NoError(t, err)
+	assert.Contains(t, string(out), `<w:ind w:left="1440" w:right="0" w:firstLine="120"></w:ind>`)
+
+	p.FirstLineIndent(0)
+	assert.Nil(t, ind.FirstLine)
+}
+
+func TestParagraphShading(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	p := rd.AddParagraph("Callout").Shading(stypes.ShdClear, "auto", "D9D9D9")
+	p.AddText("!").Shading(stypes.ShdPct10, "000000", "auto")
+
+	out, err := xml.Marshal(p.ct)
+	assert.NoError(t, err)
+	assert.Contains(t, string(out), `<w:pPr><w:shd w:val="clear" w:color="auto" w:fill="D9D9D9"></w:shd></w:pPr>`)
+	assert.Contains(t, string(out), `<w:rPr><w:shd w:val="pct10" w:color="000000" w:fill="auto"></w:shd></w:rPr>`)
+}
+
+func TestAddRawXML(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	p := rd.AddParagraph("Done ")
+	assert.NoError(t, p.AddRawRunXML(`<w:r><w:sym w:font="Wingdings" w:char="F04A"/></w:r>`))
+	assert.NoError(t, rd.Document.Body.AddRawXML(`<w:p><w:pPr><w:pStyle w:val="Title"/></w:pPr></w:p>`))
+
+	assert.ErrorIs(t, p.AddRawRunXML(`<w:r><w:t>Open</w:r>`), ctypes.ErrInvalidRawXML)
+	assert.ErrorIs(t, rd.Document.Body.AddRawXML(`<foo:p></foo:p>`), ctypes.ErrInvalidRawXML)
+	assert.Len(t, rd.Document.Body.Children, 2)
+
+	out, err := xml.Marshal(rd.Document)
+	assert.NoError(t, err)
+	assert.Contains(t, string(out), `<w:t xml:space="preserve">Done </w:t></w:r><w:r><w:sym w:font="Wingdings" w:char="F04A"></w:sym></w:r></w:p>`)
+	assert.Contains(t, string(out), `<w:p><w:pPr><w:pStyle w:val="Title"></w:pStyle></w:pPr></w:p>`)
+
+	// The fragments are kept when the document is read back
+	doc, err := LoadDocXml(rd, "word/document.xml", out)
+	assert.NoError(t, err)
+	assert.Len(t, doc.Body.Children, 2)
+	assert.Equal(t, "Title", doc.Body.Children[1].Para.ct.Property.Style.Val)
+}
+
+func TestParagraph_Clone(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	p := rd.AddParagraph("Signed: ")
+	p.Justification(stypes.JustificationRight)
+	p.Indentation(720, 0)
+	p.Border(&ctypes.ParaBorder{Bottom: &ctypes.Border{Val: stypes.BorderStyleSingle, Color: internal.ToPtr("auto")}})
+	p.AddText("name").Bold(true).Color("FF0000")
+	p.ct.ParaID = internal.ToPtr(stypes.LongHexNum("1A2B3C4D"))
+
+	clone := p.Clone()
+	assert.Nil(t, clone.ct.ParaID, "the copy should get its own paragraph identifier")
+	clone.ct.ParaID = p.ct.ParaID
+	assert.Equal(t, p.ct, clone.ct)
+	clone.ct.ParaID = nil
+
+	// Nested pointers of the copy are independent from the original
+	clone.ct.Property.Justification.Val = stypes.JustificationLeft
+	clone.ct.Property.Indent.Left = internal.ToPtr(1440)
+	clone.ct.Property.Border.Bottom.Color = internal.ToPtr("FF0000")
+	clone.ct.Children[1].Run.Property.Color.Val = "0000FF"
+	clone.ct.Children[1].Run.Children[0].Text.Text = "copy"
+	clone.AddText("!")
+
+	assert.Equal(t, stypes.JustificationRight, p.ct.Property.Justification.Val)
+	assert.Equal(t, 720, *p.ct.Property.Indent.Left)
+	assert.Equal(t, "auto", *p.ct.Property.Border.Bottom.Color)
+	assert.Equal(t, "FF0000", p.ct.Children[1].Run.Property.Color.Val)
+	assert.Equal(t, "Signed: name", p.Text())
+	assert.Equal(t, "Signed: copy!", clone.Text())
+
+	// The copy is only part of the document once appended
+	assert.Len(t, rd.Document.Body.Children, 1)
+	rd.Document.Body.AppendParagraph(clone)
+	assert.Len(t, rd.Document.Body.Children, 2)
+	assert.Equal(t, "Signed: name\nSigned: copy!", rd.PlainText())
+}
+
+func TestParagraph_PaginationControls(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	p := rd.AddParagraph("Results")
+	p.KeepNext(true).KeepLines(true).PageBreakBefore(true).WidowControl(false)
+
+	out, err := xml.Marshal(rd.Document)
+	assert.NoError(t, err)
+	assert.Contains(t, string(out), `<w:pPr><w:keepNext w:val="true"></w:keepNext><w:keepLines w:val="true"></w:keepLines>`+
+		`<w:pageBreakBefore w:val="true"></w:pageBreakBefore><w:widowControl w:val="false"></w:widowControl></w:pPr>`)
+
+	// The toggles are kept when the document is read back
+	doc, err := LoadDocXml(rd, "word/document.xml", out)
+	assert.NoError(t, err)
+	pPr := doc.Body.Children[0].Para.ct.Property
+	assert.Equal(t, stypes.OnOffTrue, *pPr.KeepNext.Val)
+	assert.Equal(t, stypes.OnOffTrue, *pPr.KeepLines.Val)
+	assert.Equal(t, stypes.OnOffTrue, *pPr.PageBreakBefore.Val)
+	assert.Equal(t, stypes.OnOffFalse, *pPr.WindowControl.Val)
+
+	p.KeepNext(false)
+	assert.Equal(t, stypes.OnOffFalse, *p.ct.Property.KeepNext.Val)
+}
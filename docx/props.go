@@ -3,13 +3,24 @@ package docx
 import (
 	"bytes"
 	"encoding/xml"
+	"fmt"
 	"io"
+	"strings"
+	"time"
 
 	"github.com/MamaShip/godocx/common/constants"
 )
 
+// corePropsPath is the part used for the core properties when the package does not reference one.
+const corePropsPath = "docProps/core.xml"
+
+// w3cdtfLayout is the W3CDTF format of the dcterms:created and dcterms:modified timestamps.
+const w3cdtfLayout = "2006-01-02T15:04:05Z"
+
 // CoreProperties represents the core properties of a document, such as title, creator, and version.
 // It is used to store metadata information about the document.
+//
+// Created and Modified are W3CDTF timestamps in UTC, such as "2024-05-01T09:30:00Z".
 type CoreProperties struct {
 	Category       string
 	ContentStatus  string
@@ -49,7 +60,8 @@ type sourceCoreProps struct {
 // finalCoreProps is the final structure used for encoding core properties data to XML.
 type finalCoreProps struct {
 	FilePath       string       `xml:"-"`
-	XMLName        xml.Name     `xml:"http://schemas.openxmlformats.org/package/2006/metadata/core-properties coreProperties"`
+	XMLName        xml.Name     `xml:"cp:coreProperties"`
+	Cp             string       `xml:"xmlns:cp,attr"`
 	Dc             string       `xml:"xmlns:dc,attr"`
 	Dcterms        string       `xml:"xmlns:dcterms,attr"`
 	Dcmitype       string       `xml:"xmlns:dcmitype,attr"`
@@ -57,17 +69,17 @@ type finalCoreProps struct {
 	Title          string       `xml:"dc:title,omitempty"`
 	Subject        string       `xml:"dc:subject,omitempty"`
 	Creator        string       `xml:"dc:creator"`
-	Keywords       string       `xml:"keywords,omitempty"`
+	Keywords       string       `xml:"cp:keywords,omitempty"`
 	Description    string       `xml:"dc:description,omitempty"`
-	LastModifiedBy string       `xml:"lastModifiedBy"`
+	LastModifiedBy string       `xml:"cp:lastModifiedBy"`
 	Language       string       `xml:"dc:language,omitempty"`
 	Identifier     string       `xml:"dc:identifier,omitempty"`
-	Revision       string       `xml:"revision,omitempty"`
+	Revision       string       `xml:"cp:revision,omitempty"`
 	Created        *docxDcTerms `xml:"dcterms:created"`
 	Modified       *docxDcTerms `xml:"dcterms:modified"`
-	ContentStatus  string       `xml:"contentStatus,omitempty"`
-	Category       string       `xml:"category,omitempty"`
-	Version        string       `xml:"version,omitempty"`
+	ContentStatus  string       `xml:"cp:contentStatus,omitempty"`
+	Category       string       `xml:"cp:category,omitempty"`
+	Version        string       `xml:"cp:version,omitempty"`
 }
 
 // ExtendedProperties represents extended properties of a document, such as application details and statistics.
@@ -178,3 +190,110 @@ func LoadDocProps(fileBytes []byte) (cp *CoreProperties, err error) {
 	}
 	return
 }
+
+// CoreProperties returns the core properties of the document, parsed from the core
+// properties part (docProps/core.xml). A document without the part has empty properties.
+//
+// Example:
+//
+//	props, err := document.CoreProperties()
+//	fmt.Println(props.Title, props.Creator)
+func (rd *RootDoc) CoreProperties() (*CoreProperties, error) {
+	content, ok := rd.FileMap.Load(rd.corePropsPath())
+	if !ok {
+		return &CoreProperties{}, nil
+	}
+	return LoadDocProps(content.([]byte))
+}
+
+// SetCoreProperties replaces the core properties of the document (docProps/core.xml),
+// registering the part in the content types and the package relationships if needed.
+//
+// Created and Modified default to the current time when empty; use FormatCoreTime to
+// format other timestamps.
+//
+// Example:
+//
+//	document.SetCoreProperties(docx.CoreProperties{
+//		Title:    "Quarterly report",
+//		Creator:  "Finance team",
+//		Keywords: "report, Q3",
+//	})
+func (rd *RootDoc) SetCoreProperties(props CoreProperties) error {
+	now := FormatCoreTime(time.Now())
+	if props.Created == "" {
+		props.Created = now
+	}
+	if props.Modified == "" {
+		props.Modified = now
+	}
+
+	core := finalCoreProps{
+		Cp:             "http://schemas.openxmlformats.org/package/2006/metadata/core-properties",
+		Dc:             "http://purl.org/dc/elements/1.1/",
+		Dcterms:        "http://purl.org/dc/terms/",
+		Dcmitype:       "http://purl.org/dc/dcmitype/",
+		XSI:            "http://www.w3.org/2001/XMLSchema-instance",
+		Title:          props.Title,
+		Subject:        props.Subject,
+		Creator:        props.Creator,
+		Keywords:       props.Keywords,
+		Description:    props.Description,
+		LastModifiedBy: props.LastModifiedBy,
+		Language:       props.Language,
+		Identifier:     props.Identifier,
+		Revision:       props.Revision,
+		Created:        &docxDcTerms{Text: props.Created, Type: "dcterms:W3CDTF"},
+		Modified:       &docxDcTerms{Text: props.Modified, Type: "dcterms:W3CDTF"},
+		ContentStatus:  props.ContentStatus,
+		Category:       props.Category,
+		Version:        props.Version,
+	}
+
+	content, err := marshal(core)
+	if err != nil {
+		return err
+	}
+
+	path := rd.corePropsPath()
+	rd.FileMap.Store(path, content)
+	_ = rd.ContentType.AddOverride("/"+path, constants.ContentTypeCoreProperties)
+	rd.RootRels.ensureRelation(constants.CORE_PROP_TYPE, path)
+
+	return nil
+}
+
+// FormatCoreTime formats t as a W3CDTF timestamp in UTC for CoreProperties.
+func FormatCoreTime(t time.Time) string {
+	return t.UTC().Format(w3cdtfLayout)
+}
+
+// corePropsPath returns the path of the core properties part referenced by the package relationships.
+func (rd *RootDoc) corePropsPath() string {
+	for _, rel := range rd.RootRels.Relationships {
+		if rel.Type == constants.CORE_PROP_TYPE && rel.Target != "" {
+			return strings.TrimPrefix(rel.Target, "/")
+		}
+	}
+	return corePropsPath
+}
+
+// ensureRelation adds a relationship of the given type and target unless one already exists.
+func (r *Relationships) ensureRelation(relType, target string) {
+	maxID := 0
+	for _, rel := range r.Relationships {
+		if rel.Type == relType && strings.TrimPrefix(rel.Target, "/") == target {
+			return
+		}
+		var id int
+		if _, err := fmt.Sscanf(rel.ID, "rId%d", &id); err == nil && id > maxID {
+			maxID = id
+		}
+	}
+
+	r.Relationships = append(r.Relationships, &Relationship{
+		ID:     fmt.Sprintf("rId%d", maxID+1),
+		Type:   relType,
+		Target: target,
+	})
+}
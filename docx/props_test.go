@@ -0,0 +1,171 @@
+package docx_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	godocx "github.com/MamaShip/godocx"
+	"github.com/MamaShip/godocx/docx"
+	"github.com/MamaShip/godocx/packager"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoreProperties_FromTemplate(t *testing.T) {
+	rd, err := godocx.NewDocument()
+	require.NoError(t, err)
+
+	props, err := rd.CoreProperties()
+	require.NoError(t, err)
+	assert.Equal(t, "gomutex", props.Creator)
+	assert.Equal(t, "2013-12-23T23:15:00Z", props.Created)
+}
+
+func TestSetCoreProperties(t *testing.T) {
+	rd, err := godocx.NewDocument()
+	require.NoError(t, err)
+
+	before := time.Now().UTC().Truncate(time.Second)
+	require.NoError(t, rd.SetCoreProperties(docx.CoreProperties{
+		Title:    "Quarterly report",
+		Subject:  "Finance",
+		Creator:  "Finance team",
+		Keywords: "report, Q3",
+		Created:  docx.FormatCoreTime(time.Date(2024, 5, 1, 9, 30, 0, 0, time.FixedZone("CEST", 2*3600))),
+	}))
+
+	files, content := writeParts(t, rd)
+
+	core := string(files["docProps/core.xml"])
+	assert.Contains(t, core, `<cp:coreProperties xmlns:cp="http://schemas.openxmlformats.org/package/2006/metadata/core-properties"`)
+	assert.Contains(t, core, `<dc:title>Quarterly report</dc:title>`)
+	assert.Contains(t, core, `<dc:creator>Finance team</dc:creator>`)
+	assert.Contains(t, core, `<cp:keywords>report, Q3</cp:keywords>`)
+	assert.Contains(t, core, `<dcterms:created xsi:type="dcterms:W3CDTF">2024-05-01T07:30:00Z</dcterms:created>`)
+	assert.Contains(t, core, `<dcterms:modified xsi:type="dcterms:W3CDTF">`)
+
+	// The template already registers the part
+	assert.Contains(t, string(files["[Content_Types].xml"]), `PartName="/docProps/core.xml"`)
+	assert.Contains(t, string(files["_rels/.rels"]), `Target="docProps/core.xml"`)
+
+	reopened, err := packager.Unpack(&content)
+	require.NoError(t, err)
+	props, err := reopened.CoreProperties()
+	require.NoError(t, err)
+	assert.Equal(t, "Quarterly report", props.Title)
+	assert.Equal(t, "Finance", props.Subject)
+	assert.Equal(t, "report, Q3", props.Keywords)
+	assert.Equal(t, "2024-05-01T07:30:00Z", props.Created)
+
+	modified, err := time.Parse(time.RFC3339, props.Modified)
+	require.NoError(t, err)
+	assert.False(t, modified.Before(before))
+}
+
+func TestSetCoreProperties_RegistersPart(t *testing.T) {
+	rd := docx.NewRootDoc()
+	rd.RootRels = docx.Relationships{RelativePath: "_rels/.rels"}
+
+	require.NoError(t, rd.SetCoreProperties(docx.CoreProperties{Title: "Untitled"}))
+	require.NoError(t, rd.SetCoreProperties(docx.CoreProperties{Title: "Titled"}))
+
+	require.Len(t, rd.RootRels.Relationships, 1)
+	assert.Equal(t, "docProps/core.xml", rd.RootRels.Relationships[0].Target)
+	assert.Equal(t, "rId1", rd.RootRels.Relationships[0].ID)
+	assert.Contains(t, rd.ContentType.Override, docx.Override{
+		PartName:    "/docProps/core.xml",
+		ContentType: "application/vnd.openxmlformats-package.core-properties+xml",
+	})
+
+	props, err := rd.CoreProperties()
+	require.NoError(t, err)
+	assert.Equal(t, "Titled", props.Title)
+}
+
+func TestSetCustomProperties(t *testing.T) {
+	rd, err := godocx.NewDocument()
+	require.NoError(t, err)
+
+	props, err := rd.CustomProperties()
+	require.NoError(t, err)
+	assert.Empty(t, props)
+
+	due := time.Date(2024, 9, 30, 12, 0, 0, 0, time.UTC)
+	require.NoError(t, rd.SetCustomProperties([]docx.CustomProperty{
+		{Name: "Project", Value: "Apollo"},
+		{Name: "Version", Value: 2},
+		{Name: "Budget", Value: 1250.5},
+		{Name: "Approved", Value: true},
+		{Name: "Due", Value: due},
+	}))
+	require.NoError(t, rd.SetCustomProperty("version", int64(3)))
+	require.NoError(t, rd.SetCustomProperty("Population", int64(8_000_000_000)))
+
+	files, content := writeParts(t, rd)
+	custom := string(files["docProps/custom.xml"])
+	assert.Contains(t, custom, `<Properties xmlns="http://schemas.openxmlformats.org/officeDocument/2006/custom-properties" xmlns:vt="http://schemas.openxmlformats.org/officeDocument/2006/docPropsVTypes">`)
+	assert.Contains(t, custom, `<property fmtid="{D5CDD505-2E9C-101B-9397-08002B2CF9AE}" pid="2" name="Project"><vt:lpwstr>Apollo</vt:lpwstr></property>`)
+	assert.Contains(t, custom, `pid="3" name="Version"><vt:i4>3</vt:i4></property>`)
+	assert.Contains(t, custom, `<vt:r8>1250.5</vt:r8>`)
+	assert.Contains(t, custom, `<vt:bool>true</vt:bool>`)
+	assert.Contains(t, custom, `<vt:filetime>2024-09-30T12:00:00Z</vt:filetime>`)
+	assert.Contains(t, custom, `pid="7" name="Population"><vt:i8>8000000000</vt:i8>`)
+	assert.Contains(t, string(files["[Content_Types].xml"]), `PartName="/docProps/custom.xml"`)
+	assert.Contains(t, string(files["_rels/.rels"]), `Target="docProps/custom.xml"`)
+
+	reopened, err := packager.Unpack(&content)
+	require.NoError(t, err)
+	props, err = reopened.CustomProperties()
+	require.NoError(t, err)
+	assert.Equal(t, []docx.CustomProperty{
+		{Name: "Project", Value: "Apollo"},
+		{Name: "Version", Value: 3},
+		{Name: "Budget", Value: 1250.5},
+		{Name: "Approved", Value: true},
+		{Name: "Due", Value: due},
+		{Name: "Population", Value: 8000000000},
+	}, props)
+}
+
+func TestSetCustomProperties_LargeIntegers(t *testing.T) {
+	rd, err := godocx.NewDocument()
+	require.NoError(t, err)
+
+	require.NoError(t, rd.SetCustomProperties([]docx.CustomProperty{
+		{Name: "Count", Value: uint(7)},
+		{Name: "Serial", Value: uint64(math.MaxUint64)},
+		{Name: "Offset", Value: int64(math.MinInt64)},
+	}))
+
+	files, content := writeParts(t, rd)
+	custom := string(files["docProps/custom.xml"])
+	assert.Contains(t, custom, `name="Count"><vt:i4>7</vt:i4>`)
+	assert.Contains(t, custom, `name="Serial"><vt:ui8>18446744073709551615</vt:ui8>`)
+	assert.Contains(t, custom, `name="Offset"><vt:i8>-9223372036854775808</vt:i8>`)
+
+	// The values out of the range of int are read back and do not prevent updating the part
+	reopened, err := packager.Unpack(&content)
+	require.NoError(t, err)
+	require.NoError(t, reopened.SetCustomProperty("Count", 8))
+	props, err := reopened.CustomProperties()
+	require.NoError(t, err)
+	assert.Equal(t, []docx.CustomProperty{
+		{Name: "Count", Value: 8},
+		{Name: "Serial", Value: uint64(math.MaxUint64)},
+		{Name: "Offset", Value: math.MinInt64},
+	}, props)
+}
+
+func TestSetCustomProperties_Errors(t *testing.T) {
+	rd, err := godocx.NewDocument()
+	require.NoError(t, err)
+
+	assert.Error(t, rd.SetCustomProperties([]docx.CustomProperty{{Name: "", Value: "x"}}))
+	assert.Error(t, rd.SetCustomProperties([]docx.CustomProperty{{Name: "A", Value: 1}, {Name: "a", Value: 2}}))
+	assert.Error(t, rd.SetCustomProperty("Tags", []string{"a"}))
+
+	props, err := rd.CustomProperties()
+	require.NoError(t, err)
+	assert.Empty(t, props, "the document is left unchanged")
+}
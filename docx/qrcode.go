@@ -0,0 +1,82 @@
+package docx
+
+import (
+	"errors"
+
+	"github.com/MamaShip/godocx/common/units"
+)
+
+// QRCodeGenerator renders the given content as a square PNG image.
+//
+// Parameters:
+//   - content: The data to encode.
+//   - size: The requested width and height of the image in pixels.
+//
+// Returns:
+//   - []byte: The PNG encoded image.
+//   - error: An error, if the content could not be encoded.
+//
+// godocx does not bundle a QR code encoder; any library can be plugged in
+// through SetQRCodeGenerator.
+type QRCodeGenerator func(content string, size int) ([]byte, error)
+
+// ErrNoQRCodeGenerator is returned by AddQRCode when no generator has been set.
+var ErrNoQRCodeGenerator = errors.New("no QR code generator set")
+
+// SetQRCodeGenerator sets the function used by AddQRCode to render QR codes.
+//
+// Example:
+//
+//	document.SetQRCodeGenerator(func(content string, size int) ([]byte, error) {
+//		return qrcode.Encode(content, qrcode.Medium, size)
+//	})
+func (rd *RootDoc) SetQRCodeGenerator(gen QRCodeGenerator) {
+	rd.qrGenerator = gen
+}
+
+// AddQRCode adds a new paragraph containing a QR code image to the document.
+//
+// Parameters:
+//   - content: The data to encode in the QR code.
+//   - sizeEMU: The width and height of the QR code in EMUs.
+//
+// Returns:
+//   - *PicMeta: Metadata about the added picture, including the Paragraph instance and Inline element.
+//   - error: ErrNoQRCodeGenerator if no generator is set, or the error returned by the generator.
+func (rd *RootDoc) AddQRCode(content string, sizeEMU int64) (*PicMeta, error) {
+	p := newParagraph(rd)
+
+	pic, err := p.AddQRCode(content, sizeEMU)
+	if err != nil {
+		return nil, err
+	}
+
+	rd.Document.Body.Children = append(rd.Document.Body.Children, DocumentChild{Para: p})
+
+	return pic, nil
+}
+
+// AddQRCode adds a QR code image to the paragraph.
+//
+// See RootDoc.AddQRCode for details.
+func (p *Paragraph) AddQRCode(content string, sizeEMU int64) (*PicMeta, error) {
+	if p.root.qrGenerator == nil {
+		return nil, ErrNoQRCodeGenerator
+	}
+
+	if sizeEMU <= 0 {
+		return nil, errors.New("QR code size must be positive")
+	}
+
+	pixels := int(sizeEMU / emuPerPixel)
+	if pixels < 1 {
+		pixels = 1
+	}
+
+	imgBytes, err := p.root.qrGenerator(content, pixels)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.addPictureBytes(imgBytes, ".png", units.Emu(sizeEMU), units.Emu(sizeEMU))
+}
@@ -0,0 +1,66 @@
+package docx
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// stubQRGenerator renders a blank square PNG of the requested size.
+func stubQRGenerator(content string, size int) ([]byte, error) {
+	img := image.NewGray(image.Rect(0, 0, size, size))
+	for i := range img.Pix {
+		img.Pix[i] = 0xff
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func TestAddQRCode(t *testing.T) {
+	rd := setupRootDoc(t)
+	rd.SetQRCodeGenerator(stubQRGenerator)
+
+	const size = 914400 // 1 inch
+	pic, err := rd.AddQRCode("https://example.com/invoice/42", size)
+	if err != nil {
+		t.Fatalf("AddQRCode: %v", err)
+	}
+
+	assert.Len(t, rd.Document.Body.Children, 1)
+
+	v, ok := rd.FileMap.Load("word/media/image2.png")
+	if !ok {
+		t.Fatalf("media part not stored")
+	}
+	cfg, err := png.DecodeConfig(bytes.NewReader(v.([]byte)))
+	if err != nil {
+		t.Fatalf("media part is not a PNG: %v", err)
+	}
+	assert.Equal(t, 96, cfg.Width)
+
+	assert.Equal(t, uint64(size), pic.Inline.Extent.Width)
+	assert.Equal(t, uint64(size), pic.Inline.Extent.Height)
+}
+
+func TestAddQRCodeErrors(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	_, err := rd.AddQRCode("data", 914400)
+	assert.ErrorIs(t, err, ErrNoQRCodeGenerator)
+
+	genErr := errors.New("content too long")
+	rd.SetQRCodeGenerator(func(string, int) ([]byte, error) { return nil, genErr })
+	_, err = rd.AddQRCode("data", 914400)
+	assert.ErrorIs(t, err, genErr)
+
+	// Failed calls must not leave empty paragraphs behind
+	assert.Empty(t, rd.Document.Body.Children)
+}
@@ -2,6 +2,10 @@ package docx
 
 import (
 	"encoding/xml"
+	"regexp"
+	"strings"
+
+	"github.com/MamaShip/godocx/common/constants"
 )
 
 // Relationship represents a relationship between elements in an Office Open XML (OOXML) document.
@@ -45,3 +49,99 @@ func (r Relationship) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 
 	return e.EncodeElement("", start)
 }
+
+// prunableRelTypes lists the relationship types that exist only to be referenced from the
+// content, and can therefore be removed when nothing references them.
+var prunableRelTypes = map[string]bool{
+	constants.SourceRelationshipHyperLink:                                           true,
+	constants.SourceRelationshipImage:                                               true,
+	constants.SourceRelationshipChart:                                               true,
+	"http://schemas.openxmlformats.org/officeDocument/2006/relationships/oleObject": true,
+	"http://schemas.openxmlformats.org/officeDocument/2006/relationships/package":   true,
+}
+
+// relReferencePattern matches the attributes holding a relationship ID, e.g. r:id, r:embed or
+// the o:relid of VML content.
+var relReferencePattern = regexp.MustCompile(`\b(?:r:[A-Za-z]+|o:relid)="([^"]*)"`)
+
+// PruneUnusedRelationships removes the hyperlink, image, chart and embedded object
+// relationships of the document that are not referenced anymore, e.g. after removing a
+// paragraph holding a link, and returns the number of relationships removed.
+//
+// References are looked for in the document, the headers and footers, the comments, the
+// notes and the other parts of the word folder. Relationships to parts such as styles, numbering or
+// settings are kept even though the content does not reference them. The parts targeted
+// by removed relationships, e.g. the image files, are kept.
+//
+// Example:
+//
+//	document.Document.Body.RemoveParagraph(p)
+//	removed := document.Document.PruneUnusedRelationships()
+func (doc *Document) PruneUnusedRelationships() int {
+	referenced, err := doc.relReferences()
+	if err != nil {
+		// The references cannot be known, so none is removed
+		return 0
+	}
+
+	kept := doc.DocRels.Relationships[:0]
+	removed := 0
+	for _, rel := range doc.DocRels.Relationships {
+		if prunableRelTypes[rel.Type] && !referenced[rel.ID] {
+			removed++
+			continue
+		}
+		kept = append(kept, rel)
+	}
+	doc.DocRels.Relationships = kept
+
+	return removed
+}
+
+// relReferences returns the relationship IDs referenced from the document, its headers,
+// footers, comments and notes, and the unparsed parts of the word folder.
+func (doc *Document) relReferences() (map[string]bool, error) {
+	referenced := make(map[string]bool)
+	addReferences := func(content []byte) {
+		for _, match := range relReferencePattern.FindAllSubmatch(content, -1) {
+			referenced[string(match[1])] = true
+		}
+	}
+
+	parts := []any{doc}
+	parsed := map[string]bool{doc.relativePath: true}
+	if rd := doc.Root; rd != nil {
+		for path, hf := range rd.headerFooters {
+			parts = append(parts, hf)
+			parsed[path] = true
+		}
+		if rd.comments != nil {
+			parts = append(parts, rd.comments)
+			parsed[rd.comments.path] = true
+		}
+		for _, notes := range []*notesPart{rd.footnotes, rd.endnotes} {
+			if notes != nil {
+				parts = append(parts, notes)
+				parsed[notes.path] = true
+			}
+		}
+
+		rd.FileMap.Range(func(key, value any) bool {
+			path := key.(string)
+			if strings.HasPrefix(path, "word/") && strings.HasSuffix(path, ".xml") && !parsed[path] {
+				addReferences(value.([]byte))
+			}
+			return true
+		})
+	}
+
+	for _, part := range parts {
+		content, err := xml.Marshal(part)
+		if err != nil {
+			return nil, err
+		}
+		addReferences(content)
+	}
+
+	return referenced, nil
+}
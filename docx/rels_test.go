@@ -0,0 +1,49 @@
+package docx
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/MamaShip/godocx/common/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPruneUnusedRelationships(t *testing.T) {
+	rd := setupRootDoc(t)
+	rd.Document.Root = rd
+	rd.Document.DocRels.Relationships = []*Relationship{
+		{ID: "rId1", Type: constants.StylesType, Target: "styles.xml"},
+	}
+	rd.Document.RID = 1
+
+	kept := rd.AddParagraph("Kept ")
+	kept.AddLink("link", "https://example.com/kept")
+
+	removedLink := rd.AddParagraph("Removed ")
+	removedLink.AddLink("link", "https://example.com/removed")
+
+	image, err := rd.AddImage(bytes.NewReader(encodeTestImage(t, ImageFormatPNG, 16, 16)), ImageFormatPNG)
+	require.NoError(t, err)
+
+	// A link referenced from an unparsed part, e.g. a footnote, is kept as well
+	rd.AddParagraph("Noted ").AddLink("link", "https://example.com/noted")
+	noted := rd.Document.DocRels.Relationships[len(rd.Document.DocRels.Relationships)-1]
+	rd.FileMap.Store("word/footnotes.xml", []byte(`<w:footnotes><w:hyperlink r:id="`+noted.ID+`"/></w:footnotes>`))
+	rd.Document.Body.RemoveParagraph(rd.Document.Body.Paragraphs()[3])
+
+	require.Len(t, rd.Document.DocRels.Relationships, 5)
+	assert.Equal(t, 0, rd.Document.PruneUnusedRelationships())
+
+	rd.Document.Body.RemoveParagraph(removedLink)
+	rd.Document.Body.RemoveParagraph(image.Paragraph())
+	assert.Equal(t, 2, rd.Document.PruneUnusedRelationships())
+
+	var targets []string
+	for _, rel := range rd.Document.DocRels.Relationships {
+		targets = append(targets, rel.Target)
+	}
+	assert.Equal(t, []string{"styles.xml", "https://example.com/kept", "https://example.com/noted"}, targets)
+
+	assert.Equal(t, 0, rd.Document.PruneUnusedRelationships())
+}
@@ -0,0 +1,200 @@
+package docx
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MamaShip/godocx/wml/ctypes"
+)
+
+// ErrNotRepeatingSection is returned when filling a content control that is not a repeating
+// section, see ContentControl.FillRepeatingSection.
+var ErrNotRepeatingSection = errors.New("content control is not a repeating section")
+
+// IsRepeatingSection reports whether the content control is a repeating section, made of
+// items that can be generated from data with FillRepeatingSection.
+func (c *ContentControl) IsRepeatingSection() bool {
+	return c.ct.Property != nil && c.ct.Property.RepeatingSection != nil
+}
+
+// FillRepeatingSections fills the repeating section content controls of the document body
+// with the given tag, see ContentControl.FillRepeatingSection.
+//
+// Returns:
+//   - error: ErrNotRepeatingSection if the document has no repeating section with the tag.
+//
+// Example:
+//
+//	err := document.FillRepeatingSections("lines", []map[string]string{
+//		{"product": "Widget", "quantity": "2"},
+//		{"product": "Gadget", "quantity": "1"},
+//	})
+func (rd *RootDoc) FillRepeatingSections(tag string, data []map[string]string) error {
+	found := false
+	for _, control := range rd.ContentControlsByTag(tag) {
+		if !control.IsRepeatingSection() {
+			continue
+		}
+		found = true
+		if err := control.FillRepeatingSection(data); err != nil {
+			return err
+		}
+	}
+
+	if !found {
+		return fmt.Errorf("%w: no repeating section with tag %q", ErrNotRepeatingSection, tag)
+	}
+	return nil
+}
+
+// FillRepeatingSection replaces the items of the repeating section content control, e.g.
+// the rows of a table made repeating in Word, with one copy of its first item per element of
+// data. In each copy, the content controls whose tag is a key of the element display its
+// value: the text of a text content control, "true" or "1" to check a check box, an ISO 8601
+// date for a date picker, the value of an item for a list, or a base64 encoded image.
+//
+// The content controls, bookmarks and drawings of the copies get new IDs, their bookmarks
+// new names, e.g. "Total_2" for "Total", and their comments are copied. The data bindings
+// of the section and of the filled content controls are removed, so that Word does not
+// replace the values with the data of a custom XML part. With no data, the section is left
+// without items.
+//
+// Returns:
+//   - error: ErrNotRepeatingSection if the content control is not a block level repeating
+//     section or a repeating section of table rows; the content control is left unchanged then.
+//
+// Example:
+//
+//	for _, section := range document.ContentControlsByTag("lines") {
+//		err := section.FillRepeatingSection([]map[string]string{
+//			{"product": "Widget", "quantity": "2"},
+//			{"product": "Gadget", "quantity": "1"},
+//		})
+//	}
+func (c *ContentControl) FillRepeatingSection(data []map[string]string) error {
+	if !c.IsRepeatingSection() || (!c.block && c.rows == nil) {
+		return ErrNotRepeatingSection
+	}
+
+	var err error
+	if c.block {
+		err = c.fillBlockItems(data)
+	} else {
+		err = c.fillRowItems(data)
+	}
+	if err != nil {
+		return err
+	}
+
+	c.ct.Property.DataBinding = nil
+	c.ct.Property.ShowingPlaceholder = nil
+	return nil
+}
+
+// fillBlockItems replaces the items of a block level repeating section with copies of its
+// first item.
+func (c *ContentControl) fillBlockItems(data []map[string]string) error {
+	var template *ContentControl
+	for _, child := range c.children {
+		if child.Sdt != nil && child.Sdt.isRepeatingSectionItem() {
+			template = child.Sdt
+			break
+		}
+	}
+	if template == nil {
+		// The whole content is the item, as in sections saved without items
+		template = &ContentControl{
+			root:     c.root,
+			ct:       &ctypes.SdtRun{Property: &ctypes.SdtProperty{RepeatingSectionItem: &ctypes.Empty{}}},
+			hf:       c.hf,
+			block:    true,
+			children: c.children,
+		}
+	}
+
+	copier := c.root.newContentCopier()
+	items := make([]DocumentChild, 0, len(data))
+	for _, values := range data {
+		copier.newCopy()
+		child, err := copier.copyChild(DocumentChild{Sdt: template})
+		if err != nil {
+			return err
+		}
+		if child.Sdt == nil {
+			return errors.New("unexpected content when copying a repeating section item")
+		}
+
+		item := child.Sdt
+		item.hf = c.hf
+		walkChildrenParagraphs(item.children, func(p *ctypes.Paragraph) {
+			p.ParaID = nil
+		})
+		for _, p := range item.Paragraphs() {
+			p.hf = c.hf
+		}
+		c.root.fillItemControls(appendBlockContentControls(nil, c.root, []DocumentChild{child}), values)
+		items = append(items, DocumentChild{Sdt: item})
+	}
+
+	c.children = items
+	return nil
+}
+
+// fillRowItems replaces the items of a repeating section of table rows with copies of its
+// first item.
+func (c *ContentControl) fillRowItems(data []map[string]string) error {
+	var template ctypes.RowContent
+	for _, rowContent := range c.rows.Rows {
+		if rowContent.Sdt != nil && rowContent.Sdt.Property != nil && rowContent.Sdt.Property.RepeatingSectionItem != nil {
+			template = rowContent
+			break
+		}
+	}
+	if template.Sdt == nil {
+		// The whole content is the item, as in sections saved without items
+		template = ctypes.RowContent{Sdt: &ctypes.SdtRow{
+			Property: &ctypes.SdtProperty{RepeatingSectionItem: &ctypes.Empty{}},
+			Rows:     c.rows.Rows,
+		}}
+	}
+
+	copier := c.root.newContentCopier()
+	items := make([]ctypes.RowContent, 0, len(data))
+	for _, values := range data {
+		copier.newCopy()
+		rows, err := copier.copyRows([]ctypes.RowContent{template})
+		if err != nil {
+			return err
+		}
+		if len(rows) != 1 || rows[0].Sdt == nil {
+			return fmt.Errorf("unexpected content when copying a repeating section item: %d rows", len(rows))
+		}
+
+		walkRowsParagraphs(rows, func(p *ctypes.Paragraph) {
+			p.ParaID = nil
+		})
+		c.root.fillItemControls(appendRowContentControls(nil, c.root, rows), values)
+		items = append(items, rows[0])
+	}
+
+	c.rows.Rows = items
+	return nil
+}
+
+// fillItemControls displays the values in the content controls of a copied item whose tag
+// is a key of the values.
+func (rd *RootDoc) fillItemControls(controls []*ContentControl, values map[string]string) {
+	for _, control := range controls {
+		value, ok := values[control.Tag()]
+		if !ok || control.Tag() == "" {
+			continue
+		}
+		control.property().DataBinding = nil
+		control.setValue(value)
+	}
+}
+
+// isRepeatingSectionItem reports whether the content control is an item of a repeating section.
+func (c *ContentControl) isRepeatingSectionItem() bool {
+	return c.ct.Property != nil && c.ct.Property.RepeatingSectionItem != nil
+}
@@ -0,0 +1,137 @@
+package docx
+
+import (
+	"encoding/xml"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/MamaShip/godocx/wml/ctypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// repeatingSectionsXML is a document body with a block level repeating section and a
+// repeating section of table rows, as saved by Word.
+const repeatingSectionsXML = `<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main" xmlns:w15="http://schemas.microsoft.com/office/word/2012/wordml"><w:body>` +
+	`<w:sdt><w:sdtPr><w:tag w:val="contacts"/><w:id w:val="10"/><w:dataBinding w:xpath="/contacts[1]/contact"/><w15:repeatingSection/></w:sdtPr><w:sdtContent>` +
+	`<w:sdt><w:sdtPr><w:id w:val="11"/><w15:repeatingSectionItem/></w:sdtPr><w:sdtContent>` +
+	`<w:p w14:paraId="0000000A" xmlns:w14="http://schemas.microsoft.com/office/word/2010/wordml"><w:r><w:t xml:space="preserve">Name: </w:t></w:r>` +
+	`<w:sdt><w:sdtPr><w:tag w:val="name"/><w:id w:val="12"/><w:dataBinding w:xpath="/contacts[1]/contact[1]/name[1]"/><w:showingPlcHdr/><w:text/></w:sdtPr><w:sdtContent><w:r><w:t>Enter a name</w:t></w:r></w:sdtContent></w:sdt></w:p>` +
+	`</w:sdtContent></w:sdt>` +
+	`</w:sdtContent></w:sdt>` +
+	`<w:tbl><w:tblPr/><w:tblGrid><w:gridCol w:w="4000"/><w:gridCol w:w="2000"/></w:tblGrid>` +
+	`<w:tr><w:tc><w:p><w:r><w:t>Product</w:t></w:r></w:p></w:tc><w:tc><w:p><w:r><w:t>Shipped</w:t></w:r></w:p></w:tc></w:tr>` +
+	`<w:sdt><w:sdtPr><w:tag w:val="lines"/><w:id w:val="20"/><w15:repeatingSection><w15:sectionTitle w15:val="Line"/></w15:repeatingSection></w:sdtPr><w:sdtContent>` +
+	`<w:sdt><w:sdtPr><w:id w:val="21"/><w15:repeatingSectionItem/></w:sdtPr><w:sdtContent><w:tr>` +
+	`<w:tc><w:p><w:sdt><w:sdtPr><w:tag w:val="product"/><w:id w:val="22"/><w:text/></w:sdtPr><w:sdtContent><w:r><w:t>Product</w:t></w:r></w:sdtContent></w:sdt></w:p></w:tc>` +
+	`<w:tc><w:p><w:sdt><w:sdtPr><w:tag w:val="shipped"/><w:id w:val="23"/><w14:checkbox xmlns:w14="http://schemas.microsoft.com/office/word/2010/wordml"><w14:checked w14:val="0"/></w14:checkbox></w:sdtPr><w:sdtContent><w:r><w:t>☐</w:t></w:r></w:sdtContent></w:sdt></w:p></w:tc>` +
+	`</w:tr></w:sdtContent></w:sdt>` +
+	`</w:sdtContent></w:sdt>` +
+	`</w:tbl>` +
+	`</w:body></w:document>`
+
+func loadRepeatingSections(t *testing.T) *RootDoc {
+	t.Helper()
+	rd := setupRootDoc(t)
+	doc, err := LoadDocXml(rd, "word/document.xml", []byte(repeatingSectionsXML))
+	require.NoError(t, err)
+	rd.Document = doc
+	return rd
+}
+
+func TestFillRepeatingSection(t *testing.T) {
+	rd := loadRepeatingSections(t)
+
+	contacts := rd.ContentControlsByTag("contacts")
+	require.Len(t, contacts, 1)
+	assert.True(t, contacts[0].IsRepeatingSection())
+	assert.True(t, contacts[0].IsBlock())
+	lines := rd.ContentControlsByTag("lines")
+	require.Len(t, lines, 1)
+	assert.True(t, lines[0].IsRepeatingSection())
+	assert.True(t, lines[0].IsTableRows())
+	assert.Equal(t, "Product\tShipped\nProduct\t☐", rd.PlainText()[strings.Index(rd.PlainText(), "Product"):])
+
+	require.NoError(t, contacts[0].FillRepeatingSection([]map[string]string{{"name": "Jane Doe"}, {"name": "John Smith"}}))
+	require.NoError(t, rd.FillRepeatingSections("lines", []map[string]string{
+		{"product": "Widget", "shipped": "true"},
+		{"product": "Gadget"},
+		{"product": "Gizmo", "shipped": "0"},
+	}))
+	assert.Equal(t, "Name: Jane Doe\nName: John Smith\nProduct\tShipped\nWidget\t☒\nGadget\t☐\nGizmo\t☐", rd.PlainText())
+
+	// The copies have their own content control IDs
+	ids := map[int]bool{}
+	for _, control := range rd.ContentControls() {
+		assert.False(t, ids[control.ID()], "duplicate ID %d", control.ID())
+		ids[control.ID()] = true
+	}
+	assert.Len(t, ids, 15)
+
+	output, err := xml.Marshal(rd.Document.Body)
+	require.NoError(t, err)
+	body := string(output)
+	assert.Equal(t, 5, strings.Count(body, "<w15:repeatingSectionItem>"))
+	assert.Equal(t, 2, strings.Count(body, "<w15:repeatingSection>"))
+	assert.Contains(t, body, `<w15:repeatingSection><w15:sectionTitle w15:val="Line"></w15:sectionTitle></w15:repeatingSection>`)
+	assert.NotContains(t, body, "w:dataBinding")
+	assert.NotContains(t, body, "paraId")
+	assert.Equal(t, 4, strings.Count(body, "<w:tr>"))
+
+	// Filling again starts from the first item, and no data leaves no items
+	require.NoError(t, rd.FillRepeatingSections("lines", []map[string]string{{"product": "Sprocket"}}))
+	assert.Equal(t, "Product\tShipped\nSprocket\t☒", rd.PlainText()[strings.Index(rd.PlainText(), "Product"):])
+	require.NoError(t, contacts[0].FillRepeatingSection(nil))
+	assert.Empty(t, contacts[0].Text())
+}
+
+func TestFillRepeatingSection_Errors(t *testing.T) {
+	rd := loadRepeatingSections(t)
+
+	name := rd.ContentControlsByTag("name")
+	require.Len(t, name, 1)
+	assert.ErrorIs(t, name[0].FillRepeatingSection(nil), ErrNotRepeatingSection)
+	assert.ErrorIs(t, rd.FillRepeatingSections("missing", nil), ErrNotRepeatingSection)
+
+	// A repeating section without items repeats its whole content
+	block := rd.AddContentControl(ContentControlOptions{Type: ContentControlRichText, Tag: "notes"})
+	block.SetText("Note")
+	block.property().RepeatingSection = &ctypes.SdtRepeatingSection{}
+	require.NoError(t, block.FillRepeatingSection([]map[string]string{{}, {}}))
+	assert.Equal(t, "Note\nNote", block.Text())
+	require.Len(t, block.children, 2)
+	assert.True(t, block.children[0].Sdt.isRepeatingSectionItem())
+}
+
+func TestFillRepeatingSection_CopiedIDs(t *testing.T) {
+	rd := loadRepeatingSections(t)
+	contacts := rd.ContentControlsByTag("contacts")[0]
+	p := contacts.children[0].Sdt.Paragraphs()[0]
+	p.AddBookmark("Contact")
+	p.AddShapeGroup().AddShape("rect", 0, 0, 100, 100)
+	rd.AddComment("Jane Doe", "JD", newRun(rd, p.ct.Children[1].Run)).AddParagraph("Check the name")
+
+	require.NoError(t, contacts.FillRepeatingSection([]map[string]string{{"name": "Jane Doe"}, {"name": "John Smith"}}))
+
+	output, err := xml.Marshal(rd.Document.Body)
+	require.NoError(t, err)
+	body := string(output)
+
+	// Each copy has its own bookmark, drawing and comment
+	for _, pattern := range []string{`<w:bookmarkStart w:id="(\d+)"`, `w:name="(Contact[^"]*)"`, `<wp:docPr id="(\d+)"`, `<w:commentRangeStart w:id="(\d+)"`} {
+		matches := regexp.MustCompile(pattern).FindAllStringSubmatch(body, -1)
+		require.Len(t, matches, 2, pattern)
+		assert.NotEqual(t, matches[0][1], matches[1][1], pattern)
+	}
+	assert.Contains(t, body, `w:name="Contact_2"`)
+	assert.Contains(t, body, `w:name="Contact_3"`)
+
+	comments := rd.Comments()
+	require.Len(t, comments, 3)
+	for _, comment := range comments[1:] {
+		assert.Equal(t, "Check the name", comment.Text())
+		assert.Contains(t, body, `<w:commentReference w:id="`+strconv.Itoa(comment.ID)+`"`)
+	}
+}
@@ -0,0 +1,207 @@
+package docx
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/MamaShip/godocx/internal"
+	"github.com/MamaShip/godocx/wml/ctypes"
+)
+
+// textMatch is a match of a search within the combined text of a group of text elements.
+type textMatch struct {
+	start, end int    // byte offsets of the match in the combined text
+	repl       string // replacement text
+}
+
+// ReplaceText replaces all non-overlapping occurrences of old with new in the document body,
+// including text split across several runs and text inside hyperlinks.
+//
+// The replacement text takes the formatting of the run in which the match starts.
+// Field instructions are never modified.
+//
+// While changes are tracked, see BeginTrackedChanges, the matched text is kept as a tracked
+// deletion followed by the replacement as a tracked insertion. Only the text of the runs of
+// the paragraphs is replaced then, not the text of hyperlinks, content controls or earlier
+// revisions.
+//
+// Returns:
+//   - int: The number of replacements made.
+//
+// Example:
+//
+//	n := document.ReplaceText("{{name}}", "Jane Doe")
+func (rd *RootDoc) ReplaceText(old, new string) int {
+	if old == "" {
+		return 0
+	}
+
+	return rd.replaceMatches(func(text string) []textMatch {
+		var matches []textMatch
+		offset := 0
+		for {
+			idx := strings.Index(text[offset:], old)
+			if idx < 0 {
+				return matches
+			}
+			start := offset + idx
+			matches = append(matches, textMatch{start: start, end: start + len(old), repl: new})
+			offset = start + len(old)
+		}
+	})
+}
+
+// ReplaceTextRegex replaces all matches of re in the document body with repl.
+// Inside repl, $ signs are interpreted as in regexp.Regexp.Expand, so $1 is the first submatch.
+//
+// Matching follows the same rules as ReplaceText.
+//
+// Returns:
+//   - int: The number of replacements made.
+func (rd *RootDoc) ReplaceTextRegex(re *regexp.Regexp, repl string) int {
+	return rd.replaceMatches(func(text string) []textMatch {
+		var matches []textMatch
+		for _, loc := range re.FindAllStringSubmatchIndex(text, -1) {
+			expanded := re.ExpandString(nil, repl, text, loc)
+			matches = append(matches, textMatch{start: loc[0], end: loc[1], repl: string(expanded)})
+		}
+		return matches
+	})
+}
+
+// replaceMatches applies the matches found by find to every text group of the document body.
+func (rd *RootDoc) replaceMatches(find func(text string) []textMatch) int {
+	count := 0
+	rd.walkParagraphs(func(p *ctypes.Paragraph) {
+		if rd.tracking != nil {
+			count += rd.replaceTracked(p, find)
+			return
+		}
+		for _, group := range paragraphTextGroups(p) {
+			count += replaceInTextGroup(group, find)
+		}
+	})
+	return count
+}
+
+// textGroupCollector splits paragraph content into groups of consecutive w:t elements.
+// Any other run content (tabs, breaks, field characters and instructions, drawings, ...)
+// ends a group, so that matches never span it.
+type textGroupCollector struct {
+	groups [][]*ctypes.Text
+	cur    []*ctypes.Text
+}
+
+func (c *textGroupCollector) flush() {
+	if len(c.cur) > 0 {
+		c.groups = append(c.groups, c.cur)
+		c.cur = nil
+	}
+}
+
+func (c *textGroupCollector) addRun(r *ctypes.Run) {
+	for _, child := range r.Children {
+		if child.Text != nil {
+			c.cur = append(c.cur, child.Text)
+		} else {
+			c.flush()
+		}
+	}
+}
+
+func (c *textGroupCollector) addChildren(children []ctypes.ParagraphChild) {
+	for _, child := range children {
+		if child.Run != nil {
+			c.addRun(child.Run)
+		}
+
+		// Hyperlink text is matched on its own so that replacements stay inside the link
+		if child.Link != nil {
+			c.flush()
+			if child.Link.Run != nil {
+				c.addRun(child.Link.Run)
+			}
+			c.addChildren(child.Link.Children)
+			c.flush()
+		}
+
+		// Likewise for content controls, so that a placeholder is not merged with the text around it
+		if child.Sdt != nil {
+			c.flush()
+			c.addChildren(child.Sdt.Content)
+			c.flush()
+		}
+	}
+}
+
+// paragraphTextGroups returns the groups of adjacent text elements of the paragraph.
+func paragraphTextGroups(p *ctypes.Paragraph) [][]*ctypes.Text {
+	c := &textGroupCollector{}
+	c.addChildren(p.Children)
+	c.flush()
+	return c.groups
+}
+
+// replaceInTextGroup replaces the matches in the combined text of the group.
+// The replacement is written to the element holding the start of each match,
+// and the rest of the match is removed from the following elements.
+func replaceInTextGroup(group []*ctypes.Text, find func(text string) []textMatch) int {
+	offsets := make([]int, len(group)+1)
+	var sb strings.Builder
+	for i, t := range group {
+		offsets[i] = sb.Len()
+		sb.WriteString(t.Text)
+	}
+	offsets[len(group)] = sb.Len()
+
+	matches := find(sb.String())
+
+	// Apply from the last match backwards so that earlier offsets stay valid
+	for m := len(matches) - 1; m >= 0; m-- {
+		match := matches[m]
+		first := segmentAt(offsets, match.start)
+		last := first
+		if match.end > match.start {
+			last = segmentAt(offsets, match.end-1)
+		}
+
+		firstText := group[first].Text
+		head := firstText[:match.start-offsets[first]]
+		if first == last {
+			group[first].Text = head + match.repl + firstText[match.end-offsets[first]:]
+		} else {
+			group[first].Text = head + match.repl
+			for i := first + 1; i < last; i++ {
+				group[i].Text = ""
+			}
+			group[last].Text = group[last].Text[match.end-offsets[last]:]
+		}
+	}
+
+	if len(matches) > 0 {
+		for _, t := range group {
+			updateTextSpace(t)
+		}
+	}
+
+	return len(matches)
+}
+
+// segmentAt returns the index of the element containing the byte at pos.
+// A position at the very end of the text belongs to the last element.
+func segmentAt(offsets []int, pos int) int {
+	last := len(offsets) - 2
+	for i := 0; i < last; i++ {
+		if pos < offsets[i+1] {
+			return i
+		}
+	}
+	return last
+}
+
+// updateTextSpace makes sure leading and trailing whitespace of the text is preserved.
+func updateTextSpace(t *ctypes.Text) {
+	if strings.TrimSpace(t.Text) != t.Text {
+		t.Space = internal.ToPtr(ctypes.TextSpacePreserve)
+	}
+}
@@ -0,0 +1,110 @@
+package docx
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/MamaShip/godocx/wml/ctypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func runTexts(p *Paragraph) []string {
+	var texts []string
+	for _, child := range p.ct.Children {
+		if child.Run == nil {
+			continue
+		}
+		for _, rc := range child.Run.Children {
+			if rc.Text != nil {
+				texts = append(texts, rc.Text.Text)
+			}
+		}
+	}
+	return texts
+}
+
+func TestReplaceText_SingleRun(t *testing.T) {
+	rd := setupRootDoc(t)
+	p := rd.AddParagraph("Hello NAME, welcome NAME!")
+
+	n := rd.ReplaceText("NAME", "Jane")
+
+	assert.Equal(t, 2, n)
+	assert.Equal(t, []string{"Hello Jane, welcome Jane!"}, runTexts(p))
+}
+
+func TestReplaceText_AcrossRuns(t *testing.T) {
+	rd := setupRootDoc(t)
+	p := rd.AddParagraph("Dear {{na")
+	p.AddText("m").Bold(true)
+	p.AddText("e}}, hi")
+
+	n := rd.ReplaceText("{{name}}", "Jane")
+
+	assert.Equal(t, 1, n)
+	assert.Equal(t, []string{"Dear Jane", "", ", hi"}, runTexts(p))
+	// The replacement keeps the formatting of the first run
+	assert.Nil(t, p.ct.Children[0].Run.Property)
+}
+
+func TestReplaceText_InHyperlink(t *testing.T) {
+	rd := setupRootDoc(t)
+	p := rd.AddParagraph("Visit ")
+	p.AddHyperlink("old site", "https://example.com")
+
+	n := rd.ReplaceText("old", "new")
+
+	assert.Equal(t, 1, n)
+	assert.Equal(t, "new site", p.ct.Children[1].Link.Run.Children[0].Text.Text)
+}
+
+func TestReplaceText_SkipsFieldCodes(t *testing.T) {
+	rd := setupRootDoc(t)
+	p := rd.AddTableOfContents(DefaultTOCOptions())
+
+	n := rd.ReplaceText("TOC", "XXX")
+
+	assert.Equal(t, 0, n)
+	for _, rc := range p.ct.Children[0].Run.Children {
+		if rc.InstrText != nil {
+			assert.Contains(t, rc.InstrText.Text, "TOC")
+		}
+	}
+}
+
+func TestReplaceText_InTable(t *testing.T) {
+	rd := setupRootDoc(t)
+	tbl := rd.AddTable()
+	row := tbl.AddRow()
+	row.AddCell().AddParagraph("cell value")
+
+	n := rd.ReplaceText("value", "text")
+
+	assert.Equal(t, 1, n)
+	cell := tbl.ct.RowContents[0].Row.Contents[0].Cell
+	assert.Equal(t, "cell text", cell.Contents[0].Paragraph.Children[0].Run.Children[0].Text.Text)
+}
+
+func TestReplaceTextRegex(t *testing.T) {
+	rd := setupRootDoc(t)
+	p := rd.AddParagraph("Invoice 2024-")
+	p.AddText("05-17 issued")
+
+	n := rd.ReplaceTextRegex(regexp.MustCompile(`(\d{4})-(\d{2})-(\d{2})`), "$3/$2/$1")
+
+	assert.Equal(t, 1, n)
+	assert.Equal(t, []string{"Invoice 17/05/2024", " issued"}, runTexts(p))
+
+	space := p.ct.Children[1].Run.Children[0].Text.Space
+	if assert.NotNil(t, space) {
+		assert.Equal(t, ctypes.TextSpacePreserve, *space)
+	}
+}
+
+func TestReplaceText_Empty(t *testing.T) {
+	rd := setupRootDoc(t)
+	rd.AddParagraph("text")
+
+	assert.Equal(t, 0, rd.ReplaceText("", "x"))
+	assert.Equal(t, 0, rd.ReplaceText("missing", "x"))
+}
@@ -0,0 +1,334 @@
+package docx
+
+import (
+	"strings"
+	"time"
+
+	"github.com/MamaShip/godocx/wml/ctypes"
+)
+
+// RevisionKind is the kind of a tracked change of the document, see Revision.
+type RevisionKind int
+
+const (
+	RevisionInsertion          RevisionKind = iota // Inserted runs (w:ins)
+	RevisionDeletion                               // Deleted runs (w:del)
+	RevisionRunFormat                              // Formatting change of a run (w:rPrChange)
+	RevisionParagraphFormat                        // Formatting change of a paragraph (w:pPrChange)
+	RevisionParagraphInsertion                     // Inserted paragraph mark, splitting a paragraph
+	RevisionParagraphDeletion                      // Deleted paragraph mark, joining a paragraph to the next one
+)
+
+// Revision is a tracked change of the document body, made in Word with "Track Changes" on or
+// through the API, see BeginTrackedChanges, which can be accepted or rejected.
+type Revision struct {
+	root *RootDoc
+
+	Kind   RevisionKind
+	ID     int       // Revision ID
+	Author string    // Author of the change
+	Date   time.Time // Time of the change; the zero time if undated
+	Text   string    // Inserted or deleted text, or the text of the run or paragraph whose formatting changed
+
+	para   *ctypes.Paragraph      // Paragraph of the change
+	change *ctypes.RunTrackChange // Insertion or deletion of runs
+	run    *ctypes.Run            // Run whose formatting changed
+}
+
+// Revisions returns the tracked changes of the paragraphs of the document body, including
+// those of tables, in document order: insertions and deletions of runs, formatting changes of
+// runs and paragraphs, and insertions and deletions of paragraph marks. Moves (w:moveFrom and
+// w:moveTo) and the revisions of table rows and cells are not listed.
+//
+// Example:
+//
+//	for _, revision := range document.Revisions() {
+//		if revision.Author == "Jane Doe" {
+//			revision.Accept()
+//		}
+//	}
+func (rd *RootDoc) Revisions() []*Revision {
+	var revisions []*Revision
+	rd.walkParagraphs(func(p *ctypes.Paragraph) {
+		if p.Property != nil && p.Property.PPrChange != nil {
+			change := p.Property.PPrChange
+			revisions = append(revisions, rd.newRevision(RevisionParagraphFormat, p, change.ID, change.Author, change.Date, paragraphText(p)))
+		}
+
+		for _, child := range p.Children {
+			switch {
+			case child.Run != nil:
+				if r := rd.runFormatRevision(p, child.Run); r != nil {
+					revisions = append(revisions, r)
+				}
+			case child.Ins != nil:
+				var sb strings.Builder
+				for _, run := range child.Ins.Runs {
+					writeRunText(&sb, run)
+				}
+				r := rd.newRevision(RevisionInsertion, p, child.Ins.ID, child.Ins.Author, child.Ins.Date, sb.String())
+				r.change = child.Ins
+				revisions = append(revisions, r)
+				for _, run := range child.Ins.Runs {
+					if r := rd.runFormatRevision(p, run); r != nil {
+						revisions = append(revisions, r)
+					}
+				}
+			case child.Del != nil:
+				var sb strings.Builder
+				for _, run := range child.Del.Runs {
+					for _, runChild := range run.Children {
+						if runChild.DelText != nil {
+							sb.WriteString(runChild.DelText.Text)
+						}
+					}
+				}
+				r := rd.newRevision(RevisionDeletion, p, child.Del.ID, child.Del.Author, child.Del.Date, sb.String())
+				r.change = child.Del
+				revisions = append(revisions, r)
+			}
+		}
+
+		if p.Property != nil && p.Property.RunProperty != nil {
+			mark := p.Property.RunProperty
+			if mark.Ins != nil {
+				revisions = append(revisions, rd.newRevision(RevisionParagraphInsertion, p, mark.Ins.ID, mark.Ins.Author, mark.Ins.Date, paragraphText(p)))
+			}
+			if mark.Del != nil {
+				revisions = append(revisions, rd.newRevision(RevisionParagraphDeletion, p, mark.Del.ID, mark.Del.Author, mark.Del.Date, paragraphText(p)))
+			}
+		}
+	})
+	return revisions
+}
+
+// AcceptAllRevisions accepts all the tracked changes of the document body listed by
+// Revisions, and returns their number.
+func (rd *RootDoc) AcceptAllRevisions() int {
+	count := 0
+	for _, r := range rd.Revisions() {
+		if r.Accept() {
+			count++
+		}
+	}
+	return count
+}
+
+// RejectAllRevisions rejects all the tracked changes of the document body listed by
+// Revisions, and returns their number.
+func (rd *RootDoc) RejectAllRevisions() int {
+	count := 0
+	for _, r := range rd.Revisions() {
+		if r.Reject() {
+			count++
+		}
+	}
+	return count
+}
+
+// Accept accepts the change, as in Word: inserted runs become regular runs, deleted runs are
+// removed, the previous formatting of a formatting change is dropped, an inserted paragraph
+// mark is kept and a deleted one is removed, joining the paragraph to the next one.
+//
+// Returns false if the change is no longer part of the document, e.g. if it was already
+// accepted or rejected.
+func (r *Revision) Accept() bool {
+	return r.resolve(true)
+}
+
+// Reject rejects the change, as in Word: inserted runs are removed, deleted runs are restored,
+// the previous formatting of a formatting change is restored, an inserted paragraph mark is
+// removed, joining the paragraph to the next one, and a deleted one is kept.
+//
+// Returns false if the change is no longer part of the document, e.g. if it was already
+// accepted or rejected.
+func (r *Revision) Reject() bool {
+	return r.resolve(false)
+}
+
+// resolve accepts or rejects the change.
+func (r *Revision) resolve(accept bool) bool {
+	switch r.Kind {
+	case RevisionInsertion, RevisionDeletion:
+		return r.resolveRuns(accept == (r.Kind == RevisionInsertion))
+	case RevisionRunFormat:
+		prop := r.run.Property
+		if prop == nil || prop.RPrChange == nil {
+			return false
+		}
+		if accept {
+			prop.RPrChange = nil
+			return true
+		}
+		r.run.Property = prop.RPrChange.RunProp
+		return true
+	case RevisionParagraphFormat:
+		// The properties are changed in place, as the paragraph may have been joined to another one
+		prop := r.para.Property
+		if prop == nil || prop.PPrChange == nil {
+			return false
+		}
+		if accept {
+			prop.PPrChange = nil
+			return true
+		}
+		prev := &ctypes.ParagraphProp{}
+		if prop.PPrChange.ParaProp != nil {
+			prev = prop.PPrChange.ParaProp
+		}
+		mark, sectPr := prop.RunProperty, prop.SectPr
+		*prop = *prev
+		prop.RunProperty, prop.SectPr, prop.PPrChange = mark, sectPr, nil
+		return true
+	case RevisionParagraphInsertion, RevisionParagraphDeletion:
+		if r.para.Property == nil || r.para.Property.RunProperty == nil {
+			return false
+		}
+		mark := r.para.Property.RunProperty
+		inserted := r.Kind == RevisionParagraphInsertion
+		if (inserted && mark.Ins == nil) || (!inserted && mark.Del == nil) {
+			return false
+		}
+		mark.Ins, mark.Del = nil, nil
+		if accept != inserted {
+			r.root.joinNextParagraph(r.para.Property)
+		}
+		return true
+	}
+	return false
+}
+
+// resolveRuns resolves an insertion or a deletion of runs, keeping the runs as regular runs
+// or removing them.
+func (r *Revision) resolveRuns(keep bool) bool {
+	found := false
+	r.root.walkParagraphs(func(p *ctypes.Paragraph) {
+		if found {
+			return
+		}
+		for i, child := range p.Children {
+			if child.Ins != r.change && child.Del != r.change {
+				continue
+			}
+			found = true
+
+			var runs []ctypes.ParagraphChild
+			if keep {
+				for _, run := range r.change.Runs {
+					restoreDeletedRun(run)
+					runs = append(runs, ctypes.ParagraphChild{Run: run})
+				}
+			}
+			children := append([]ctypes.ParagraphChild{}, p.Children[:i]...)
+			children = append(children, runs...)
+			p.Children = append(children, p.Children[i+1:]...)
+			return
+		}
+	})
+	return found
+}
+
+// newRevision returns a revision of the paragraph.
+func (rd *RootDoc) newRevision(kind RevisionKind, p *ctypes.Paragraph, id int, author string, date *string, text string) *Revision {
+	r := &Revision{root: rd, Kind: kind, ID: id, Author: author, Text: text, para: p}
+	if date != nil {
+		r.Date, _ = time.Parse(time.RFC3339, *date)
+	}
+	return r
+}
+
+// runFormatRevision returns the formatting change of the run of the paragraph, or nil if it
+// has none.
+func (rd *RootDoc) runFormatRevision(p *ctypes.Paragraph, run *ctypes.Run) *Revision {
+	if run.Property == nil || run.Property.RPrChange == nil {
+		return nil
+	}
+	change := run.Property.RPrChange
+
+	var sb strings.Builder
+	writeRunText(&sb, run)
+	r := rd.newRevision(RevisionRunFormat, p, change.ID, change.Author, change.Date, sb.String())
+	r.run = run
+	return r
+}
+
+// restoreDeletedRun turns the deleted text and field instructions of the run back into text
+// and field instructions.
+func restoreDeletedRun(run *ctypes.Run) {
+	for i, child := range run.Children {
+		if child.DelText != nil {
+			run.Children[i] = ctypes.RunChild{Text: child.DelText}
+		}
+		if child.DelInstrText != nil {
+			run.Children[i] = ctypes.RunChild{InstrText: child.DelInstrText}
+		}
+	}
+}
+
+// paragraphText returns the text of the paragraph, as Paragraph.Text.
+func paragraphText(p *ctypes.Paragraph) string {
+	var sb strings.Builder
+	writeParagraphChildrenText(&sb, p.Children)
+	return sb.String()
+}
+
+// joinNextParagraph removes the paragraph mark of the paragraph with the given properties,
+// joining the paragraph to the next one in the body or the table cell: the content of the next
+// paragraph is appended to the paragraph, which takes the properties of the next one. Nothing
+// is done if the paragraph is followed by a table or is the last of its container.
+func (rd *RootDoc) joinNextParagraph(prop *ctypes.ParagraphProp) {
+	if rd.Document == nil || rd.Document.Body == nil {
+		return
+	}
+
+	children := rd.Document.Body.Children
+	for i, child := range children {
+		if child.Para != nil && child.Para.ct.Property == prop {
+			if i+1 < len(children) && children[i+1].Para != nil {
+				joinParagraphs(&child.Para.ct, &children[i+1].Para.ct)
+				rd.Document.Body.Children = append(children[:i+1], children[i+2:]...)
+			}
+			return
+		}
+		if child.Table != nil && joinNextTableParagraph(&child.Table.ct, prop) {
+			return
+		}
+	}
+}
+
+// joinNextTableParagraph joins the paragraph with the given properties of a cell of the table
+// or of its nested tables to the next one, and reports whether the paragraph was found.
+func joinNextTableParagraph(tbl *ctypes.Table, prop *ctypes.ParagraphProp) bool {
+	for _, rowContent := range tbl.RowContents {
+		if rowContent.Row == nil {
+			continue
+		}
+		for _, cellContent := range rowContent.Row.Contents {
+			if cellContent.Cell == nil {
+				continue
+			}
+
+			cell := cellContent.Cell
+			for i, block := range cell.Contents {
+				if block.Paragraph != nil && block.Paragraph.Property == prop {
+					if i+1 < len(cell.Contents) && cell.Contents[i+1].Paragraph != nil {
+						joinParagraphs(block.Paragraph, cell.Contents[i+1].Paragraph)
+						cell.Contents = append(cell.Contents[:i+1], cell.Contents[i+2:]...)
+					}
+					return true
+				}
+				if block.Table != nil && joinNextTableParagraph(block.Table, prop) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// joinParagraphs appends the content of next to p, which takes the properties of next, as
+// when the paragraph mark of p is removed in Word.
+func joinParagraphs(p, next *ctypes.Paragraph) {
+	p.Children = append(p.Children, next.Children...)
+	p.Property = next.Property
+}
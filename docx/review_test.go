@@ -0,0 +1,107 @@
+package docx
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// revisionsXML is a document body with one revision of each kind.
+const revisionsXML = `<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:body>` +
+	`<w:p><w:pPr><w:jc w:val="center"/><w:pPrChange w:id="1" w:author="Jane Doe" w:date="2024-03-01T09:30:00Z"><w:pPr><w:jc w:val="left"/></w:pPr></w:pPrChange>` +
+	`<w:rPr><w:del w:id="6" w:author="John Roe"/></w:rPr></w:pPr>` +
+	`<w:r><w:t xml:space="preserve">The meeting is on </w:t></w:r>` +
+	`<w:del w:id="2" w:author="Jane Doe"><w:r><w:delText>Monday</w:delText></w:r></w:del>` +
+	`<w:ins w:id="3" w:author="Jane Doe"><w:r><w:t>Tuesday</w:t></w:r></w:ins>` +
+	`<w:r><w:rPr><w:b/><w:rPrChange w:id="4" w:author="John Roe"><w:rPr><w:i/></w:rPr></w:rPrChange></w:rPr><w:t xml:space="preserve"> at noon</w:t></w:r></w:p>` +
+	`<w:p><w:pPr><w:rPr><w:ins w:id="5" w:author="John Roe"/></w:rPr></w:pPr><w:r><w:t>, in room 4.</w:t></w:r></w:p>` +
+	`<w:p><w:r><w:t>Agenda</w:t></w:r></w:p>` +
+	`</w:body></w:document>`
+
+func loadRevisions(t *testing.T) *RootDoc {
+	t.Helper()
+	rd := setupRootDoc(t)
+	doc, err := LoadDocXml(rd, "word/document.xml", []byte(revisionsXML))
+	require.NoError(t, err)
+	rd.Document = doc
+	return rd
+}
+
+func TestRevisions(t *testing.T) {
+	rd := loadRevisions(t)
+
+	revisions := rd.Revisions()
+	require.Len(t, revisions, 6)
+
+	kinds := make([]RevisionKind, len(revisions))
+	for i, r := range revisions {
+		kinds[i] = r.Kind
+	}
+	assert.Equal(t, []RevisionKind{RevisionParagraphFormat, RevisionDeletion, RevisionInsertion, RevisionRunFormat,
+		RevisionParagraphDeletion, RevisionParagraphInsertion}, kinds)
+
+	assert.Equal(t, 1, revisions[0].ID)
+	assert.Equal(t, "Jane Doe", revisions[0].Author)
+	assert.Equal(t, time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC), revisions[0].Date)
+	assert.Equal(t, "Monday", revisions[1].Text)
+	assert.Equal(t, "Tuesday", revisions[2].Text)
+	assert.Equal(t, " at noon", revisions[3].Text)
+	assert.True(t, revisions[3].Date.IsZero())
+	assert.Equal(t, 5, revisions[5].ID)
+}
+
+func TestAcceptAllRevisions(t *testing.T) {
+	rd := loadRevisions(t)
+
+	assert.Equal(t, 6, rd.AcceptAllRevisions())
+	assert.Empty(t, rd.Revisions())
+
+	children := rd.Document.Body.Children
+	require.Len(t, children, 2, "the deleted paragraph mark joins the first two paragraphs")
+	assert.Equal(t, "The meeting is on Tuesday at noon, in room 4.", children[0].Para.Text())
+
+	out, err := xml.Marshal(children[0].Para.ct.Property)
+	require.NoError(t, err)
+	assert.Equal(t, `<w:pPr><w:rPr></w:rPr></w:pPr>`, string(out), "the joined paragraph takes the properties of the second one")
+	run := children[0].Para.ct.Children[2].Run
+	assert.NotNil(t, run.Property.Bold)
+	assert.Nil(t, run.Property.RPrChange)
+}
+
+func TestRejectAllRevisions(t *testing.T) {
+	rd := loadRevisions(t)
+
+	assert.Equal(t, 6, rd.RejectAllRevisions())
+	assert.Empty(t, rd.Revisions())
+
+	children := rd.Document.Body.Children
+	require.Len(t, children, 2, "the inserted paragraph mark joins the last two paragraphs")
+	first := children[0].Para
+	assert.Equal(t, "The meeting is on Monday at noon", first.Text())
+	assert.Equal(t, ", in room 4.Agenda", children[1].Para.Text())
+
+	out, err := xml.Marshal(first.ct)
+	require.NoError(t, err)
+	assert.Equal(t, `<w:p><w:pPr><w:jc w:val="left"></w:jc><w:rPr></w:rPr></w:pPr>`+
+		`<w:r><w:t xml:space="preserve">The meeting is on </w:t></w:r><w:r><w:t>Monday</w:t></w:r>`+
+		`<w:r><w:rPr><w:i></w:i></w:rPr><w:t xml:space="preserve"> at noon</w:t></w:r></w:p>`, string(out))
+}
+
+func TestRevision_AcceptAndReject(t *testing.T) {
+	rd := setupRootDoc(t)
+	p := rd.AddParagraph("The meeting is on ")
+	p.AddDeletion("Jane Doe", time.Time{}, "Monday")
+	p.AddInsertion("Jane Doe", time.Time{}, "Tuesday")
+
+	revisions := rd.Revisions()
+	require.Len(t, revisions, 2)
+	assert.True(t, revisions[0].Reject())
+	assert.True(t, revisions[1].Accept())
+	assert.False(t, revisions[1].Reject(), "a change is resolved once")
+
+	assert.Equal(t, "The meeting is on MondayTuesday", p.Text())
+	assert.Empty(t, rd.Revisions())
+}
@@ -0,0 +1,343 @@
+package docx
+
+import (
+	"time"
+
+	"github.com/MamaShip/godocx/internal"
+	"github.com/MamaShip/godocx/wml/ctypes"
+)
+
+// revisionMode is the author and date of the changes tracked after BeginTrackedChanges.
+type revisionMode struct {
+	author string
+	date   time.Time
+}
+
+// BeginTrackedChanges starts tracking the changes made through the API as revisions by the
+// given author, which can be reviewed, accepted or rejected in Word, until EndTrackedChanges.
+//
+// While changes are tracked, the runs added to paragraphs with AddText, AddRun or AppendRun
+// are tracked insertions (w:ins), the runs removed with Run.Delete are tracked deletions
+// (w:del), and ReplaceText and ReplaceTextRegex record both. Each change gets a revision ID
+// not used elsewhere in the document. Paragraphs themselves are not tracked: a new
+// paragraph holds inserted text, but its paragraph mark is not marked as inserted.
+//
+// Parameters:
+//   - author: The author of the changes, shown in the Word review pane.
+//   - date: The time of the changes; the zero time leaves them undated.
+//
+// Example:
+//
+//	document.BeginTrackedChanges("Jane Doe", time.Now())
+//	document.ReplaceText("Monday", "Tuesday")
+//	document.AddParagraph("The agenda follows.")
+//	document.EndTrackedChanges()
+func (rd *RootDoc) BeginTrackedChanges(author string, date time.Time) {
+	rd.tracking = &revisionMode{author: author, date: date}
+}
+
+// EndTrackedChanges stops tracking the changes started with BeginTrackedChanges. The changes
+// made since are kept as revisions.
+func (rd *RootDoc) EndTrackedChanges() {
+	rd.tracking = nil
+}
+
+// TrackingChanges reports whether the changes made through the API are tracked, see
+// BeginTrackedChanges.
+func (rd *RootDoc) TrackingChanges() bool {
+	return rd.tracking != nil
+}
+
+// AddInsertion adds the text to the paragraph as a tracked insertion (w:ins) by the given author,
+// which can be accepted or rejected in Word.
+//
+// Parameters:
+//   - author: The author of the change, shown in the Word review pane.
+//   - date: The time of the change; the zero time leaves it undated.
+//   - text: The inserted text.
+//
+// Returns:
+//   - *Run: The inserted run, for further formatting.
+//
+// Example:
+//
+//	p := document.AddParagraph("The meeting is on ")
+//	p.AddDeletion("Jane Doe", time.Now(), "Monday")
+//	p.AddInsertion("Jane Doe", time.Now(), "Tuesday")
+func (p *Paragraph) AddInsertion(author string, date time.Time, text string) *Run {
+	run := &ctypes.Run{
+		Children: []ctypes.RunChild{{Text: ctypes.TextFromString(p.applyRunOptions(text))}},
+	}
+
+	p.ct.Children = append(p.ct.Children, ctypes.ParagraphChild{Ins: p.root.newTrackChange(author, date, run)})
+	return newRun(p.root, run)
+}
+
+// AddDeletion adds the text to the paragraph as a tracked deletion (w:del) by the given author,
+// which can be accepted or rejected in Word. The text is written as deleted text (w:delText).
+//
+// See AddInsertion for the parameters.
+func (p *Paragraph) AddDeletion(author string, date time.Time, text string) *Run {
+	run := &ctypes.Run{
+		Children: []ctypes.RunChild{{DelText: ctypes.TextFromString(text)}},
+	}
+
+	p.ct.Children = append(p.ct.Children, ctypes.ParagraphChild{Del: p.root.newTrackChange(author, date, run)})
+	return newRun(p.root, run)
+}
+
+// trackedChange returns a tracked change of the run by the author and at the date of the
+// tracked changes.
+func (rd *RootDoc) trackedChange(run *ctypes.Run) *ctypes.RunTrackChange {
+	return rd.newTrackChange(rd.tracking.author, rd.tracking.date, run)
+}
+
+func (rd *RootDoc) newTrackChange(author string, date time.Time, run *ctypes.Run) *ctypes.RunTrackChange {
+	change := &ctypes.RunTrackChange{
+		ID:     rd.nextRevisionID(),
+		Author: author,
+		Runs:   []*ctypes.Run{run},
+	}
+	if !date.IsZero() {
+		formatted := FormatCoreTime(date)
+		change.Date = &formatted
+	}
+	return change
+}
+
+// nextRevisionID returns a revision ID not used anywhere else in the document.
+// On first use the IDs of existing revisions, e.g. from a loaded document, are taken into account,
+// including those of formatting changes and of inserted or deleted paragraph marks.
+func (rd *RootDoc) nextRevisionID() int {
+	if !rd.revisionIDInit {
+		rd.revisionIDInit = true
+		for _, revision := range rd.Revisions() {
+			if revision.ID >= rd.revisionID {
+				rd.revisionID = revision.ID + 1
+			}
+		}
+	}
+
+	id := rd.revisionID
+	rd.revisionID++
+	return id
+}
+
+// Delete removes the run from its paragraph and reports whether the run was found in a
+// paragraph of the document body.
+//
+// While changes are tracked, see RootDoc.BeginTrackedChanges, the run is kept as a tracked
+// deletion (w:del) instead, with its text turned into deleted text, unless it is itself a
+// tracked insertion, which is removed as in Word. Only runs directly in a paragraph or in a
+// tracked insertion can be deleted, not the runs of hyperlinks or content controls.
+//
+// Example:
+//
+//	document.BeginTrackedChanges("Jane Doe", time.Now())
+//	run.Delete()
+func (r *Run) Delete() bool {
+	p, idx := r.root.findRun(r.ct)
+	if p == nil {
+		return false
+	}
+
+	child := p.Children[idx]
+	switch {
+	case child.Run == r.ct:
+		if r.root.tracking == nil {
+			p.Children = append(p.Children[:idx], p.Children[idx+1:]...)
+			return true
+		}
+		markRunDeleted(r.ct)
+		p.Children[idx] = ctypes.ParagraphChild{Del: r.root.trackedChange(r.ct)}
+		return true
+	case child.Ins != nil:
+		for i, run := range child.Ins.Runs {
+			if run == r.ct {
+				child.Ins.Runs = append(child.Ins.Runs[:i], child.Ins.Runs[i+1:]...)
+				break
+			}
+		}
+		if len(child.Ins.Runs) == 0 {
+			p.Children = append(p.Children[:idx], p.Children[idx+1:]...)
+		}
+		return true
+	}
+	return false
+}
+
+// markRunDeleted turns the text and field instructions of the run into deleted text and
+// deleted field instructions, as required in a tracked deletion.
+func markRunDeleted(run *ctypes.Run) {
+	for i, child := range run.Children {
+		if child.Text != nil {
+			run.Children[i] = ctypes.RunChild{DelText: child.Text}
+		}
+		if child.InstrText != nil {
+			run.Children[i] = ctypes.RunChild{DelInstrText: child.InstrText}
+		}
+	}
+}
+
+// trackedCut is a part of a text element replaced as a tracked change.
+type trackedCut struct {
+	start, end int                 // byte offsets of the deleted text in the element
+	insert     bool                // whether the replacement is inserted after the deleted text
+	repl       string              // replacement text
+	prop       *ctypes.RunProperty // formatting of the replacement, from the run where the match starts
+}
+
+// replaceTracked replaces the matches found by find in the runs of the paragraph as tracked
+// changes: the matched text is kept as a deletion, followed by the replacement as an
+// insertion. The runs holding matches are split around them.
+func (rd *RootDoc) replaceTracked(p *ctypes.Paragraph, find func(text string) []textMatch) int {
+	type segment struct {
+		run  *ctypes.Run
+		text *ctypes.Text
+	}
+
+	// Groups of adjacent text elements of the runs, as in paragraphTextGroups
+	var groups [][]segment
+	var cur []segment
+	flush := func() {
+		if len(cur) > 0 {
+			groups = append(groups, cur)
+			cur = nil
+		}
+	}
+	for _, child := range p.Children {
+		if child.Run == nil {
+			flush()
+			continue
+		}
+		for _, runChild := range child.Run.Children {
+			if runChild.Text == nil {
+				flush()
+				continue
+			}
+			cur = append(cur, segment{run: child.Run, text: runChild.Text})
+		}
+	}
+	flush()
+
+	count := 0
+	cuts := map[*ctypes.Text][]trackedCut{}
+	for _, group := range groups {
+		offsets := make([]int, len(group)+1)
+		text := ""
+		for i, seg := range group {
+			offsets[i] = len(text)
+			text += seg.text.Text
+		}
+		offsets[len(group)] = len(text)
+
+		matches := find(text)
+		count += len(matches)
+		for _, match := range matches {
+			first := segmentAt(offsets, match.start)
+			last := first
+			if match.end > match.start {
+				last = segmentAt(offsets, match.end-1)
+			}
+
+			for i := first; i <= last; i++ {
+				start, end := match.start, match.end
+				if start < offsets[i] {
+					start = offsets[i]
+				}
+				if end > offsets[i+1] {
+					end = offsets[i+1]
+				}
+				cuts[group[i].text] = append(cuts[group[i].text], trackedCut{
+					start:  start - offsets[i],
+					end:    end - offsets[i],
+					insert: i == last && match.repl != "",
+					repl:   match.repl,
+					prop:   group[first].run.Property,
+				})
+			}
+		}
+	}
+	if len(cuts) == 0 {
+		return count
+	}
+
+	children := make([]ctypes.ParagraphChild, 0, len(p.Children))
+	for _, child := range p.Children {
+		if child.Run == nil {
+			children = append(children, child)
+			continue
+		}
+		children = append(children, rd.splitTrackedRun(child.Run, cuts)...)
+	}
+	p.Children = children
+	return count
+}
+
+// splitTrackedRun returns the paragraph children replacing the run once the cuts of its text
+// elements are applied: the run itself if it has none, otherwise the pieces of the run around
+// the tracked deletions and insertions.
+func (rd *RootDoc) splitTrackedRun(run *ctypes.Run, cuts map[*ctypes.Text][]trackedCut) []ctypes.ParagraphChild {
+	hasCuts := false
+	for _, child := range run.Children {
+		if child.Text != nil && len(cuts[child.Text]) > 0 {
+			hasCuts = true
+			break
+		}
+	}
+	if !hasCuts {
+		return []ctypes.ParagraphChild{{Run: run}}
+	}
+
+	var (
+		out   []ctypes.ParagraphChild
+		piece *ctypes.Run
+	)
+	keep := func(child ctypes.RunChild) {
+		if piece == nil {
+			clone := *run
+			clone.Property = internal.DeepCopy(run.Property)
+			clone.Children = nil
+			piece = &clone
+			out = append(out, ctypes.ParagraphChild{Run: piece})
+		}
+		piece.Children = append(piece.Children, child)
+	}
+
+	for _, child := range run.Children {
+		if child.Text == nil || len(cuts[child.Text]) == 0 {
+			keep(child)
+			continue
+		}
+
+		text, pos := child.Text.Text, 0
+		for _, cut := range cuts[child.Text] {
+			if cut.start > pos {
+				keep(ctypes.RunChild{Text: ctypes.TextFromString(text[pos:cut.start])})
+			}
+			if cut.end > cut.start {
+				piece = nil
+				deleted := &ctypes.Run{
+					Property: internal.DeepCopy(run.Property),
+					Children: []ctypes.RunChild{{DelText: ctypes.TextFromString(text[cut.start:cut.end])}},
+				}
+				out = append(out, ctypes.ParagraphChild{Del: rd.trackedChange(deleted)})
+			}
+			if cut.insert {
+				piece = nil
+				inserted := &ctypes.Run{
+					Property: internal.DeepCopy(cut.prop),
+					Children: []ctypes.RunChild{{Text: ctypes.TextFromString(cut.repl)}},
+				}
+				out = append(out, ctypes.ParagraphChild{Ins: rd.trackedChange(inserted)})
+			}
+			if cut.end > pos {
+				pos = cut.end
+			}
+		}
+		if pos < len(text) {
+			keep(ctypes.RunChild{Text: ctypes.TextFromString(text[pos:])})
+		}
+	}
+	return out
+}
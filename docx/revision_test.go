@@ -0,0 +1,127 @@
+package docx
+
+import (
+	"encoding/xml"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/MamaShip/godocx/wml/ctypes"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddInsertionAndDeletion(t *testing.T) {
+	rd := setupRootDoc(t)
+	date := time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC)
+
+	p := rd.AddParagraph("The meeting is on ")
+	p.AddDeletion("Jane Doe", date, "Monday")
+	p.AddInsertion("Jane Doe", date, "Tuesday").Bold(true)
+	p.AddInsertion("John Roe", time.Time{}, " at noon")
+
+	out, err := xml.Marshal(p.ct)
+	require.NoError(t, err)
+	assert.Contains(t, string(out), `<w:del w:id="0" w:author="Jane Doe" w:date="2024-03-01T09:30:00Z"><w:r><w:delText>Monday</w:delText></w:r></w:del>`)
+	assert.Contains(t, string(out), `<w:ins w:id="1" w:author="Jane Doe" w:date="2024-03-01T09:30:00Z"><w:r><w:rPr><w:b w:val="true"></w:b></w:rPr><w:t>Tuesday</w:t></w:r></w:ins>`)
+	assert.Contains(t, string(out), `<w:ins w:id="2" w:author="John Roe"><w:r><w:t xml:space="preserve"> at noon</w:t></w:r></w:ins>`)
+
+	assert.Equal(t, "The meeting is on Tuesday at noon", p.Text())
+}
+
+func TestRevisionIDsUniqueAfterLoad(t *testing.T) {
+	rd := setupRootDoc(t)
+	p := rd.AddParagraph("")
+	p.AddDeletion("Jane Doe", time.Time{}, "old")
+	p.AddInsertion("Jane Doe", time.Time{}, "new")
+
+	out, err := xml.Marshal(rd.Document)
+	require.NoError(t, err)
+
+	loaded := NewRootDoc()
+	doc, err := LoadDocXml(loaded, "word/document.xml", out)
+	require.NoError(t, err)
+	loaded.Document = doc
+
+	children := doc.Body.Children[0].Para.ct.Children
+	require.Len(t, children, 3)
+	assert.Equal(t, "old", children[1].Del.Runs[0].Children[0].DelText.Text)
+	assert.Equal(t, 1, children[2].Ins.ID)
+
+	next := loaded.AddParagraph("").AddInsertion("John Roe", time.Time{}, "more")
+	assert.NotNil(t, next)
+	assert.Equal(t, 2, loaded.Document.Body.Children[1].Para.ct.Children[1].Ins.ID)
+}
+
+func TestRevisionIDsUniqueAfterLoad_AllKinds(t *testing.T) {
+	// The highest IDs are those of a formatting change and of a deleted paragraph mark
+	rd := loadRevisions(t)
+
+	ins := rd.AddParagraph("").AddInsertion("Jane Doe", time.Time{}, "more")
+	require.NotNil(t, ins)
+	assert.Equal(t, 7, rd.Document.Body.Children[3].Para.ct.Children[1].Ins.ID)
+}
+
+func TestBeginTrackedChanges(t *testing.T) {
+	rd := setupRootDoc(t)
+	date := time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC)
+
+	p := rd.AddParagraph("Draft")
+	old := p.AddText(" old")
+	kept := p.AddText(" kept")
+
+	rd.BeginTrackedChanges("Jane Doe", date)
+	assert.True(t, rd.TrackingChanges())
+	added := p.AddText(" new")
+	second := rd.AddParagraph("Second")
+	assert.True(t, old.Delete())
+	assert.True(t, added.Delete(), "an insertion is removed rather than deleted")
+	p.AddText(" final")
+	rd.EndTrackedChanges()
+
+	assert.False(t, rd.TrackingChanges())
+	assert.True(t, kept.Delete())
+	p.AddText("!")
+	assert.False(t, newRun(rd, &ctypes.Run{}).Delete())
+
+	out, err := xml.Marshal(p.ct)
+	require.NoError(t, err)
+	assert.Equal(t, `<w:p><w:r><w:t>Draft</w:t></w:r>`+
+		`<w:del w:id="2" w:author="Jane Doe" w:date="2024-03-01T09:30:00Z"><w:r><w:delText xml:space="preserve"> old</w:delText></w:r></w:del>`+
+		`<w:ins w:id="3" w:author="Jane Doe" w:date="2024-03-01T09:30:00Z"><w:r><w:t xml:space="preserve"> final</w:t></w:r></w:ins>`+
+		`<w:r><w:t>!</w:t></w:r></w:p>`, string(out))
+
+	require.NotNil(t, second.ct.Children[0].Ins)
+	assert.Equal(t, 1, second.ct.Children[0].Ins.ID)
+	assert.Equal(t, "Draft final!", p.Text())
+}
+
+func TestReplaceText_Tracked(t *testing.T) {
+	rd := setupRootDoc(t)
+	p := rd.AddParagraph("The meeting is on Mon")
+	p.AddText("day").Bold(true)
+	p.AddText(" at noon, Monday again")
+	link := rd.AddParagraph("").AddHyperlink("Monday", "https://example.com")
+
+	rd.BeginTrackedChanges("Jane Doe", time.Time{})
+	assert.Equal(t, 2, rd.ReplaceText("Monday", "Tuesday"))
+	assert.Equal(t, 1, rd.ReplaceTextRegex(regexp.MustCompile(`at (\w+)`), "by $1"))
+	rd.EndTrackedChanges()
+
+	out, err := xml.Marshal(p.ct)
+	require.NoError(t, err)
+	assert.Equal(t, `<w:p><w:r><w:t xml:space="preserve">The meeting is on </w:t></w:r>`+
+		`<w:del w:id="0" w:author="Jane Doe"><w:r><w:delText>Mon</w:delText></w:r></w:del>`+
+		`<w:del w:id="1" w:author="Jane Doe"><w:r><w:rPr><w:b w:val="true"></w:b></w:rPr><w:delText>day</w:delText></w:r></w:del>`+
+		`<w:ins w:id="2" w:author="Jane Doe"><w:r><w:t>Tuesday</w:t></w:r></w:ins>`+
+		`<w:r><w:t xml:space="preserve"> </w:t></w:r>`+
+		`<w:del w:id="5" w:author="Jane Doe"><w:r><w:delText>at noon</w:delText></w:r></w:del>`+
+		`<w:ins w:id="6" w:author="Jane Doe"><w:r><w:t>by noon</w:t></w:r></w:ins>`+
+		`<w:r><w:t xml:space="preserve">, </w:t></w:r>`+
+		`<w:del w:id="3" w:author="Jane Doe"><w:r><w:delText>Monday</w:delText></w:r></w:del>`+
+		`<w:ins w:id="4" w:author="Jane Doe"><w:r><w:t>Tuesday</w:t></w:r></w:ins>`+
+		`<w:r><w:t xml:space="preserve"> again</w:t></w:r></w:p>`, string(out))
+	assert.Equal(t, "The meeting is on Tuesday by noon, Tuesday again", p.Text())
+	assert.Equal(t, "Monday", link.ct.Children[0].Text.Text, "hyperlinks are left unchanged")
+}
@@ -21,6 +21,31 @@ type RootDoc struct {
 
 	rID        int // rId is used to generate unique relationship IDs.
 	ImageCount uint
+
+	qrGenerator   QRCodeGenerator // qrGenerator renders QR codes for AddQRCode.
+	shareLinkRels bool            // shareLinkRels makes hyperlinks to the same URL share one relationship.
+
+	bookmarkID     int  // bookmarkID is the next free bookmark ID.
+	bookmarkIDInit bool // bookmarkIDInit is set once existing bookmark IDs have been scanned.
+
+	sdtID     int  // sdtID is the next free content control ID.
+	sdtIDInit bool // sdtIDInit is set once existing content control IDs have been scanned.
+
+	revisionID     int  // revisionID is the next free ID of tracked insertions and deletions.
+	revisionIDInit bool // revisionIDInit is set once existing revision IDs have been scanned.
+
+	tracking *revisionMode // tracking is the author and date of tracked changes, nil when changes are not tracked.
+
+	runOpts     RunOptions     // runOpts controls how text passed to AddText is processed.
+	headingOpts HeadingOptions // headingOpts controls how headings added with AddHeading are formatted.
+
+	headerFooters map[string]*headerFooter // headerFooters holds the header and footer parts by path.
+	comments      *commentsPart            // comments holds the comments part once loaded or created.
+	footnotes     *notesPart               // footnotes holds the footnotes part once loaded or created.
+	endnotes      *notesPart               // endnotes holds the endnotes part once loaded or created.
+
+	logger Logger // logger receives diagnostic messages while parsing.
+	strict bool   // strict makes writing fail if the document does not validate.
 }
 
 // NewRootDoc creates a new instance of the RootDoc structure.
@@ -44,7 +69,9 @@ func LoadDocXml(rd *RootDoc, fileName string, fileBytes []byte) (*Document, erro
 	doc := Document{
 		Root: rd,
 	}
-	err := xml.Unmarshal(fileBytes, &doc)
+	d, release := rd.newDecoder(fileBytes)
+	defer release()
+	err := d.Decode(&doc)
 	if err != nil {
 		return nil, err
 	}
@@ -81,3 +108,10 @@ func LoadStyles(fileName string, fileBytes []byte) (*ctypes.Styles, error) {
 func (root *RootDoc) NewListInstance(abstractNumId int) int {
 	return root.Numbering.NewListInstance(abstractNumId)
 }
+
+// nextDrawingID returns a new unique ID for drawing objects (wp:docPr and shape cNvPr).
+// Pictures use the image count as their drawing ID, so the same counter is shared.
+func (rd *RootDoc) nextDrawingID() uint {
+	rd.ImageCount += 1
+	return rd.ImageCount
+}
@@ -1,6 +1,7 @@
 package docx
 
 import (
+	"github.com/MamaShip/godocx/internal"
 	"github.com/MamaShip/godocx/wml/ctypes"
 	"github.com/MamaShip/godocx/wml/stypes"
 )
@@ -14,6 +15,12 @@ func newRun(root *RootDoc, ct *ctypes.Run) *Run {
 	return &Run{root: root, ct: ct}
 }
 
+// Clone returns a deep copy of the run, with its properties and content, that is not part
+// of any paragraph; add it with Paragraph.AppendRun. Changing the copy does not affect the original.
+func (r *Run) Clone() *Run {
+	return newRun(r.root, internal.DeepCopy(r.ct))
+}
+
 // getProp returns the run properties. If not initialized, it creates and returns a new instance.
 func (r *Run) getProp() *ctypes.RunProperty {
 	if r.ct.Property == nil {
@@ -56,6 +63,16 @@ func (r *Run) Size(size uint64) *Run {
 	return r
 }
 
+// SizeHalfPoints sets the font size of the run in half-points, for sizes such as 10.5pt (21)
+// that Size cannot express.
+//
+// Returns:
+//   - *Run: The modified Run instance with the updated size.
+func (r *Run) SizeHalfPoints(halfPoints uint64) *Run {
+	r.getProp().Size = ctypes.NewFontSize(halfPoints)
+	return r
+}
+
 // Font sets the font for the run.
 func (r *Run) Font(font string) *Run {
 	if r.getProp().Fonts == nil {
@@ -69,19 +86,48 @@ func (r *Run) Font(font string) *Run {
 	return r
 }
 
-// Shading sets the shading properties (type, color, fill) for the run
+// ComplexScriptFont sets the font of the complex script text of the run, such as Arabic or
+// Hebrew text, leaving the fonts of other scripts unchanged.
+func (r *Run) ComplexScriptFont(font string) *Run {
+	if r.getProp().Fonts == nil {
+		r.getProp().Fonts = &ctypes.RunFonts{}
+	}
+
+	r.getProp().Fonts.CS = font
+	r.getProp().Fonts.CSTheme = ""
+	return r
+}
+
+// ComplexScriptSize sets the font size in points of the complex script text of the run,
+// such as Arabic or Hebrew text (w:szCs). Size only applies to the other scripts.
+func (r *Run) ComplexScriptSize(size uint64) *Run {
+	r.getProp().SizeCs = ctypes.NewFontSizeCS(size * 2)
+	return r
+}
+
+// RTL sets whether the text of the run is right to left text (w:rtl), e.g. Arabic or Hebrew
+// text. It is then displayed from right to left and formatted with the complex script
+// properties of the run, see ComplexScriptFont and ComplexScriptSize.
+func (r *Run) RTL(value bool) *Run {
+	r.getProp().RightToLeft = ctypes.OnOffFromBool(value)
+	return r
+}
+
+// Shading sets the shading properties (type, color, fill) for the run.
+// The color and fill are hex values or "auto"; see Paragraph.Shading to shade a whole paragraph.
 func (r *Run) Shading(shdType stypes.Shading, color, fill string) *Run {
 	r.getProp().Shading = ctypes.NewShading().SetShadingType(shdType).SetColor(color).SetFill(fill)
 	return r
 }
 
-// AddHighlight sets the highlight color for the run.
-func (r *Run) Highlight(color string) *Run {
-	r.getProp().Highlight = ctypes.NewCTString(color)
+// Highlight sets the highlight color for the run, e.g. stypes.HighlightYellow.
+// stypes.HighlightNone removes a highlight inherited from the style.
+func (r *Run) Highlight(color stypes.HighlightColor) *Run {
+	r.getProp().Highlight = ctypes.NewCTString(string(color))
 	return r
 }
 
-// AddBold enables bold formatting for the run.
+// Bold enables or disables bold formatting for the run.
 func (r *Run) Bold(value bool) *Run {
 	r.getProp().Bold = ctypes.OnOffFromBool(value)
 	return r
@@ -172,27 +218,80 @@ func (r *Run) Underline(value stypes.Underline) *Run {
 	return r
 }
 
-// Add a break element of `stypes.BreakType` to this run.
-func (r *Run) AddBreak(breakType *stypes.BreakType) {
-	// clear := stypes.BreakClearNone
-	// switch breakType{
-	// case stypes.BreakType:
+// AddTab adds a tab character to the end of the run, moving the following text to the next tab stop.
+func (r *Run) AddTab() *Run {
+	r.ct.Children = append(r.ct.Children, ctypes.RunChild{Tab: &ctypes.Empty{}})
+	return r
+}
 
-	// }
+// AddBreak adds a break element (w:br) of the given type to the end of the run:
+//
+//	nil                          line break, the following text starts on the next line
+//	stypes.BreakTypeTextWrapping line break, see AddTextWrappingBreak to set where the text restarts
+//	stypes.BreakTypePage         page break, the following text starts on the next page
+//	stypes.BreakTypeColumn       column break, see AddColumnBreak
+//
+// Any other type is not valid in a document and is replaced by a line break; the replacement
+// is reported to the logger set with RootDoc.SetLogger.
+//
+// Returns:
+//   - *Run: The run instance for method chaining.
+func (r *Run) AddBreak(breakType *stypes.BreakType) *Run {
 	br := ctypes.Break{}
 
 	if breakType != nil {
-		br.BreakType = breakType
+		if valid, err := stypes.BreakTypeFromStr(string(*breakType)); err == nil {
+			br.BreakType = internal.ToPtr(valid)
+		} else {
+			r.root.LogDebug("replaced invalid break type by a line break", "type", string(*breakType))
+		}
 	}
 
 	r.ct.Children = append(r.ct.Children, ctypes.RunChild{
 		Break: &br,
 	})
+	return r
 }
 
-// Style sets the style of the run.
+// AddColumnBreak adds a column break to the end of the run, moving the following text to the
+// next column of the section, or to the next page if the section has a single column.
+func (r *Run) AddColumnBreak() *Run {
+	return r.AddBreak(internal.ToPtr(stypes.BreakTypeColumn))
+}
+
+// AddTextWrappingBreak adds a line break to the end of the run, with the location where the
+// following text restarts when the line is next to a floating object such as an image:
+//   - stypes.BreakClearNone: on the next line;
+//   - stypes.BreakClearLeft, stypes.BreakClearRight: on the next line whose left, respectively
+//     right, side is clear of floating objects;
+//   - stypes.BreakClearAll: on the next line clear of floating objects on both sides.
+//
+// Any other value is not valid in a document and is omitted, which is the same as stypes.BreakClearNone.
+func (r *Run) AddTextWrappingBreak(clear stypes.BreakClear) *Run {
+	br := ctypes.Break{BreakType: internal.ToPtr(stypes.BreakTypeTextWrapping)}
+
+	if valid, err := stypes.BreakClearFromStr(string(clear)); err == nil {
+		br.Clear = internal.ToPtr(valid)
+	} else {
+		r.root.LogDebug("omitted invalid break clear value", "clear", string(clear))
+	}
+
+	r.ct.Children = append(r.ct.Children, ctypes.RunChild{
+		Break: &br,
+	})
+	return r
+}
+
+// Style sets the character style of the run.
+//
+// If value is the ID of a built-in character style ("Strong", "Emphasis" or "Hyperlink") and
+// the styles part of the document lacks it, a minimal definition of the style is added.
+// Other styles can be defined with RootDoc.AddCharacterStyle.
 func (r *Run) Style(value string) *Run {
 	r.getProp().Style = ctypes.NewRunStyle(value)
+	if r.root != nil {
+		r.root.ensureStyle(value, stypes.StyleTypeCharacter)
+	}
 	return r
 }
 
@@ -207,3 +306,15 @@ func (r *Run) VerticalAlign(value stypes.VerticalAlignRun) *Run {
 	r.getProp().VertAlign = ctypes.NewGenSingleStrVal(value)
 	return r
 }
+
+// Superscript raises the run text above the baseline in a smaller size, e.g. for exponents
+// and note markers. It is the same as VerticalAlign(stypes.VerticalAlignRunSuperscript).
+func (r *Run) Superscript() *Run {
+	return r.VerticalAlign(stypes.VerticalAlignRunSuperscript)
+}
+
+// Subscript lowers the run text below the baseline in a smaller size, e.g. for chemical
+// formulas. It is the same as VerticalAlign(stypes.VerticalAlignRunSubscript).
+func (r *Run) Subscript() *Run {
+	return r.VerticalAlign(stypes.VerticalAlignRunSubscript)
+}
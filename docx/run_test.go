@@ -0,0 +1,152 @@
+package docx
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/MamaShip/godocx/internal"
+	"github.com/MamaShip/godocx/wml/stypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunFormattingChain(t *testing.T) {
+	rd := setupRootDoc(t)
+	p := rd.AddEmptyParagraph()
+
+	p.AddText("Warning").
+		Bold(true).
+		Italic(true).
+		Underline(stypes.UnderlineDouble).
+		Color("FF0000").
+		SizeHalfPoints(21).
+		Font("Georgia").
+		Highlight(stypes.HighlightYellow)
+
+	out, err := xml.Marshal(p.ct)
+	require.NoError(t, err)
+	xmlStr := string(out)
+
+	assert.Contains(t, xmlStr, `<w:b w:val="true"></w:b>`)
+	assert.Contains(t, xmlStr, `<w:i w:val="true"></w:i>`)
+	assert.Contains(t, xmlStr, `<w:color w:val="FF0000"></w:color>`)
+	assert.Contains(t, xmlStr, `<w:sz w:val="21"></w:sz>`)
+	assert.Contains(t, xmlStr, `w:ascii="Georgia"`)
+	assert.Contains(t, xmlStr, `<w:highlight w:val="yellow"></w:highlight>`)
+	assert.Contains(t, xmlStr, `<w:u w:val="double"></w:u>`)
+	assert.Contains(t, xmlStr, `<w:t>Warning</w:t>`)
+}
+
+func TestRunSize(t *testing.T) {
+	rd := setupRootDoc(t)
+	run := rd.AddEmptyParagraph().AddRun()
+
+	assert.Nil(t, run.ct.Property)
+	run.Size(12)
+	assert.Equal(t, uint64(24), run.ct.Property.Size.Value)
+}
+
+func TestRunClone(t *testing.T) {
+	rd := setupRootDoc(t)
+	p := rd.AddEmptyParagraph()
+	run := p.AddText("Total").Bold(true).Font("Arial").Size(12)
+
+	clone := run.Clone()
+	require.Equal(t, run.ct, clone.ct)
+
+	clone.Font("Calibri").Italic(true)
+	clone.ct.Children[0].Text.Text = "Subtotal"
+
+	assert.Equal(t, "Arial", run.ct.Property.Fonts.Ascii)
+	assert.Nil(t, run.ct.Property.Italic)
+	assert.Equal(t, "Total", p.Text())
+
+	p.AppendRun(clone)
+	assert.Equal(t, "TotalSubtotal", p.Text())
+}
+
+func TestRunTabsAndBreaks(t *testing.T) {
+	rd := setupRootDoc(t)
+	run := rd.AddEmptyParagraph().AddText("Title").
+		AddTab().
+		AddColumnBreak().
+		AddTextWrappingBreak(stypes.BreakClearAll).
+		AddBreak(nil)
+
+	out, err := xml.Marshal(run.ct)
+	require.NoError(t, err)
+	assert.Equal(t, `<w:r><w:t>Title</w:t><w:tab></w:tab>`+
+		`<w:br w:type="column"></w:br>`+
+		`<w:br w:type="textWrapping" w:clear="all"></w:br>`+
+		`<w:br></w:br></w:r>`, string(out))
+}
+
+func TestRunSuperscriptSubscript(t *testing.T) {
+	rd := setupRootDoc(t)
+	p := rd.AddEmptyParagraph()
+	p.AddText("H")
+	p.AddText("2").Subscript().Bold(true)
+	p.AddText("O")
+	p.AddText("1").Italic(true).Superscript()
+
+	runs := p.GetCT().Children
+	assert.Equal(t, stypes.VerticalAlignRunSubscript, runs[1].Run.Property.VertAlign.Val)
+	assert.Equal(t, stypes.VerticalAlignRunSuperscript, runs[3].Run.Property.VertAlign.Val)
+
+	out, err := xml.Marshal(rd.Document)
+	require.NoError(t, err)
+	assert.Contains(t, string(out), `<w:vertAlign w:val="subscript"></w:vertAlign>`)
+
+	loaded := setupRootDoc(t)
+	doc, err := LoadDocXml(loaded, "word/document.xml", out)
+	require.NoError(t, err)
+	children := doc.Body.Children[0].Para.GetCT().Children
+	assert.Equal(t, stypes.VerticalAlignRunSubscript, children[1].Run.Property.VertAlign.Val)
+	assert.NotNil(t, children[1].Run.Property.Bold)
+	assert.Equal(t, stypes.VerticalAlignRunSuperscript, children[3].Run.Property.VertAlign.Val)
+	assert.NotNil(t, children[3].Run.Property.Italic)
+}
+
+func TestBidirectionalText(t *testing.T) {
+	rd := setupRootDoc(t)
+	p := rd.AddEmptyParagraph().BiDi(true)
+	p.AddText("Arabic: ")
+	p.AddText("مرحبا").RTL(true).ComplexScriptFont("Arial").ComplexScriptSize(14).Font("Calibri")
+	p.AddText(" mixed").Font("Calibri").ComplexScriptFont("Tahoma")
+
+	out, err := xml.Marshal(rd.Document)
+	require.NoError(t, err)
+	assert.Contains(t, string(out), `<w:bidi w:val="true"></w:bidi>`)
+	assert.Contains(t, string(out), `<w:szCs w:val="28"></w:szCs>`)
+	assert.Contains(t, string(out), `<w:rtl w:val="true"></w:rtl>`)
+
+	loaded := setupRootDoc(t)
+	doc, err := LoadDocXml(loaded, "word/document.xml", out)
+	require.NoError(t, err)
+	para := doc.Body.Children[0].Para.GetCT()
+	require.NotNil(t, para.Property.Bidi)
+	assert.Equal(t, stypes.OnOffTrue, *para.Property.Bidi.Val)
+
+	rtl := para.Children[1].Run.Property
+	require.NotNil(t, rtl.RightToLeft)
+	assert.Equal(t, uint64(28), rtl.SizeCs.Value)
+	assert.Equal(t, "Calibri", rtl.Fonts.CS, "Font sets the fonts of all scripts")
+	assert.Nil(t, para.Children[2].Run.Property.RightToLeft)
+	assert.Equal(t, "Tahoma", para.Children[2].Run.Property.Fonts.CS)
+	assert.Equal(t, "Calibri", para.Children[2].Run.Property.Fonts.Ascii)
+
+	p.BiDi(false)
+	assert.Equal(t, stypes.OnOffFalse, *p.GetCT().Property.Bidi.Val)
+}
+
+func TestRunAddBreak_InvalidValues(t *testing.T) {
+	rd := setupRootDoc(t)
+	run := rd.AddEmptyParagraph().AddRun().
+		AddBreak(internal.ToPtr(stypes.BreakTypePage)).
+		AddBreak(internal.ToPtr(stypes.BreakType("section"))).
+		AddTextWrappingBreak(stypes.BreakClear("both"))
+
+	out, err := xml.Marshal(run.ct)
+	require.NoError(t, err)
+	assert.Equal(t, `<w:r><w:br w:type="page"></w:br><w:br></w:br><w:br w:type="textWrapping"></w:br></w:r>`, string(out))
+}
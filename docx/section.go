@@ -0,0 +1,612 @@
+package docx
+
+import (
+	"github.com/MamaShip/godocx/internal"
+	"github.com/MamaShip/godocx/wml/ctypes"
+	"github.com/MamaShip/godocx/wml/stypes"
+)
+
+// Page dimensions Word assumes when a section does not specify them:
+// US Letter (8.5 × 11 inches) with 1 inch margins, in twips.
+const (
+	defaultPageWidth  = 12240
+	defaultPageHeight = 15840
+	defaultPageMargin = 1440
+
+	// defaultHeaderDistance is the distance of the header and footer from the edge of the page.
+	defaultHeaderDistance = 720
+)
+
+// ContentWidth returns the width available for content in the active section, in twips.
+//
+// This is the page width minus the left and right margins of the section that
+// new content is added to. Values missing from the section properties fall back
+// to Word's defaults (US Letter with 1 inch margins).
+//
+// Example:
+//
+//	width := document.ContentWidth() // 9360 for Letter with 1 inch margins
+func (rd *RootDoc) ContentWidth() int {
+	var sectPr *ctypes.SectionProp
+	if rd.Document != nil && rd.Document.Body != nil {
+		sectPr = rd.Document.Body.SectPr
+	}
+	return (&SectionProperties{ct: sectPr}).ContentWidth()
+}
+
+// ensureSectPr returns the section properties of the document body, creating them if needed.
+func (rd *RootDoc) ensureSectPr() *ctypes.SectionProp {
+	if rd.Document.Body.SectPr == nil {
+		rd.Document.Body.SectPr = ctypes.NewSectionProper()
+	}
+	return rd.Document.Body.SectPr
+}
+
+// SectionProperties configures the page layout of one section of the document.
+//
+// The last section of the document is configured through the body-level section
+// properties (w:body/w:sectPr); every earlier section is terminated by a paragraph
+// carrying its properties (w:pPr/w:sectPr). See RootDoc.AddSectionBreak.
+type SectionProperties struct {
+	root *RootDoc // root is nil for snapshots detached from the document.
+	ct   *ctypes.SectionProp
+}
+
+// AddSectionBreak ends the current section and starts a new one.
+//
+// The properties configured so far stay with the section that ends, which is terminated
+// by a new empty paragraph carrying them. The new section becomes the last section of the
+// document and starts with the page size and margins of the previous one, so only the
+// differences need to be set on the returned handle.
+//
+// Parameters:
+//   - sectType: How the new section starts: stypes.SectionMarkNextPage, stypes.SectionMarkNextContinuous,
+//     stypes.SectionMarkEvenPage, stypes.SectionMarkOddPage or stypes.SectionMarkNextColumn.
+//
+// Returns:
+//   - *SectionProperties: The properties of the new section.
+//
+// Example:
+//
+//	document.AddParagraph("Portrait page")
+//	document.AddSectionBreak(stypes.SectionMarkNextPage).SetPageOrientation(stypes.PageOrientLandscape)
+//	document.AddParagraph("Landscape page")
+func (rd *RootDoc) AddSectionBreak(sectType stypes.SectionMark) *SectionProperties {
+	prev := rd.ensureSectPr()
+
+	p := rd.AddEmptyParagraph()
+	p.ensureProp()
+	p.ct.Property.SectPr = prev
+
+	next := ctypes.NewSectionProper()
+	next.PageSize = clonePageSize(prev.PageSize)
+	next.PageMargin = clonePageMargin(prev.PageMargin)
+	next.Bidi = clonePtr(prev.Bidi)
+	if sectType != "" {
+		next.Type = ctypes.NewGenSingleStrVal(sectType)
+	}
+
+	rd.Document.Body.SectPr = next
+	return &SectionProperties{root: rd, ct: next}
+}
+
+// Sections returns the properties of the sections of the document, in document order: the
+// sections ended by section breaks, then the last section. They can be changed through the
+// returned handles, e.g. to give the sections of a loaded document different page setups.
+func (rd *RootDoc) Sections() []*SectionProperties {
+	var sections []*SectionProperties
+	for _, child := range rd.Document.Body.Children {
+		if child.Para != nil && child.Para.ct.Property != nil && child.Para.ct.Property.SectPr != nil {
+			sections = append(sections, &SectionProperties{root: rd, ct: child.Para.ct.Property.SectPr})
+		}
+	}
+	return append(sections, rd.lastSection())
+}
+
+// Type returns how the section starts relative to the previous one, or
+// stypes.SectionMarkNextPage, the default of Word, if it is not set.
+func (s *SectionProperties) Type() stypes.SectionMark {
+	if s.ct.Type == nil {
+		return stypes.SectionMarkNextPage
+	}
+	return s.ct.Type.Val
+}
+
+// PageSize returns the width and height of the pages of the section in twips, Word's
+// default Letter size filling in the values that are not set.
+func (s *SectionProperties) PageSize() (width, height int) {
+	width, height = defaultPageWidth, defaultPageHeight
+	if s.ct != nil && s.ct.PageSize != nil {
+		if s.ct.PageSize.Width != nil {
+			width = int(*s.ct.PageSize.Width)
+		}
+		if s.ct.PageSize.Height != nil {
+			height = int(*s.ct.PageSize.Height)
+		}
+	}
+	return width, height
+}
+
+// Orientation returns the orientation of the pages of the section, derived from the page
+// size if it is not set.
+func (s *SectionProperties) Orientation() stypes.PageOrient {
+	if s.ct != nil && s.ct.PageSize != nil && s.ct.PageSize.Orient != "" {
+		return s.ct.PageSize.Orient
+	}
+	if width, height := s.PageSize(); width > height {
+		return stypes.PageOrientLandscape
+	}
+	return stypes.PageOrientPortrait
+}
+
+// PageMargins returns the margins of the section in twips, in the order of SetPageMargins,
+// Word's defaults filling in the values that are not set.
+func (s *SectionProperties) PageMargins() (top, right, bottom, left, header, footer, gutter int) {
+	top, right, bottom, left = defaultPageMargin, defaultPageMargin, defaultPageMargin, defaultPageMargin
+	header, footer = defaultHeaderDistance, defaultHeaderDistance
+	if s.ct == nil || s.ct.PageMargin == nil {
+		return
+	}
+
+	for _, m := range []struct {
+		dst *int
+		src *int
+	}{
+		{&top, s.ct.PageMargin.Top},
+		{&right, s.ct.PageMargin.Right},
+		{&bottom, s.ct.PageMargin.Bottom},
+		{&left, s.ct.PageMargin.Left},
+		{&header, s.ct.PageMargin.Header},
+		{&footer, s.ct.PageMargin.Footer},
+		{&gutter, s.ct.PageMargin.Gutter},
+	} {
+		if m.src != nil {
+			*m.dst = *m.src
+		}
+	}
+	return
+}
+
+// ContentWidth returns the width available for content in the section, in twips: the page
+// width minus the left and right margins.
+func (s *SectionProperties) ContentWidth() int {
+	width, _ := s.PageSize()
+	_, right, _, left, _, _, _ := s.PageMargins()
+	return width - left - right
+}
+
+// CurrentSectionProperties returns a snapshot of the page layout of the last section of the
+// document: page size and orientation, margins, page numbering format, text direction, right
+// to left layout and document grid.
+//
+// The snapshot is detached from the document, so later changes to the section do not affect
+// it and changes made through the returned handle only affect the snapshot. Use
+// ApplySectionProperties to apply it to a section.
+//
+// Example:
+//
+//	document.SetPaperSize(ctypes.A4)
+//	document.SetPageOrientation(stypes.PageOrientLandscape)
+//	landscape := document.CurrentSectionProperties()
+//	...
+//	document.AddSectionBreak(stypes.SectionMarkNextPage)
+//	document.ApplySectionProperties(landscape)
+func (rd *RootDoc) CurrentSectionProperties() *SectionProperties {
+	snapshot := ctypes.NewSectionProper()
+	copyPageLayout(snapshot, rd.ensureSectPr())
+	return &SectionProperties{ct: snapshot}
+}
+
+// ApplySectionProperties replaces the page layout of the last section of the document with
+// the one of props, typically a snapshot returned by CurrentSectionProperties.
+//
+// The section type, headers and footers of the last section are kept. A nil props is ignored.
+func (rd *RootDoc) ApplySectionProperties(props *SectionProperties) {
+	if props == nil || props.ct == nil {
+		return
+	}
+	copyPageLayout(rd.ensureSectPr(), props.ct)
+}
+
+// copyPageLayout sets the page layout of dst to a copy of the one of src.
+func copyPageLayout(dst, src *ctypes.SectionProp) {
+	dst.PageSize = clonePageSize(src.PageSize)
+	dst.PageMargin = clonePageMargin(src.PageMargin)
+	dst.PageNum = clonePtr(src.PageNum)
+	dst.TextDir = clonePtr(src.TextDir)
+	dst.Bidi = clonePtr(src.Bidi)
+	dst.DocGrid = nil
+	if src.DocGrid != nil {
+		dst.DocGrid = &ctypes.DocGrid{
+			Type:      src.DocGrid.Type,
+			LinePitch: clonePtr(src.DocGrid.LinePitch),
+			CharSpace: clonePtr(src.DocGrid.CharSpace),
+		}
+	}
+}
+
+func clonePageSize(pgSz *ctypes.PageSize) *ctypes.PageSize {
+	if pgSz == nil {
+		return nil
+	}
+	return &ctypes.PageSize{
+		Width:  clonePtr(pgSz.Width),
+		Height: clonePtr(pgSz.Height),
+		Orient: pgSz.Orient,
+		Code:   clonePtr(pgSz.Code),
+	}
+}
+
+func clonePageMargin(pgMar *ctypes.PageMargin) *ctypes.PageMargin {
+	if pgMar == nil {
+		return nil
+	}
+	return &ctypes.PageMargin{
+		Top:    clonePtr(pgMar.Top),
+		Right:  clonePtr(pgMar.Right),
+		Bottom: clonePtr(pgMar.Bottom),
+		Left:   clonePtr(pgMar.Left),
+		Header: clonePtr(pgMar.Header),
+		Footer: clonePtr(pgMar.Footer),
+		Gutter: clonePtr(pgMar.Gutter),
+	}
+}
+
+// clonePtr returns a pointer to a copy of the value p points to, or nil if p is nil.
+func clonePtr[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+	return internal.ToPtr(*p)
+}
+
+// lastSection returns the properties of the last section of the document.
+func (rd *RootDoc) lastSection() *SectionProperties {
+	return &SectionProperties{root: rd, ct: rd.ensureSectPr()}
+}
+
+// ensurePageSize returns the page size of the section, creating it with
+// Word's default Letter size if needed. A missing width or height, as in a
+// loaded w:pgSz without one, takes the dimension of the default size.
+func (s *SectionProperties) ensurePageSize() *ctypes.PageSize {
+	if s.ct.PageSize == nil {
+		s.ct.PageSize = &ctypes.PageSize{}
+	}
+
+	pgSz := s.ct.PageSize
+	if pgSz.Width == nil {
+		pgSz.Width = internal.ToPtr(uint64(defaultPageWidth))
+	}
+	if pgSz.Height == nil {
+		pgSz.Height = internal.ToPtr(uint64(defaultPageHeight))
+	}
+	return pgSz
+}
+
+// SetPageSize sets the page width and height of the last section of the document.
+// See SectionProperties.SetPageSize for details.
+func (rd *RootDoc) SetPageSize(width, height int) {
+	rd.lastSection().SetPageSize(width, height)
+}
+
+// SetPaperSize sets the page size of the last section of the document to a predefined paper size.
+// See SectionProperties.SetPaperSize for details.
+func (rd *RootDoc) SetPaperSize(size *ctypes.PageSize) {
+	rd.lastSection().SetPaperSize(size)
+}
+
+// SetPageOrientation sets the orientation of the last section of the document.
+// See SectionProperties.SetPageOrientation for details.
+func (rd *RootDoc) SetPageOrientation(orient stypes.PageOrient) {
+	rd.lastSection().SetPageOrientation(orient)
+}
+
+// SetPageMargins sets the page margins of the last section of the document.
+// See SectionProperties.SetPageMargins for details.
+func (rd *RootDoc) SetPageMargins(top, right, bottom, left, header, footer, gutter int) {
+	rd.lastSection().SetPageMargins(top, right, bottom, left, header, footer, gutter)
+}
+
+// SetColumns sets the number of equal width text columns of the last section of the document.
+// See SectionProperties.SetColumns for details.
+func (rd *RootDoc) SetColumns(count, space int) {
+	rd.lastSection().SetColumns(count, space)
+}
+
+// SetColumnsCustom sets the text columns of unequal width of the last section of the document.
+// See SectionProperties.SetColumnsCustom for details.
+func (rd *RootDoc) SetColumnsCustom(cols []ctypes.ColumnDef, sep bool) {
+	rd.lastSection().SetColumnsCustom(cols, sep)
+}
+
+// SetPageSize sets the page width and height of the section (w:pgSz), in twips.
+//
+// The orientation is set to landscape when the width is larger than the height and to
+// portrait otherwise. Non-positive values leave the corresponding dimension unchanged.
+//
+// Returns:
+//   - *SectionProperties: The section instance for method chaining.
+//
+// Example:
+//
+//	document.SetPageSize(11906, 16838) // A4 portrait
+func (s *SectionProperties) SetPageSize(width, height int) *SectionProperties {
+	pgSz := s.ensurePageSize()
+
+	if width > 0 {
+		pgSz.Width = internal.ToPtr(uint64(width))
+	}
+	if height > 0 {
+		pgSz.Height = internal.ToPtr(uint64(height))
+	}
+
+	// A custom size no longer matches the paper code
+	pgSz.Code = nil
+
+	if *pgSz.Width > *pgSz.Height {
+		pgSz.Orient = stypes.PageOrientLandscape
+	} else {
+		pgSz.Orient = stypes.PageOrientPortrait
+	}
+
+	return s
+}
+
+// SetPaperSize sets the page size of the section to one of the predefined
+// paper sizes, such as ctypes.A4, ctypes.Letter or ctypes.Legal.
+//
+// The current orientation is kept, so the dimensions are swapped for a landscape section.
+//
+// Returns:
+//   - *SectionProperties: The section instance for method chaining.
+//
+// Example:
+//
+//	document.SetPaperSize(ctypes.A4)
+func (s *SectionProperties) SetPaperSize(size *ctypes.PageSize) *SectionProperties {
+	if size == nil || size.Width == nil || size.Height == nil {
+		return s
+	}
+
+	pgSz := s.ensurePageSize()
+	orient := pgSz.Orient
+
+	pgSz.Width = internal.ToPtr(*size.Width)
+	pgSz.Height = internal.ToPtr(*size.Height)
+	pgSz.Code = nil
+	if size.Code != nil {
+		pgSz.Code = internal.ToPtr(*size.Code)
+	}
+
+	pgSz.Orient = stypes.PageOrientPortrait
+	if orient == stypes.PageOrientLandscape {
+		s.SetPageOrientation(stypes.PageOrientLandscape)
+	}
+
+	return s
+}
+
+// SetPageOrientation sets the orientation of the section.
+//
+// The page width and height are swapped when needed, so that landscape pages are wider
+// than they are tall and portrait pages are taller than they are wide.
+//
+// Returns:
+//   - *SectionProperties: The section instance for method chaining.
+//
+// Example:
+//
+//	document.SetPaperSize(ctypes.A4)
+//	document.SetPageOrientation(stypes.PageOrientLandscape)
+func (s *SectionProperties) SetPageOrientation(orient stypes.PageOrient) *SectionProperties {
+	pgSz := s.ensurePageSize()
+
+	landscape := orient == stypes.PageOrientLandscape
+	if landscape != (*pgSz.Width > *pgSz.Height) {
+		pgSz.Width, pgSz.Height = pgSz.Height, pgSz.Width
+	}
+
+	pgSz.Orient = orient
+	return s
+}
+
+// SetPageMargins sets the page margins of the section (w:pgMar), in twips.
+//
+// Parameters:
+//   - top, right, bottom, left: The distance between the page edges and the text.
+//   - header: The distance between the top edge of the page and the header.
+//   - footer: The distance between the bottom edge of the page and the footer.
+//   - gutter: The extra space added to the inside margin for binding.
+//
+// Returns:
+//   - *SectionProperties: The section instance for method chaining.
+//
+// Example:
+//
+//	document.SetPageMargins(1440, 1440, 1440, 1440, 720, 720, 0)
+func (s *SectionProperties) SetPageMargins(top, right, bottom, left, header, footer, gutter int) *SectionProperties {
+	s.ct.PageMargin = &ctypes.PageMargin{
+		Top:    internal.ToPtr(top),
+		Right:  internal.ToPtr(right),
+		Bottom: internal.ToPtr(bottom),
+		Left:   internal.ToPtr(left),
+		Header: internal.ToPtr(header),
+		Footer: internal.ToPtr(footer),
+		Gutter: internal.ToPtr(gutter),
+	}
+	return s
+}
+
+// SetDifferentFirstPage sets whether the first page of the section has its own header and
+// footer (w:titlePg), e.g. for a title page. The first page has no header or footer until
+// ones of type HeaderFooterFirst are added.
+//
+// Returns:
+//   - *SectionProperties: The section instance for method chaining.
+func (s *SectionProperties) SetDifferentFirstPage(value bool) *SectionProperties {
+	s.ct.TitlePg = nil
+	if value {
+		s.ct.TitlePg = ctypes.NewGenSingleStrVal(stypes.OnOffTrue)
+	}
+	return s
+}
+
+// AddHeader returns the header of the given type of the section, creating the header part if
+// the section does not reference one yet. See RootDoc.AddHeader for the handling of the types.
+//
+// A section without a header of some type uses the one of the previous section. AddHeader
+// returns nil on a snapshot returned by RootDoc.CurrentSectionProperties, and if the header
+// part referenced for the type cannot be parsed.
+//
+// Example:
+//
+//	appendix := document.AddSectionBreak(stypes.SectionMarkNextPage)
+//	appendix.AddHeader(docx.HeaderFooterDefault).AddParagraph("Appendix")
+func (s *SectionProperties) AddHeader(hfType HeaderFooterType) *Header {
+	if s.root == nil {
+		return nil
+	}
+	hf := s.root.headerFooterPart(s.ct, hfType, false)
+	if hf == nil {
+		return nil
+	}
+	return &Header{hf}
+}
+
+// AddFooter returns the footer of the given type of the section, creating the footer part if
+// the section does not reference one yet. See AddHeader for details.
+func (s *SectionProperties) AddFooter(hfType HeaderFooterType) *Footer {
+	if s.root == nil {
+		return nil
+	}
+	hf := s.root.headerFooterPart(s.ct, hfType, true)
+	if hf == nil {
+		return nil
+	}
+	return &Footer{hf}
+}
+
+// SetType sets how the section starts relative to the previous one (w:type).
+//
+// Returns:
+//   - *SectionProperties: The section instance for method chaining.
+func (s *SectionProperties) SetType(sectType stypes.SectionMark) *SectionProperties {
+	s.ct.Type = ctypes.NewGenSingleStrVal(sectType)
+	return s
+}
+
+// SetColumns lays out the text of the section in columns of equal width (w:cols).
+//
+// Parameters:
+//   - count: The number of columns; 1 or less removes the columns of the section.
+//   - space: The spacing between the columns, in twips (720 is half an inch).
+//
+// The vertical line between the columns set by SetColumnSeparator is kept.
+//
+// Returns:
+//   - *SectionProperties: The section instance for method chaining.
+//
+// Example:
+//
+//	document.AddSectionBreak(stypes.SectionMarkNextContinuous).SetColumns(2, 720)
+func (s *SectionProperties) SetColumns(count, space int) *SectionProperties {
+	if count <= 1 {
+		s.ct.Columns = nil
+		return s
+	}
+
+	var sep stypes.OnOff
+	if s.ct.Columns != nil {
+		sep = s.ct.Columns.Sep
+	}
+
+	s.ct.Columns = &ctypes.Columns{
+		Space: internal.ToPtr(space),
+		Num:   internal.ToPtr(count),
+		Sep:   sep,
+	}
+	return s
+}
+
+// SetColumnsCustom lays out the text of the section in columns of unequal width (w:cols).
+//
+// Parameters:
+//   - cols: The width of each column and the spacing after it, in twips. The spacing after
+//     the last column is ignored. An empty slice removes the columns of the section.
+//   - sep: Whether a vertical line is drawn between the columns.
+//
+// The widths and spacings should add up to the width available for text, see
+// RootDoc.ContentWidth; RootDoc.Validate reports columns that do not fit.
+//
+// Returns:
+//   - *SectionProperties: The section instance for method chaining.
+//
+// Example:
+//
+//	// A wide column and a narrow sidebar on a Letter page with 1 inch margins
+//	document.SetColumnsCustom([]ctypes.ColumnDef{{Width: 6120, Space: 360}, {Width: 2880}}, true)
+func (s *SectionProperties) SetColumnsCustom(cols []ctypes.ColumnDef, sep bool) *SectionProperties {
+	if len(cols) == 0 {
+		s.ct.Columns = nil
+		return s
+	}
+
+	columns := &ctypes.Columns{
+		EqualWidth: stypes.OnOffZero,
+		Num:        internal.ToPtr(len(cols)),
+		Col:        append([]ctypes.ColumnDef{}, cols...),
+	}
+	columns.Col[len(cols)-1].Space = 0
+	if sep {
+		columns.Sep = stypes.OnOffOne
+	}
+
+	s.ct.Columns = columns
+	return s
+}
+
+// ColumnCount returns the number of columns the text of the section is laid out in, 1 if
+// it has no columns.
+func (s *SectionProperties) ColumnCount() int {
+	cols := s.ct.Columns
+	switch {
+	case cols == nil:
+		return 1
+	case len(cols.Col) > 0:
+		return len(cols.Col)
+	case cols.Num != nil && *cols.Num > 1:
+		return *cols.Num
+	}
+	return 1
+}
+
+// SetColumnSeparator sets whether a vertical line is drawn between the columns of the
+// section. It has no effect on a section without columns.
+//
+// Returns:
+//   - *SectionProperties: The section instance for method chaining.
+func (s *SectionProperties) SetColumnSeparator(sep bool) *SectionProperties {
+	if s.ct.Columns == nil {
+		return s
+	}
+
+	s.ct.Columns.Sep = ""
+	if sep {
+		s.ct.Columns.Sep = stypes.OnOffOne
+	}
+	return s
+}
+
+// SetBiDi sets whether the section is laid out from right to left (w:bidi), e.g. for Arabic or
+// Hebrew documents: the columns and the page numbers then run from right to left. The
+// direction of the text is set per paragraph, see Paragraph.BiDi.
+//
+// Returns:
+//   - *SectionProperties: The section instance for method chaining.
+func (s *SectionProperties) SetBiDi(value bool) *SectionProperties {
+	s.ct.Bidi = nil
+	if value {
+		s.ct.Bidi = ctypes.OnOffFromBool(true)
+	}
+	return s
+}
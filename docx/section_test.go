@@ -0,0 +1,271 @@
+package docx
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/MamaShip/godocx/internal"
+	"github.com/MamaShip/godocx/wml/ctypes"
+	"github.com/MamaShip/godocx/wml/stypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContentWidth(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	// No section properties: Word defaults
+	assert.Equal(t, 9360, rd.ContentWidth())
+
+	rd.Document.Body.SectPr = &ctypes.SectionProp{
+		PageSize:   ctypes.Letter,
+		PageMargin: &ctypes.PageMargin{Left: internal.ToPtr(1440), Right: internal.ToPtr(1440)},
+	}
+	assert.Equal(t, 9360, rd.ContentWidth())
+
+	rd.Document.Body.SectPr = &ctypes.SectionProp{
+		PageSize:   ctypes.A4,
+		PageMargin: &ctypes.PageMargin{Left: internal.ToPtr(1134), Right: internal.ToPtr(850)},
+	}
+	assert.Equal(t, 11906-1134-850, rd.ContentWidth())
+}
+
+func TestSetPageSizeAndOrientation(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	rd.SetPageSize(16838, 11906)
+	pgSz := rd.Document.Body.SectPr.PageSize
+	assert.Equal(t, uint64(16838), *pgSz.Width)
+	assert.Equal(t, uint64(11906), *pgSz.Height)
+	assert.Equal(t, stypes.PageOrientLandscape, pgSz.Orient)
+
+	rd.SetPageOrientation(stypes.PageOrientPortrait)
+	assert.Equal(t, uint64(11906), *pgSz.Width)
+	assert.Equal(t, uint64(16838), *pgSz.Height)
+	assert.Equal(t, stypes.PageOrientPortrait, pgSz.Orient)
+
+	// Setting the same orientation again does not swap
+	rd.SetPageOrientation(stypes.PageOrientPortrait)
+	assert.Equal(t, uint64(11906), *pgSz.Width)
+
+	// A page size without width, as loaded from a w:pgSz with only a height
+	pgSz.Width = nil
+	rd.SetPageSize(0, 15000)
+	assert.Equal(t, uint64(defaultPageWidth), *pgSz.Width)
+	assert.Equal(t, uint64(15000), *pgSz.Height)
+	assert.Equal(t, stypes.PageOrientPortrait, pgSz.Orient)
+}
+
+func TestSetPaperSize(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	rd.SetPageOrientation(stypes.PageOrientLandscape)
+	rd.SetPaperSize(ctypes.Legal)
+
+	pgSz := rd.Document.Body.SectPr.PageSize
+	assert.Equal(t, ctypes.LegalHeight, *pgSz.Width)
+	assert.Equal(t, ctypes.LegalWidth, *pgSz.Height)
+	assert.Equal(t, ctypes.LegalCode, *pgSz.Code)
+	assert.Equal(t, stypes.PageOrientLandscape, pgSz.Orient)
+
+	// The preset itself is not modified
+	assert.Equal(t, uint64(12240), *ctypes.Legal.Width)
+	assert.Equal(t, stypes.PageOrientPortrait, ctypes.Legal.Orient)
+
+	rd.SetPageSize(10000, 0)
+	assert.Nil(t, pgSz.Code, "custom size should drop the paper code")
+	assert.Equal(t, ctypes.LegalWidth, *pgSz.Height)
+}
+
+func TestSetPageMargins_RoundTrip(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	rd.SetPaperSize(ctypes.A4)
+	rd.SetPageMargins(1440, 1134, 1440, 850, 708, 709, 0)
+	assert.Equal(t, 11906-1134-850, rd.ContentWidth())
+
+	out, err := xml.Marshal(rd.Document)
+	assert.NoError(t, err)
+	assert.Contains(t, string(out), `<w:pgSz w:w="11906" w:h="16838" w:orient="portrait" w:code="1"></w:pgSz>`)
+	assert.Contains(t, string(out), `<w:pgMar w:left="850" w:right="1134" w:gutter="0" w:header="708" w:top="1440" w:footer="709" w:bottom="1440"></w:pgMar>`)
+
+	doc, err := LoadDocXml(rd, "word/document.xml", out)
+	assert.NoError(t, err)
+	assert.Equal(t, rd.Document.Body.SectPr.PageSize, doc.Body.SectPr.PageSize)
+	assert.Equal(t, rd.Document.Body.SectPr.PageMargin, doc.Body.SectPr.PageMargin)
+}
+
+func TestAddSectionBreak(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	rd.SetPaperSize(ctypes.A4)
+	rd.AddParagraph("Portrait")
+	landscape := rd.AddSectionBreak(stypes.SectionMarkNextPage).SetPageOrientation(stypes.PageOrientLandscape)
+	rd.AddParagraph("Landscape")
+	rd.AddSectionBreak(stypes.SectionMarkNextContinuous)
+	rd.AddParagraph("Still landscape")
+	last := rd.AddSectionBreak(stypes.SectionMarkOddPage).SetPageOrientation(stypes.PageOrientPortrait)
+
+	// The first section keeps its portrait layout on the terminating paragraph
+	first := rd.Document.Body.Children[1].Para.ct.Property.SectPr
+	assert.NotNil(t, first)
+	assert.Equal(t, uint64(11906), *first.PageSize.Width)
+	assert.Nil(t, first.Type)
+
+	// The handle follows its section when a later break moves it to a paragraph
+	second := rd.Document.Body.Children[3].Para.ct.Property.SectPr
+	assert.Same(t, landscape.ct, second)
+	assert.Equal(t, uint64(16838), *second.PageSize.Width)
+	assert.Equal(t, stypes.SectionMarkNextPage, second.Type.Val)
+
+	third := rd.Document.Body.Children[5].Para.ct.Property.SectPr
+	assert.Equal(t, stypes.SectionMarkNextContinuous, third.Type.Val)
+	assert.Equal(t, stypes.PageOrientLandscape, third.PageSize.Orient)
+
+	// The last section is the body-level one
+	assert.Same(t, last.ct, rd.Document.Body.SectPr)
+	assert.Equal(t, uint64(11906), *rd.Document.Body.SectPr.PageSize.Width)
+	assert.Equal(t, uint64(16838), *second.PageSize.Width, "changing a section must not affect the previous one")
+
+	out, err := xml.Marshal(rd.Document)
+	assert.NoError(t, err)
+	xmlStr := string(out)
+	assert.Contains(t, xmlStr, `<w:p><w:pPr><w:sectPr><w:type w:val="nextPage"></w:type><w:pgSz w:w="16838" w:h="11906" w:orient="landscape" w:code="1"></w:pgSz></w:sectPr></w:pPr></w:p>`)
+	assert.Contains(t, xmlStr, `<w:type w:val="continuous"></w:type>`)
+	assert.Contains(t, xmlStr, `<w:sectPr><w:type w:val="oddPage"></w:type><w:pgSz w:w="11906" w:h="16838" w:orient="portrait" w:code="1"></w:pgSz></w:sectPr></w:body>`)
+
+	doc, err := LoadDocXml(rd, "word/document.xml", out)
+	assert.NoError(t, err)
+	loaded := doc.Body.Children[3].Para.ct.Property.SectPr
+	assert.NotNil(t, loaded)
+	assert.Equal(t, stypes.SectionMarkNextPage, loaded.Type.Val)
+	assert.Equal(t, stypes.PageOrientLandscape, loaded.PageSize.Orient)
+}
+
+func TestSections(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	rd.AddParagraph("First")
+	rd.AddSectionBreak(stypes.SectionMarkEvenPage)
+	rd.AddParagraph("Second")
+	rd.AddSectionBreak(stypes.SectionMarkNextContinuous)
+
+	sections := rd.Sections()
+	assert.Len(t, sections, 3)
+	assert.Equal(t, stypes.SectionMarkNextPage, sections[0].Type())
+	assert.Equal(t, stypes.SectionMarkEvenPage, sections[1].Type())
+	assert.Equal(t, stypes.SectionMarkNextContinuous, sections[2].Type())
+	assert.Same(t, rd.Document.Body.SectPr, sections[2].ct)
+
+	// The handles change the sections of the document
+	sections[0].SetPageOrientation(stypes.PageOrientLandscape)
+	assert.Equal(t, stypes.PageOrientLandscape, rd.Document.Body.Children[1].Para.ct.Property.SectPr.PageSize.Orient)
+
+	assert.Len(t, setupRootDoc(t).Sections(), 1)
+}
+
+func TestSectionPageSetupGetters(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	first := rd.Sections()[0]
+	width, height := first.PageSize()
+	assert.Equal(t, [2]int{defaultPageWidth, defaultPageHeight}, [2]int{width, height})
+	assert.Equal(t, stypes.PageOrientPortrait, first.Orientation())
+	top, right, bottom, left, header, footer, gutter := first.PageMargins()
+	assert.Equal(t, []int{1440, 1440, 1440, 1440, 720, 720, 0}, []int{top, right, bottom, left, header, footer, gutter})
+
+	rd.SetPaperSize(ctypes.Legal)
+	rd.SetPageOrientation(stypes.PageOrientLandscape)
+	rd.SetPageMargins(1000, 900, 800, 700, 600, 500, 400)
+
+	section := rd.Sections()[0]
+	width, height = section.PageSize()
+	assert.Equal(t, [2]int{20160, 12240}, [2]int{width, height})
+	assert.Equal(t, stypes.PageOrientLandscape, section.Orientation())
+	top, right, bottom, left, header, footer, gutter = section.PageMargins()
+	assert.Equal(t, []int{1000, 900, 800, 700, 600, 500, 400}, []int{top, right, bottom, left, header, footer, gutter})
+	assert.Equal(t, 20160-900-700, section.ContentWidth())
+	assert.Equal(t, section.ContentWidth(), rd.ContentWidth())
+}
+
+func TestSectionPropertiesSnapshot(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	rd.SetPaperSize(ctypes.A4)
+	rd.SetPageOrientation(stypes.PageOrientLandscape)
+	rd.SetPageMargins(720, 1080, 720, 1080, 360, 360, 0)
+	landscape := rd.CurrentSectionProperties()
+
+	rd.AddParagraph("Landscape")
+	rd.AddSectionBreak(stypes.SectionMarkNextPage).
+		SetPaperSize(ctypes.Letter).
+		SetPageOrientation(stypes.PageOrientPortrait).
+		SetPageMargins(1440, 1440, 1440, 1440, 720, 720, 0)
+	rd.AddParagraph("Portrait")
+
+	// Changing the section does not affect the snapshot
+	assert.Equal(t, uint64(16838), *landscape.ct.PageSize.Width)
+
+	rd.AddSectionBreak(stypes.SectionMarkOddPage)
+	rd.ApplySectionProperties(landscape)
+	rd.AddParagraph("Landscape again")
+
+	last := rd.Document.Body.SectPr
+	assert.Equal(t, stypes.PageOrientLandscape, last.PageSize.Orient)
+	assert.Equal(t, uint64(16838), *last.PageSize.Width)
+	assert.Equal(t, uint64(11906), *last.PageSize.Height)
+	assert.Equal(t, 1080, *last.PageMargin.Left)
+	assert.Equal(t, 720, *last.PageMargin.Top)
+	assert.Equal(t, 360, *last.PageMargin.Header)
+	assert.Equal(t, stypes.SectionMarkOddPage, last.Type.Val, "the section type is kept")
+	assert.NotSame(t, landscape.ct.PageSize, last.PageSize)
+
+	// Changing the section does not affect the snapshot applied to it
+	rd.SetPageMargins(0, 0, 0, 0, 0, 0, 0)
+	assert.Equal(t, 1080, *landscape.ct.PageMargin.Left)
+
+	portrait := rd.Document.Body.Children[3].Para.ct.Property.SectPr
+	assert.Equal(t, stypes.PageOrientPortrait, portrait.PageSize.Orient)
+
+	rd.ApplySectionProperties(nil)
+	assert.Same(t, last, rd.Document.Body.SectPr)
+}
+
+func TestSetColumns(t *testing.T) {
+	rd := setupRootDoc(t)
+	rd.AddParagraph("Masthead")
+	newsletter := rd.AddSectionBreak(stypes.SectionMarkNextContinuous).
+		SetColumns(3, 360).
+		SetColumnSeparator(true)
+
+	out, err := xml.Marshal(rd.Document.Body.SectPr)
+	require.NoError(t, err)
+	assert.Contains(t, string(out), `<w:cols w:space="360" w:num="3" w:sep="1"></w:cols>`)
+
+	assert.Equal(t, 3, newsletter.ColumnCount())
+	assert.Equal(t, 1, rd.Sections()[0].ColumnCount())
+
+	// The separator is kept when the count changes
+	newsletter.SetColumns(2, 720)
+	assert.Equal(t, stypes.OnOffOne, rd.Document.Body.SectPr.Columns.Sep)
+
+	brk := rd.AddColumnBreak()
+	assert.Equal(t, stypes.BreakTypeColumn, *brk.ct.Children[0].Run.Children[0].Break.BreakType)
+
+	cols := []ctypes.ColumnDef{{Width: 6120, Space: 360}, {Width: 2880, Space: 720}}
+	rd.SetColumnsCustom(cols, false)
+	out, err = xml.Marshal(rd.Document.Body.SectPr)
+	require.NoError(t, err)
+	assert.Contains(t, string(out), `<w:cols w:equalWidth="0" w:num="2"><w:col w:w="6120" w:space="360"></w:col><w:col w:w="2880"></w:col></w:cols>`)
+	assert.Equal(t, 720, cols[1].Space, "the argument is not modified")
+	assert.Equal(t, 2, newsletter.ColumnCount())
+
+	out, err = xml.Marshal(rd.Document)
+	require.NoError(t, err)
+	doc, err := LoadDocXml(setupRootDoc(t), "word/document.xml", out)
+	require.NoError(t, err)
+	assert.Equal(t, rd.Document.Body.SectPr.Columns, doc.Body.SectPr.Columns)
+
+	rd.SetColumns(1, 0)
+	assert.Nil(t, rd.Document.Body.SectPr.Columns)
+}
@@ -0,0 +1,254 @@
+package docx
+
+import (
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+const settingsPath = "word/settings.xml"
+
+// ErrNoSettings is returned when a setting is changed in a document without a settings part.
+var ErrNoSettings = errors.New("document has no settings part")
+
+// ProtectionMode is the editing allowed in a protected document (w:documentProtection w:edit).
+type ProtectionMode string
+
+const (
+	ProtectionNone           ProtectionMode = ""               // No editing restriction
+	ProtectionReadOnly       ProtectionMode = "readOnly"       // No changes
+	ProtectionComments       ProtectionMode = "comments"       // Only comments can be added
+	ProtectionTrackedChanges ProtectionMode = "trackedChanges" // Changes are always tracked
+	ProtectionForms          ProtectionMode = "forms"          // Only form fields can be filled in
+)
+
+// ProtectionSettings describes the editing restrictions of a document.
+type ProtectionSettings struct {
+	// Mode is the editing allowed; ProtectionNone removes the restriction.
+	Mode ProtectionMode
+
+	// Password is needed to stop the protection in Word; empty for none.
+	Password string
+
+	// Salt is the random value hashed with the password; empty for 16 random bytes.
+	Salt []byte
+
+	// ReadOnlyRecommended makes Word suggest opening the document read-only (w:writeProtection),
+	// without preventing changes.
+	ReadOnlyRecommended bool
+}
+
+// passwordSpinCount is the number of hash iterations of the password of a protected document.
+const passwordSpinCount = 100000
+
+// documentProtectionSuccessors and writeProtectionSuccessors list settings that follow the
+// element in the schema order and are commonly present; the element is inserted before the
+// earliest one found.
+var (
+	documentProtectionSuccessors = []string{
+		"<w:autoFormatOverride",
+		"<w:styleLockTheme",
+		"<w:styleLockQFSet",
+		"<w:defaultTabStop",
+		"<w:autoHyphenation",
+		"<w:evenAndOddHeaders",
+		"<w:characterSpacingControl",
+		"<w:compat",
+		"<w:rsids",
+		"</w:settings>",
+	}
+
+	writeProtectionSuccessors = append([]string{
+		"<w:view",
+		"<w:zoom",
+		"<w:removePersonalInformation",
+		"<w:proofState",
+		"<w:attachedTemplate",
+		"<w:trackRevisions",
+		"<w:documentProtection",
+	}, documentProtectionSuccessors...)
+)
+
+// SetProtection restricts the editing of the document in Word (w:documentProtection in settings.xml),
+// replacing any previous restriction.
+//
+// The password is stored as a salted hash using the legacy Word algorithm of the transitional
+// ECMA-376 schema: the 32-bit legacy password key, rendered as upper-case hex, is hashed with
+// SHA-1 together with the salt, then rehashed 100000 times with the iteration number appended
+// (cryptProviderType "rsaFull", cryptAlgorithmSid 4). The protection is not encryption: the
+// document content stays readable by any tool.
+//
+// Returns:
+//   - error: ErrNoSettings if the document has no settings part.
+//
+// Example:
+//
+//	err := document.SetProtection(docx.ProtectionSettings{
+//		Mode:     docx.ProtectionReadOnly,
+//		Password: "secret",
+//	})
+func (rd *RootDoc) SetProtection(settings ProtectionSettings) error {
+	if _, ok := rd.FileMap.Load(settingsPath); !ok {
+		return ErrNoSettings
+	}
+
+	protection := ""
+	if settings.Mode != ProtectionNone {
+		var sb strings.Builder
+		sb.WriteString(`<w:documentProtection w:edit="` + string(settings.Mode) + `" w:enforcement="1"`)
+
+		if settings.Password != "" {
+			salt := settings.Salt
+			if len(salt) == 0 {
+				salt = make([]byte, 16)
+				if _, err := rand.Read(salt); err != nil {
+					return err
+				}
+			}
+
+			sb.WriteString(` w:cryptProviderType="rsaFull" w:cryptAlgorithmClass="hash" w:cryptAlgorithmType="typeAny"`)
+			sb.WriteString(` w:cryptAlgorithmSid="4" w:cryptSpinCount="` + strconv.Itoa(passwordSpinCount) + `"`)
+			sb.WriteString(` w:hash="` + legacyPasswordHash(settings.Password, salt, passwordSpinCount) + `"`)
+			sb.WriteString(` w:salt="` + base64.StdEncoding.EncodeToString(salt) + `"`)
+		}
+		sb.WriteString(`/>`)
+		protection = sb.String()
+	}
+
+	writeProtection := ""
+	if settings.ReadOnlyRecommended {
+		writeProtection = `<w:writeProtection w:recommended="1"/>`
+	}
+
+	rd.replaceSetting("documentProtection", protection, documentProtectionSuccessors)
+	rd.replaceSetting("writeProtection", writeProtection, writeProtectionSuccessors)
+	return nil
+}
+
+// replaceSetting replaces the element with the given name in settings.xml by markup, inserted
+// before the earliest of the successors found. An empty markup removes the element.
+// It does nothing if the document has no settings part or none of the successors is found.
+func (rd *RootDoc) replaceSetting(name, markup string, successors []string) {
+	existing, ok := rd.FileMap.Load(settingsPath)
+	if !ok {
+		return
+	}
+
+	element := regexp.MustCompile(`(?s)<w:` + name + `\b(?:[^>]*/>|.*?</w:` + name + `>)`)
+	content := element.ReplaceAllString(string(existing.([]byte)), "")
+
+	if markup != "" {
+		insertAt := -1
+		for _, successor := range successors {
+			if idx := strings.Index(content, successor); idx >= 0 && (insertAt < 0 || idx < insertAt) {
+				insertAt = idx
+			}
+		}
+		if insertAt < 0 {
+			return
+		}
+		content = content[:insertAt] + markup + content[insertAt:]
+	}
+
+	rd.FileMap.Store(settingsPath, []byte(content))
+}
+
+// legacyPasswordHash returns the base64 hash of the password stored in w:documentProtection
+// by Word, following the legacy algorithm of ECMA-376 Part 4.
+func legacyPasswordHash(password string, salt []byte, spinCount int) string {
+	key := legacyPasswordKey(password)
+
+	// The key bytes, least significant first, as upper-case hex without zero padding
+	var hexKey strings.Builder
+	for i := 0; i < 4; i++ {
+		hexKey.WriteString(strings.ToUpper(strconv.FormatUint(uint64(key>>(8*i)&0xFF), 16)))
+	}
+
+	input := append([]byte{}, salt...)
+	for _, unit := range utf16.Encode([]rune(hexKey.String())) {
+		input = append(input, byte(unit), byte(unit>>8))
+	}
+
+	sum := sha1.Sum(input)
+	hash := sum[:]
+	iteration := make([]byte, 4)
+	for i := 0; i < spinCount; i++ {
+		binary.LittleEndian.PutUint32(iteration, uint32(i))
+		sum = sha1.Sum(append(hash, iteration...))
+		hash = sum[:]
+	}
+
+	return base64.StdEncoding.EncodeToString(hash)
+}
+
+// legacyPasswordKey returns the 32-bit key derived from the first 15 characters of the
+// password by the legacy Word algorithm.
+func legacyPasswordKey(password string) uint32 {
+	const maxPasswordLength = 15
+
+	runes := []rune(password)
+	if len(runes) > maxPasswordLength {
+		runes = runes[:maxPasswordLength]
+	}
+	if len(runes) == 0 {
+		return 0
+	}
+
+	// Each character contributes its low byte, or its high byte if the low byte is zero
+	chars := make([]byte, len(runes))
+	for i, r := range runes {
+		chars[i] = byte(r & 0xFF)
+		if chars[i] == 0 {
+			chars[i] = byte(r >> 8 & 0xFF)
+		}
+	}
+
+	high := legacyInitialCodes[len(chars)-1]
+	for i, c := range chars {
+		row := legacyEncryptionMatrix[maxPasswordLength-len(chars)+i]
+		for bit := 0; bit < 7; bit++ {
+			if c&(1<<bit) != 0 {
+				high ^= row[bit]
+			}
+		}
+	}
+
+	var low uint16
+	for i := len(chars) - 1; i >= 0; i-- {
+		low = ((low>>14)&0x0001 | (low<<1)&0x7FFF) ^ uint16(chars[i])
+	}
+	low = ((low>>14)&0x0001 | (low<<1)&0x7FFF) ^ uint16(len(chars)) ^ 0xCE4B
+
+	return uint32(high)<<16 | uint32(low)
+}
+
+// legacyInitialCodes holds the initial high-order word of the legacy key, by password length.
+var legacyInitialCodes = [15]uint16{
+	0xE1F0, 0x1D0F, 0xCC9C, 0x84C0, 0x110C, 0x0E10, 0xF1CE, 0x313E, 0x1872, 0xE139, 0xD40F, 0x84F9, 0x280C, 0xA96A, 0x4EC3,
+}
+
+// legacyEncryptionMatrix holds the values combined into the high-order word of the legacy key
+// for each bit of each password character.
+var legacyEncryptionMatrix = [15][7]uint16{
+	{0xAEFC, 0x4DD9, 0x9BB2, 0x2745, 0x4E8A, 0x9D14, 0x2A09},
+	{0x7B61, 0xF6C2, 0xFDA5, 0xEB6B, 0xC6F7, 0x9DCF, 0x2BBF},
+	{0x4563, 0x8AC6, 0x05AD, 0x0B5A, 0x16B4, 0x2D68, 0x5AD0},
+	{0x0375, 0x06EA, 0x0DD4, 0x1BA8, 0x3750, 0x6EA0, 0xDD40},
+	{0xD849, 0xA0B3, 0x5147, 0xA28E, 0x553D, 0xAA7A, 0x44D5},
+	{0x6F45, 0xDE8A, 0xAD35, 0x4A4B, 0x9496, 0x390D, 0x721A},
+	{0xEB23, 0xC667, 0x9CEF, 0x29FF, 0x53FE, 0xA7FC, 0x5FD9},
+	{0x47D3, 0x8FA6, 0x0F6D, 0x1EDA, 0x3DB4, 0x7B68, 0xF6D0},
+	{0xB861, 0x60E3, 0xC1C6, 0x93AD, 0x377B, 0x6EF6, 0xDDEC},
+	{0x45A0, 0x8B40, 0x06A1, 0x0D42, 0x1A84, 0x3508, 0x6A10},
+	{0xAA51, 0x4483, 0x8906, 0x022D, 0x045A, 0x08B4, 0x1168},
+	{0x76B4, 0xED68, 0xCAF1, 0x85C3, 0x1BA7, 0x374E, 0x6E9C},
+	{0x3730, 0x6E60, 0xDCC0, 0xA9A1, 0x4363, 0x86C6, 0x1DAD},
+	{0x3331, 0x6662, 0xCCC4, 0x89A9, 0x0373, 0x06E6, 0x0DCC},
+	{0x1021, 0x2042, 0x4084, 0x8108, 0x1231, 0x2462, 0x48C4},
+}
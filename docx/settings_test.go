@@ -0,0 +1,96 @@
+package docx_test
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	godocx "github.com/MamaShip/godocx"
+	"github.com/MamaShip/godocx/docx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func protectionHash(t *testing.T, settings string) string {
+	t.Helper()
+	match := regexp.MustCompile(`w:hash="([^"]+)"`).FindStringSubmatch(settings)
+	require.Len(t, match, 2)
+	return match[1]
+}
+
+func TestSetProtection_ReadOnly(t *testing.T) {
+	rd, err := godocx.NewDocument()
+	require.NoError(t, err)
+
+	require.NoError(t, rd.SetProtection(docx.ProtectionSettings{Mode: docx.ProtectionReadOnly}))
+
+	files, _ := writeParts(t, rd)
+	settings := string(files["word/settings.xml"])
+	assert.Contains(t, settings, `<w:documentProtection w:edit="readOnly" w:enforcement="1"/>`)
+	assert.Less(t, strings.Index(settings, "<w:documentProtection"), strings.Index(settings, "<w:defaultTabStop"))
+	assert.Greater(t, strings.Index(settings, "<w:documentProtection"), strings.Index(settings, "<w:proofState"))
+	assert.NotContains(t, settings, "w:hash=")
+}
+
+func TestSetProtection_Password(t *testing.T) {
+	rd, err := godocx.NewDocument()
+	require.NoError(t, err)
+	salt := []byte("0123456789abcdef")
+
+	require.NoError(t, rd.SetProtection(docx.ProtectionSettings{Mode: docx.ProtectionComments, Password: "secret", Salt: salt}))
+	files, _ := writeParts(t, rd)
+	settings := string(files["word/settings.xml"])
+
+	assert.Contains(t, settings, `<w:documentProtection w:edit="comments" w:enforcement="1" w:cryptProviderType="rsaFull" w:cryptAlgorithmClass="hash" `+
+		`w:cryptAlgorithmType="typeAny" w:cryptAlgorithmSid="4" w:cryptSpinCount="100000" w:hash="`)
+	assert.Contains(t, settings, `w:salt="MDEyMzQ1Njc4OWFiY2RlZg=="/>`)
+	hash := protectionHash(t, settings)
+	assert.Len(t, hash, 28) // SHA-1 digest
+
+	// The hash only depends on the password and the salt
+	require.NoError(t, rd.SetProtection(docx.ProtectionSettings{Mode: docx.ProtectionComments, Password: "secret", Salt: salt}))
+	files, _ = writeParts(t, rd)
+	settings = string(files["word/settings.xml"])
+	assert.Equal(t, hash, protectionHash(t, settings))
+	assert.Equal(t, 1, strings.Count(settings, "<w:documentProtection"))
+
+	require.NoError(t, rd.SetProtection(docx.ProtectionSettings{Mode: docx.ProtectionComments, Password: "other", Salt: salt}))
+	files, _ = writeParts(t, rd)
+	assert.NotEqual(t, hash, protectionHash(t, string(files["word/settings.xml"])))
+}
+
+func TestSetProtection_RecommendedAndRemoved(t *testing.T) {
+	rd, err := godocx.NewDocument()
+	require.NoError(t, err)
+
+	require.NoError(t, rd.SetProtection(docx.ProtectionSettings{Mode: docx.ProtectionReadOnly, ReadOnlyRecommended: true}))
+	require.NoError(t, rd.SetProtection(docx.ProtectionSettings{ReadOnlyRecommended: true}))
+
+	files, _ := writeParts(t, rd)
+	settings := string(files["word/settings.xml"])
+	assert.NotContains(t, settings, "<w:documentProtection")
+	assert.Equal(t, 1, strings.Count(settings, `<w:writeProtection w:recommended="1"/>`))
+	assert.Less(t, strings.Index(settings, "<w:writeProtection"), strings.Index(settings, "<w:zoom"))
+
+	require.NoError(t, rd.SetProtection(docx.ProtectionSettings{}))
+	files, _ = writeParts(t, rd)
+	assert.NotContains(t, string(files["word/settings.xml"]), "<w:writeProtection")
+}
+
+func TestSetProtection_NoSettings(t *testing.T) {
+	rd := docx.NewRootDoc()
+	assert.ErrorIs(t, rd.SetProtection(docx.ProtectionSettings{Mode: docx.ProtectionReadOnly}), docx.ErrNoSettings)
+}
+
+func TestAddTableOfContents_UpdateFields(t *testing.T) {
+	rd, err := godocx.NewDocument()
+	require.NoError(t, err)
+
+	rd.AddTableOfContents(docx.DefaultTOCOptions())
+	rd.AddTableOfContents(docx.DefaultTOCOptions())
+
+	files, _ := writeParts(t, rd)
+	settings := string(files["word/settings.xml"])
+	assert.Equal(t, 1, strings.Count(settings, `<w:updateFields w:val="true"/>`))
+	assert.Less(t, strings.Index(settings, "<w:updateFields"), strings.Index(settings, "<w:compat"))
+}
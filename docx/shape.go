@@ -0,0 +1,150 @@
+package docx
+
+import (
+	"fmt"
+
+	"github.com/MamaShip/godocx/common/units"
+	"github.com/MamaShip/godocx/dml"
+	"github.com/MamaShip/godocx/dml/dmlct"
+	"github.com/MamaShip/godocx/dml/dmlst"
+	"github.com/MamaShip/godocx/wml/ctypes"
+)
+
+// ShapeGroup represents a group of shapes (wpg:wgp) that move and resize together.
+// The group is placed in the document as a floating (anchored) drawing.
+type ShapeGroup struct {
+	root   *RootDoc
+	anchor *dml.Anchor
+	group  *dml.WordprocessingGroup
+
+	// sized is set once the group size has been set explicitly;
+	// until then the group size follows the bounding box of its shapes.
+	sized bool
+}
+
+// AddShapeGroup adds a new paragraph containing an empty shape group to the document.
+//
+// Example:
+//
+//	group := document.AddShapeGroup()
+//	group.AddShape("rect", 0, 0, units.Inch(1).ToEmu(), units.Inch(1).ToEmu())
+//	group.AddShape("ellipse", units.Inch(1).ToEmu(), 0, units.Inch(1).ToEmu(), units.Inch(1).ToEmu())
+//	group.Position(units.Inch(0.5).ToEmu(), 0)
+func (rd *RootDoc) AddShapeGroup() *ShapeGroup {
+	p := rd.AddEmptyParagraph()
+	return p.AddShapeGroup()
+}
+
+// AddShapeGroup adds an empty shape group anchored to this paragraph.
+func (p *Paragraph) AddShapeGroup() *ShapeGroup {
+	id := p.root.nextDrawingID()
+
+	group := dml.NewWordprocessingGroup()
+
+	anchor := dml.NewAnchor()
+	anchor.SimplePos = dmlct.NewPoint2D(0, 0)
+	anchor.PositionH = dml.PoistionH{RelativeFrom: dmlst.RelFromHColumn}
+	anchor.PositionV = dml.PoistionV{RelativeFrom: dmlst.RelFromVParagraph}
+	anchor.LayoutInCell = 1
+	anchor.AllowOverlap = 1
+	anchor.RelativeHeight = int(id)
+	anchor.EffectExtent = dml.NewEffectExtent(0, 0, 0, 0)
+	anchor.WrapTopBtm = &dml.WrapTopBtm{}
+	anchor.DocProp = dml.DocProp{
+		ID:   uint64(id),
+		Name: fmt.Sprintf("Group %d", id),
+	}
+	anchor.CNvGraphicFramePr = &dml.NonVisualGraphicFrameProp{}
+	anchor.Graphic = *dml.NewGroupGraphic(group)
+
+	drawing := &dml.Drawing{}
+	drawing.Anchor = append(drawing.Anchor, anchor)
+
+	run := &ctypes.Run{
+		Children: []ctypes.RunChild{{Drawing: drawing}},
+	}
+	p.ct.Children = append(p.ct.Children, ctypes.ParagraphChild{Run: run})
+
+	return &ShapeGroup{
+		root:   p.root,
+		anchor: anchor,
+		group:  group,
+	}
+}
+
+// AddShape adds a shape with the given preset geometry (e.g. "rect", "ellipse", "line") to the group.
+//
+// Parameters:
+//   - preset: The DrawingML preset geometry name.
+//   - x, y: The offset of the shape within the group, in EMUs.
+//   - width, height: The size of the shape, in EMUs.
+//
+// Returns:
+//   - *dml.WordprocessingShape: The added shape, whose FillColor and LineColor can be customized.
+func (g *ShapeGroup) AddShape(preset string, x, y, width, height units.Emu) *dml.WordprocessingShape {
+	id := g.root.nextDrawingID()
+
+	shape := dml.NewWordprocessingShape(uint(id), fmt.Sprintf("Shape %d", id), preset,
+		uint64(x), uint64(y), uint64(width), uint64(height))
+
+	g.group.AddShape(shape)
+	g.fit()
+
+	return shape
+}
+
+// Shapes returns the shapes contained in the group.
+func (g *ShapeGroup) Shapes() []*dml.WordprocessingShape {
+	return g.group.Shapes
+}
+
+// Position sets the offset of the group from the column (horizontally) and
+// the paragraph (vertically) it is anchored to, in EMUs.
+func (g *ShapeGroup) Position(x, y units.Emu) *ShapeGroup {
+	g.anchor.PositionH.PosOffset = int(x)
+	g.anchor.PositionV.PosOffset = int(y)
+	return g
+}
+
+// Size sets the size of the whole group, in EMUs. Child shapes are scaled to fit.
+func (g *ShapeGroup) Size(width, height units.Emu) *ShapeGroup {
+	g.sized = true
+	g.setExtent(uint64(width), uint64(height))
+	return g
+}
+
+// fit recomputes the child coordinate space from the bounding box of the shapes
+// and, unless the size was set explicitly, makes the group as large as that box.
+func (g *ShapeGroup) fit() {
+	var width, height uint64
+	for _, shape := range g.group.Shapes {
+		tf := shape.ShapeProp.TransformGroup
+		if tf == nil || tf.Extent == nil {
+			continue
+		}
+
+		var x, y uint64
+		if tf.Offset != nil {
+			x, y = tf.Offset.X, tf.Offset.Y
+		}
+
+		if x+tf.Extent.Width > width {
+			width = x + tf.Extent.Width
+		}
+		if y+tf.Extent.Height > height {
+			height = y + tf.Extent.Height
+		}
+	}
+
+	xfrm := &g.group.GroupShapeProp.TransformGroup
+	xfrm.ChildExtent = dmlct.PSize2D{Width: width, Height: height}
+
+	if !g.sized {
+		g.setExtent(width, height)
+	}
+}
+
+func (g *ShapeGroup) setExtent(width, height uint64) {
+	g.group.GroupShapeProp.TransformGroup.Extent = dmlct.PSize2D{Width: width, Height: height}
+	g.anchor.Extent = dmlct.PSize2D{Width: width, Height: height}
+}
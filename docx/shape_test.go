@@ -0,0 +1,75 @@
+package docx
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/MamaShip/godocx/common/units"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddShapeGroup(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	group := rd.AddShapeGroup()
+	group.AddShape("rect", 0, 0, units.Inch(1).ToEmu(), units.Inch(1).ToEmu())
+	group.AddShape("ellipse", units.Inch(1).ToEmu(), 0, units.Inch(1).ToEmu(), units.Inch(2).ToEmu())
+	group.Position(100, 200)
+
+	assert.Len(t, group.Shapes(), 2)
+	assert.Equal(t, uint64(units.Inch(2).ToEmu()), group.anchor.Extent.Width)
+	assert.Equal(t, uint64(units.Inch(2).ToEmu()), group.anchor.Extent.Height)
+
+	var buf bytes.Buffer
+	err := xml.NewEncoder(&buf).Encode(rd.Document.Body)
+	assert.NoError(t, err)
+
+	out := buf.String()
+	start := strings.Index(out, "<wpg:wgp>")
+	end := strings.Index(out, "</wpg:wgp>")
+	assert.True(t, start >= 0 && end > start, "expected a wpg:wgp element in %s", out)
+
+	groupXML := out[start:end]
+	assert.Equal(t, 2, strings.Count(groupXML, "<wps:wsp>"))
+	assert.Contains(t, groupXML, `<a:prstGeom prst="rect">`)
+	assert.Contains(t, groupXML, `<a:prstGeom prst="ellipse">`)
+	assert.Contains(t, out, `<a:graphicData uri="http://schemas.microsoft.com/office/word/2010/wordprocessingGroup">`)
+	assert.Contains(t, out, `<wp:positionH relativeFrom="column"><wp:posOffset>100</wp:posOffset></wp:positionH>`)
+}
+
+func TestShapeGroupSize(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	group := rd.AddShapeGroup().Size(5000, 4000)
+	group.AddShape("rect", 0, 0, 1000, 1000)
+
+	xfrm := group.group.GroupShapeProp.TransformGroup
+	assert.Equal(t, uint64(5000), xfrm.Extent.Width)
+	assert.Equal(t, uint64(4000), xfrm.Extent.Height)
+	assert.Equal(t, uint64(1000), xfrm.ChildExtent.Width)
+	assert.Equal(t, uint64(1000), xfrm.ChildExtent.Height)
+}
+
+func TestShapeGroup_RoundTrip(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	group := rd.AddShapeGroup()
+	fill, line := "FF0000", "0000FF"
+	rect := group.AddShape("rect", 0, 0, 1000, 1000)
+	rect.ShapeProp.FillColor = &fill
+	rect.ShapeProp.LineColor = &line
+	group.AddShape("ellipse", 1000, 0, 1000, 2000)
+
+	saved, err := xml.Marshal(rd.Document)
+	require.NoError(t, err)
+
+	doc, err := LoadDocXml(rd, "word/document.xml", saved)
+	require.NoError(t, err)
+	loaded, err := xml.Marshal(doc)
+	require.NoError(t, err)
+	assert.Equal(t, string(saved), string(loaded))
+	assert.Contains(t, string(loaded), `<a:solidFill><a:srgbClr val="FF0000"></a:srgbClr></a:solidFill><a:ln><a:solidFill><a:srgbClr val="0000FF"></a:srgbClr></a:solidFill></a:ln>`)
+}
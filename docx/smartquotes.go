@@ -0,0 +1,101 @@
+package docx
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// RunOptions controls how text added with AddText is processed.
+type RunOptions struct {
+	// SmartQuotes converts straight quotes and apostrophes to typographic (curly) ones
+	// and double hyphens to dashes, like Word's AutoCorrect as you type.
+	SmartQuotes bool
+}
+
+// SetRunOptions sets the options applied to text added to the document from now on.
+//
+// Example:
+//
+//	document.SetRunOptions(docx.RunOptions{SmartQuotes: true})
+//	document.AddParagraph(`"It's done," she said -- finally.`) // “It’s done,” she said – finally.
+func (rd *RootDoc) SetRunOptions(opts RunOptions) {
+	rd.runOpts = opts
+}
+
+// applyRunOptions returns the text transformed according to the document run options.
+func (rd *RootDoc) applyRunOptions(text string) string {
+	if rd == nil || !rd.runOpts.SmartQuotes {
+		return text
+	}
+	return smartQuotes("", text)
+}
+
+// applyRunOptions returns the text appended to the paragraph transformed according to the
+// document run options, the quotes at its start following the existing text of the paragraph.
+func (p *Paragraph) applyRunOptions(text string) string {
+	if p.root == nil || !p.root.runOpts.SmartQuotes {
+		return text
+	}
+	return smartQuotes(p.Text(), text)
+}
+
+// smartQuotes replaces straight quotes with curly ones and double hyphens with dashes.
+//
+// A quote opens when it starts the text or follows whitespace or an opening bracket,
+// and closes (or is an apostrophe) otherwise. "--" between spaces becomes an en dash,
+// while "--" or "---" joining two words becomes an em dash. The text is taken to follow
+// before, e.g. the existing text of the paragraph it is appended to.
+func smartQuotes(before, text string) string {
+	text = strings.ReplaceAll(text, "---", "—")
+
+	runes := []rune(text)
+	out := make([]rune, 0, len(runes))
+
+	for i := 0; i < len(runes); i++ {
+		var prev rune
+		if len(out) > 0 {
+			prev = out[len(out)-1]
+		} else if before != "" {
+			prev, _ = utf8.DecodeLastRuneInString(before)
+		}
+
+		switch r := runes[i]; {
+		case r == '-' && i+1 < len(runes) && runes[i+1] == '-':
+			i++
+			if prev == ' ' {
+				out = append(out, '–')
+			} else {
+				out = append(out, '—')
+			}
+		case r == '"':
+			if opensQuote(prev) {
+				out = append(out, '“')
+			} else {
+				out = append(out, '”')
+			}
+		case r == '\'':
+			if opensQuote(prev) {
+				out = append(out, '‘')
+			} else {
+				out = append(out, '’')
+			}
+		default:
+			out = append(out, r)
+		}
+	}
+
+	return string(out)
+}
+
+// opensQuote reports whether a quote following prev should be an opening quote.
+func opensQuote(prev rune) bool {
+	switch {
+	case prev == 0, unicode.IsSpace(prev):
+		return true
+	case strings.ContainsRune("([{<–—“‘", prev):
+		return true
+	default:
+		return false
+	}
+}
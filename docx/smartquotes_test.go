@@ -0,0 +1,58 @@
+package docx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSmartQuotes(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`can't`, "can’t"},
+		{`"Hello," she said.`, "“Hello,” she said."},
+		{`'single' quotes`, "‘single’ quotes"},
+		{`("quoted")`, "(“quoted”)"},
+		{`pages 1 -- 5`, "pages 1 – 5"},
+		{`well--maybe`, "well—maybe"},
+		{`well---maybe`, "well—maybe"},
+		{`no change`, "no change"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.expected, smartQuotes("", tt.input))
+		})
+	}
+}
+
+func TestAddTextSmartQuotesOption(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	p := rd.AddParagraph(`"can't"`)
+	assert.Equal(t, `"can't"`, p.ct.Children[0].Run.Children[0].Text.Text)
+
+	rd.SetRunOptions(RunOptions{SmartQuotes: true})
+
+	p = rd.AddParagraph(`"can't"`)
+	assert.Equal(t, "“can’t”", p.ct.Children[0].Run.Children[0].Text.Text)
+
+	rd.SetRunOptions(RunOptions{})
+
+	p = rd.AddParagraph(`"can't"`)
+	assert.Equal(t, `"can't"`, p.ct.Children[0].Run.Children[0].Text.Text)
+}
+
+func TestAddTextSmartQuotes_FollowsParagraphText(t *testing.T) {
+	rd := setupRootDoc(t)
+	rd.SetRunOptions(RunOptions{SmartQuotes: true})
+
+	p := rd.AddParagraph("")
+	p.AddText(`"hi`)
+	p.AddText(`" and 'bye`)
+	p.AddText(`' -- `)
+	p.AddText(`"end"`)
+	assert.Equal(t, "“hi” and ‘bye’ – “end”", p.Text())
+}
@@ -1,6 +1,13 @@
 package docx
 
 import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/MamaShip/godocx/common/constants"
+	"github.com/MamaShip/godocx/internal"
 	"github.com/MamaShip/godocx/wml/ctypes"
 	"github.com/MamaShip/godocx/wml/stypes"
 )
@@ -32,3 +39,543 @@ func (rd *RootDoc) GetStyleByID(styleID string, styleType stypes.StyleType) *cty
 	}
 	return nil
 }
+
+// StyleDefinition describes a paragraph or character style added with AddParagraphStyle
+// or AddCharacterStyle.
+type StyleDefinition struct {
+	ID      string // Style ID referenced by Paragraph.Style and Run.Style; defaults to Name without spaces
+	Name    string // Name displayed in the style gallery; defaults to ID
+	BasedOn string // ID of the parent style, if any
+	Next    string // ID of the style of the following paragraph, for paragraph styles
+
+	Font   string          // Font family; empty to inherit
+	Size   uint64          // Font size in points; 0 to inherit
+	Bold   bool            // Bold text
+	Italic bool            // Italic text
+	Color  string          // Text color in hex format (e.g., "2F5496"); empty to inherit
+	Space  *ctypes.Spacing // Paragraph spacing, for paragraph styles; nil to inherit
+
+	// Full run and paragraph properties of the style, for the formatting not covered by the
+	// fields above, e.g. indentation, borders or small caps. They are copied, and the fields
+	// above take precedence over them. ParaProp is ignored for character styles.
+	RunProp  *ctypes.RunProperty
+	ParaProp *ctypes.ParagraphProp
+}
+
+// ErrStyleID is returned when adding a style definition without an ID or a name.
+var ErrStyleID = errors.New("style definition needs an ID or a name")
+
+// AddParagraphStyle adds a paragraph style to the styles part of the document,
+// replacing the style with the same ID if there is one.
+//
+// Returns:
+//   - error: ErrStyleID if the definition has neither an ID nor a name.
+//
+// Example:
+//
+//	document.AddParagraphStyle(docx.StyleDefinition{
+//		ID:      "Note",
+//		Name:    "Note",
+//		BasedOn: "Normal",
+//		Italic:  true,
+//		Color:   "808080",
+//	})
+//	document.AddParagraph("Remember to save").Style("Note")
+func (rd *RootDoc) AddParagraphStyle(def StyleDefinition) error {
+	_, err := rd.addStyle(def, stypes.StyleTypeParagraph)
+	return err
+}
+
+// AddCharacterStyle adds a character style to the styles part of the document,
+// replacing the style with the same ID if there is one. Space and Next are ignored.
+//
+// See AddParagraphStyle for details.
+func (rd *RootDoc) AddCharacterStyle(def StyleDefinition) error {
+	_, err := rd.addStyle(def, stypes.StyleTypeCharacter)
+	return err
+}
+
+// addStyle adds or replaces the style and returns it. The returned pointer is only valid
+// until the next style is added.
+func (rd *RootDoc) addStyle(def StyleDefinition, styleType stypes.StyleType) (*ctypes.Style, error) {
+	if def.ID == "" {
+		def.ID = strings.ReplaceAll(def.Name, " ", "")
+	}
+	if def.ID == "" {
+		return nil, ErrStyleID
+	}
+	if def.Name == "" {
+		def.Name = def.ID
+	}
+
+	style := ctypes.Style{
+		Type:    &styleType,
+		ID:      internal.ToPtr(def.ID),
+		Name:    ctypes.NewCTString(def.Name),
+		QFormat: &ctypes.OnOff{},
+	}
+	if def.BasedOn != "" {
+		style.BasedOn = ctypes.NewCTString(def.BasedOn)
+	}
+	if def.Next != "" && styleType == stypes.StyleTypeParagraph {
+		style.Next = ctypes.NewCTString(def.Next)
+	}
+	if styleType == stypes.StyleTypeParagraph {
+		if def.ParaProp != nil {
+			style.ParaProp = internal.DeepCopy(def.ParaProp)
+		}
+		if def.Space != nil {
+			space := *def.Space
+			if style.ParaProp == nil {
+				style.ParaProp = &ctypes.ParagraphProp{}
+			}
+			style.ParaProp.Spacing = &space
+		}
+	}
+
+	runProp := &ctypes.RunProperty{}
+	if def.RunProp != nil {
+		runProp = internal.DeepCopy(def.RunProp)
+	}
+	hasRunProp := def.RunProp != nil || def.Font != "" || def.Bold || def.Italic || def.Color != "" || def.Size > 0
+	if def.Font != "" {
+		runProp.Fonts = &ctypes.RunFonts{Ascii: def.Font, HAnsi: def.Font, EastAsia: def.Font, CS: def.Font}
+	}
+	if def.Bold {
+		runProp.Bold = ctypes.OnOffFromBool(true)
+	}
+	if def.Italic {
+		runProp.Italic = ctypes.OnOffFromBool(true)
+	}
+	if def.Color != "" {
+		runProp.Color = ctypes.NewColor(def.Color)
+	}
+	if def.Size > 0 {
+		runProp.Size = ctypes.NewFontSize(def.Size * 2)
+	}
+	if hasRunProp {
+		style.RunProp = runProp
+	}
+
+	return rd.putStyle(style), nil
+}
+
+// TableStyleDefinition describes a table style added with AddTableStyle.
+type TableStyleDefinition struct {
+	ID      string // Style ID referenced by Table.SetStyle; defaults to Name without spaces
+	Name    string // Name displayed in the table style gallery; defaults to ID
+	BasedOn string // ID of the parent table style, if any, e.g. "TableGrid"
+
+	TableProp *ctypes.TableProp     // Formatting of the table, e.g. borders and cell margins; nil to inherit
+	CellProp  *ctypes.CellProperty  // Formatting of every cell, e.g. shading; nil to inherit
+	RunProp   *ctypes.RunProperty   // Formatting of the text of the table; nil to inherit
+	ParaProp  *ctypes.ParagraphProp // Formatting of the paragraphs of the table; nil to inherit
+
+	// Conditional formatting of regions of the table, such as the header row
+	// (stypes.TblStyleOverrideFirstRow) or the odd banded rows (stypes.TblStyleOverrideBand1Horz).
+	// Word only applies the regions enabled by the look of the table, see Table.SetLook.
+	Conditional []ctypes.TableStyleProp
+}
+
+// AddTableStyle adds a table style to the styles part of the document, replacing the style
+// with the same ID if there is one. The properties of the definition are copied.
+//
+// Returns:
+//   - error: ErrStyleID if the definition has neither an ID nor a name.
+//
+// Example:
+//
+//	border := &ctypes.Border{Val: stypes.BorderStyleSingle, Size: internal.ToPtr(4), Color: internal.ToPtr("4472C4")}
+//	document.AddTableStyle(docx.TableStyleDefinition{
+//		ID:        "Invoice",
+//		TableProp: &ctypes.TableProp{Borders: &ctypes.TableBorders{Top: border, Bottom: border, InsideH: border}},
+//		Conditional: []ctypes.TableStyleProp{{
+//			Type:     stypes.TblStyleOverrideFirstRow,
+//			RunProp:  &ctypes.RunProperty{Bold: ctypes.OnOffFromBool(true)},
+//			CellProp: &ctypes.CellProperty{Shading: &ctypes.Shading{Val: stypes.ShdClear, Fill: internal.ToPtr("D9E2F3")}},
+//		}},
+//	})
+//	table.SetStyle("Invoice").SetLook(true, false, false, false, true, true)
+func (rd *RootDoc) AddTableStyle(def TableStyleDefinition) error {
+	if def.ID == "" {
+		def.ID = strings.ReplaceAll(def.Name, " ", "")
+	}
+	if def.ID == "" {
+		return ErrStyleID
+	}
+	if def.Name == "" {
+		def.Name = def.ID
+	}
+
+	style := ctypes.Style{
+		Type:          internal.ToPtr(stypes.StyleTypeTable),
+		ID:            internal.ToPtr(def.ID),
+		Name:          ctypes.NewCTString(def.Name),
+		ParaProp:      internal.DeepCopy(def.ParaProp),
+		RunProp:       internal.DeepCopy(def.RunProp),
+		TableProp:     internal.DeepCopy(def.TableProp),
+		TableCellProp: internal.DeepCopy(def.CellProp),
+		TableStylePr:  internal.DeepCopy(def.Conditional),
+	}
+	if def.BasedOn != "" {
+		style.BasedOn = ctypes.NewCTString(def.BasedOn)
+	}
+
+	rd.putStyle(style)
+	return nil
+}
+
+// putStyle adds the style to the styles part, replacing the style with the same ID and type,
+// and returns it. The returned pointer is only valid until the next style is added.
+func (rd *RootDoc) putStyle(style ctypes.Style) *ctypes.Style {
+	styles := rd.ensureStyles()
+	if idx := styleIndex(styles, *style.ID, *style.Type); idx >= 0 {
+		styles.StyleList[idx] = style
+		return &styles.StyleList[idx]
+	}
+	styles.StyleList = append(styles.StyleList, style)
+	return &styles.StyleList[len(styles.StyleList)-1]
+}
+
+// themeRelType is the type of the relationship of the document to its theme part.
+const themeRelType = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/theme"
+
+var (
+	themeFontPatterns = map[string]*regexp.Regexp{
+		"latin": regexp.MustCompile(`<a:latin\b[^>]*?/>`),
+		"ea":    regexp.MustCompile(`<a:ea\b[^>]*?/>`),
+	}
+	themeFontSchemes = []*regexp.Regexp{
+		regexp.MustCompile(`(?s)<a:majorFont>.*?</a:majorFont>`),
+		regexp.MustCompile(`(?s)<a:minorFont>.*?</a:minorFont>`),
+	}
+)
+
+// SetDefaultFont sets the font and size inherited by every run of the document that does not
+// set its own, in the document defaults of the styles part (w:docDefaults/w:rPrDefault).
+// The document defaults are created if missing; the other default run properties are kept.
+//
+// If the document has a theme, its heading (major) and body (minor) fonts are changed as
+// well, so that the styles referring to the theme fonts, such as the headings, use them.
+//
+// Parameters:
+//   - ascii: The font of Latin text, e.g. "Arial"; empty to keep the current one.
+//   - eastAsia: The font of East Asian text, e.g. "MS Mincho"; empty to keep the current one.
+//   - sizeHalfPts: The font size in half-points, e.g. 22 for 11pt; 0 to keep the current one.
+//
+// Example:
+//
+//	document.SetDefaultFont("Arial", "SimSun", 21) // 10.5pt
+func (rd *RootDoc) SetDefaultFont(ascii, eastAsia string, sizeHalfPts int) {
+	rPr := rd.defaultRunProp()
+
+	if ascii != "" || eastAsia != "" {
+		if rPr.Fonts == nil {
+			rPr.Fonts = &ctypes.RunFonts{}
+		}
+		// Theme fonts take precedence over explicit fonts, so they are removed
+		if ascii != "" {
+			rPr.Fonts.Ascii, rPr.Fonts.HAnsi, rPr.Fonts.CS = ascii, ascii, ascii
+			rPr.Fonts.AsciiTheme, rPr.Fonts.HAnsiTheme, rPr.Fonts.CSTheme = "", "", ""
+		}
+		if eastAsia != "" {
+			rPr.Fonts.EastAsia = eastAsia
+			rPr.Fonts.EastAsiaTheme = ""
+		}
+	}
+
+	if sizeHalfPts > 0 {
+		rPr.Size = ctypes.NewFontSize(uint64(sizeHalfPts))
+		rPr.SizeCs = ctypes.NewFontSizeCS(uint64(sizeHalfPts))
+	}
+
+	rd.setThemeFonts(ascii, eastAsia)
+}
+
+// SetDefaultDirection sets whether the document is a right to left document, e.g. an Arabic
+// or Hebrew one: paragraphs that do not set their own direction are right to left, through
+// the document defaults of the styles part (w:docDefaults/w:pPrDefault), and the last section
+// is laid out from right to left. Sections added afterwards keep the layout.
+//
+// The direction of the text itself is set per run, see Run.RTL.
+//
+// Example:
+//
+//	document.SetDefaultDirection(true)
+//	document.AddParagraph("").AddText("שלום").RTL(true)
+func (rd *RootDoc) SetDefaultDirection(rtl bool) {
+	pPr := rd.defaultParaProp()
+	pPr.Bidi = nil
+	if rtl {
+		pPr.Bidi = ctypes.OnOffFromBool(true)
+	}
+
+	rd.lastSection().SetBiDi(rtl)
+}
+
+// SetDefaultLanguage sets the language of every run of the document that does not set its
+// own, in the document defaults of the styles part, for spelling and hyphenation. The
+// other default run properties are kept.
+//
+// Parameters:
+//   - latin: The language of Latin text, e.g. "en-GB"; empty to keep the current one.
+//   - eastAsia: The language of East Asian text, e.g. "zh-CN"; empty to keep the current one.
+//   - bidi: The language of right to left text, e.g. "ar-SA"; empty to keep the current one.
+func (rd *RootDoc) SetDefaultLanguage(latin, eastAsia, bidi string) {
+	rPr := rd.defaultRunProp()
+	if rPr.Lang == nil {
+		rPr.Lang = &ctypes.Lang{}
+	}
+
+	if latin != "" {
+		rPr.Lang.Val = internal.ToPtr(latin)
+	}
+	if eastAsia != "" {
+		rPr.Lang.EastAsia = internal.ToPtr(eastAsia)
+	}
+	if bidi != "" {
+		rPr.Lang.Bidi = internal.ToPtr(bidi)
+	}
+}
+
+// SetDefaultSpacing sets the spacing of every paragraph of the document that does not set
+// its own, in the document defaults of the styles part, e.g.
+// ctypes.NewParagraphSpacing(0, 160) for 8pt after each paragraph. A nil spacing removes the
+// default spacing. The other default paragraph properties are kept.
+func (rd *RootDoc) SetDefaultSpacing(spacing *ctypes.Spacing) {
+	pPr := rd.defaultParaProp()
+	pPr.Spacing = internal.DeepCopy(spacing)
+}
+
+// defaultRunProp returns the default run properties of the document, creating them if needed.
+func (rd *RootDoc) defaultRunProp() *ctypes.RunProperty {
+	styles := rd.ensureStyles()
+	if styles.DocDefaults == nil {
+		styles.DocDefaults = &ctypes.DocDefault{}
+	}
+	if styles.DocDefaults.RunProp == nil {
+		styles.DocDefaults.RunProp = &ctypes.RunPropDefault{}
+	}
+	if styles.DocDefaults.RunProp.RunProp == nil {
+		styles.DocDefaults.RunProp.RunProp = &ctypes.RunProperty{}
+	}
+	return styles.DocDefaults.RunProp.RunProp
+}
+
+// defaultParaProp returns the default paragraph properties of the document, creating them
+// if needed.
+func (rd *RootDoc) defaultParaProp() *ctypes.ParagraphProp {
+	styles := rd.ensureStyles()
+	if styles.DocDefaults == nil {
+		styles.DocDefaults = &ctypes.DocDefault{}
+	}
+	if styles.DocDefaults.ParaProp == nil {
+		styles.DocDefaults.ParaProp = &ctypes.ParaPropDefault{}
+	}
+	if styles.DocDefaults.ParaProp.ParaProp == nil {
+		styles.DocDefaults.ParaProp.ParaProp = &ctypes.ParagraphProp{}
+	}
+	return styles.DocDefaults.ParaProp.ParaProp
+}
+
+// setThemeFonts sets the Latin and East Asian typefaces of the major and minor fonts of the
+// theme part, if the document has one. Empty typefaces are left unchanged.
+func (rd *RootDoc) setThemeFonts(latin, eastAsia string) {
+	if rd.Document == nil {
+		return
+	}
+
+	for _, rel := range rd.Document.DocRels.Relationships {
+		if rel.Type != themeRelType {
+			continue
+		}
+
+		path := "word/" + rel.Target
+		if strings.HasPrefix(rel.Target, "/") {
+			path = strings.TrimPrefix(rel.Target, "/")
+		}
+		content, ok := rd.FileMap.Load(path)
+		if !ok {
+			return
+		}
+
+		theme := string(content.([]byte))
+		for _, scheme := range themeFontSchemes {
+			theme = scheme.ReplaceAllStringFunc(theme, func(fonts string) string {
+				for element, typeface := range map[string]string{"latin": latin, "ea": eastAsia} {
+					if typeface == "" {
+						continue
+					}
+					// The panose of the previous typeface is dropped
+					fonts = themeFontPatterns[element].ReplaceAllLiteralString(fonts, `<a:`+element+` typeface="`+escapeAttr(typeface)+`"/>`)
+				}
+				return fonts
+			})
+		}
+		rd.FileMap.Store(path, []byte(theme))
+		return
+	}
+}
+
+// ensureStyles returns the styles of the document, creating the styles part if the
+// document has none.
+func (rd *RootDoc) ensureStyles() *ctypes.Styles {
+	if rd.DocStyles == nil {
+		rd.DocStyles = &ctypes.Styles{}
+	}
+	if rd.DocStyles.RelativePath == "" {
+		rd.DocStyles.RelativePath = "word/styles.xml"
+		if rd.Document != nil && !rd.Document.hasRelation(constants.StylesType) {
+			rd.Document.addRelation(constants.StylesType, "styles.xml")
+		}
+		_ = rd.ContentType.AddOverride("/word/styles.xml", constants.ContentTypeStyles)
+	}
+	return rd.DocStyles
+}
+
+// styleIndex returns the index of the style with the given ID and type, or -1.
+func styleIndex(styles *ctypes.Styles, styleID string, styleType stypes.StyleType) int {
+	for i, style := range styles.StyleList {
+		if style.ID != nil && *style.ID == styleID && style.Type != nil && *style.Type == styleType {
+			return i
+		}
+	}
+	return -1
+}
+
+// ensureStyle adds a minimal definition of the built-in style with the given ID, and of the
+// styles it is based on, if the styles part of the document lacks it.
+// Other style IDs are left to the document.
+func (rd *RootDoc) ensureStyle(styleID string, styleType stypes.StyleType) {
+	for styleID != "" {
+		if rd.DocStyles != nil && styleIndex(rd.DocStyles, styleID, styleType) >= 0 {
+			return
+		}
+
+		def, ok := builtinStyle(styleID, styleType)
+		if !ok {
+			return
+		}
+
+		style, _ := rd.addStyle(def, styleType)
+		completeBuiltinStyle(style, styleID)
+		styleID = def.BasedOn
+	}
+}
+
+// builtinStyle returns a minimal definition of a built-in style, close to the Word defaults.
+func builtinStyle(styleID string, styleType stypes.StyleType) (StyleDefinition, bool) {
+	if styleType == stypes.StyleTypeCharacter {
+		switch styleID {
+		case "Strong":
+			return StyleDefinition{ID: styleID, Name: "Strong", Bold: true}, true
+		case "Emphasis":
+			return StyleDefinition{ID: styleID, Name: "Emphasis", Italic: true}, true
+		case "Hyperlink":
+			return StyleDefinition{ID: styleID, Name: "Hyperlink", Color: "0563C1"}, true
+		case "FootnoteReference":
+			return StyleDefinition{ID: styleID, Name: "footnote reference"}, true
+		case "EndnoteReference":
+			return StyleDefinition{ID: styleID, Name: "endnote reference"}, true
+		case "CommentReference":
+			return StyleDefinition{ID: styleID, Name: "annotation reference", Size: 8}, true
+		}
+		return StyleDefinition{}, false
+	}
+
+	if level, ok := headingLevel(styleID); ok {
+		def := StyleDefinition{
+			ID:      styleID,
+			Name:    fmt.Sprintf("heading %d", level),
+			BasedOn: "Normal",
+			Next:    "Normal",
+			Bold:    true,
+			Color:   "2F5496",
+			Size:    11,
+			Space:   ctypes.NewParagraphSpacing(40, 0),
+		}
+		switch level {
+		case 1:
+			def.Size = 16
+			def.Space = ctypes.NewParagraphSpacing(240, 0)
+		case 2:
+			def.Size = 13
+		case 3:
+			def.Size = 12
+		}
+		return def, true
+	}
+
+	if level, ok := tocLevel(styleID); ok {
+		return StyleDefinition{ID: styleID, Name: fmt.Sprintf("toc %d", level), BasedOn: "Normal", Next: "Normal", Space: ctypes.NewParagraphSpacing(0, 100)}, true
+	}
+
+	switch styleID {
+	case "Normal":
+		return StyleDefinition{ID: styleID, Name: "Normal"}, true
+	case "Title":
+		return StyleDefinition{ID: styleID, Name: "Title", BasedOn: "Normal", Next: "Normal", Size: 28}, true
+	case "Subtitle":
+		return StyleDefinition{ID: styleID, Name: "Subtitle", BasedOn: "Normal", Next: "Normal", Color: "5A5A5A"}, true
+	case "Quote":
+		return StyleDefinition{ID: styleID, Name: "Quote", BasedOn: "Normal", Next: "Normal", Italic: true, Color: "404040"}, true
+	case "ListParagraph":
+		return StyleDefinition{ID: styleID, Name: "List Paragraph", BasedOn: "Normal"}, true
+	case "NoSpacing":
+		return StyleDefinition{ID: styleID, Name: "No Spacing", Space: ctypes.NewParagraphSpacing(0, 0)}, true
+	case "FootnoteText":
+		return StyleDefinition{ID: styleID, Name: "footnote text", BasedOn: "Normal", Size: 10, Space: ctypes.NewParagraphSpacing(0, 0)}, true
+	case "EndnoteText":
+		return StyleDefinition{ID: styleID, Name: "endnote text", BasedOn: "Normal", Size: 10, Space: ctypes.NewParagraphSpacing(0, 0)}, true
+	case "CommentText":
+		return StyleDefinition{ID: styleID, Name: "annotation text", BasedOn: "Normal", Size: 10}, true
+	}
+	return StyleDefinition{}, false
+}
+
+// completeBuiltinStyle sets the properties of a built-in style not covered by StyleDefinition.
+func completeBuiltinStyle(style *ctypes.Style, styleID string) {
+	switch styleID {
+	case "Normal":
+		style.Default = internal.ToPtr(stypes.OnOffTrue)
+	case "ListParagraph":
+		style.ParaProp = &ctypes.ParagraphProp{Indent: &ctypes.Indent{Left: internal.ToPtr(720)}}
+	case "Hyperlink":
+		style.RunProp.Underline = ctypes.NewGenSingleStrVal(stypes.UnderlineSingle)
+	case "FootnoteReference", "EndnoteReference":
+		style.RunProp = &ctypes.RunProperty{VertAlign: ctypes.NewGenSingleStrVal(stypes.VerticalAlignRunSuperscript)}
+	}
+
+	if level, ok := headingLevel(styleID); ok {
+		style.ParaProp.KeepNext = &ctypes.OnOff{}
+		style.ParaProp.OutlineLvl = ctypes.NewDecimalNum(level - 1)
+	}
+
+	if level, ok := tocLevel(styleID); ok && level > 1 {
+		style.ParaProp.Indent = &ctypes.Indent{Left: internal.ToPtr(220 * (level - 1))}
+	}
+}
+
+// headingLevel returns the level of a HeadingN style ID.
+func headingLevel(styleID string) (int, bool) {
+	if len(styleID) != len("Heading1") || !strings.HasPrefix(styleID, "Heading") {
+		return 0, false
+	}
+	level := int(styleID[len(styleID)-1] - '0')
+	if level < 1 || level > 9 {
+		return 0, false
+	}
+	return level, true
+}
+
+// tocLevel returns the level of the built-in table of contents style "TOC1" to "TOC9".
+func tocLevel(styleID string) (int, bool) {
+	if len(styleID) != len("TOC1") || !strings.HasPrefix(styleID, "TOC") {
+		return 0, false
+	}
+	level := int(styleID[len(styleID)-1] - '0')
+	if level < 1 || level > 9 {
+		return 0, false
+	}
+	return level, true
+}
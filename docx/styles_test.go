@@ -0,0 +1,308 @@
+package docx
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/MamaShip/godocx/internal"
+	"github.com/MamaShip/godocx/wml/ctypes"
+	"github.com/MamaShip/godocx/wml/stypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func marshalStyles(t *testing.T, rd *RootDoc) string {
+	t.Helper()
+	out, err := xml.Marshal(rd.DocStyles)
+	require.NoError(t, err)
+	return string(out)
+}
+
+func TestParagraphStyle_InjectsBuiltinStyle(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	rd.AddParagraph("Introduction").Style("Heading1")
+	rd.AddParagraph("Background").Style("Heading1")
+
+	heading := rd.GetStyleByID("Heading1", stypes.StyleTypeParagraph)
+	require.NotNil(t, heading)
+	assert.Equal(t, "heading 1", heading.Name.Val)
+	assert.Equal(t, "Normal", heading.BasedOn.Val)
+	assert.Equal(t, 0, heading.ParaProp.OutlineLvl.Val)
+	assert.Equal(t, uint64(32), heading.RunProp.Size.Value)
+
+	normal := rd.GetStyleByID("Normal", stypes.StyleTypeParagraph)
+	require.NotNil(t, normal)
+	assert.Equal(t, stypes.OnOffTrue, *normal.Default)
+
+	styles := marshalStyles(t, rd)
+	assert.Equal(t, 1, strings.Count(styles, `w:styleId="Heading1"`))
+	assert.Equal(t, 1, strings.Count(styles, `w:styleId="Normal"`))
+	assert.Equal(t, "word/styles.xml", rd.DocStyles.RelativePath)
+}
+
+func TestParagraphStyle_KeepsExistingStyle(t *testing.T) {
+	rd := setupRootDoc(t)
+	require.NoError(t, rd.AddParagraphStyle(StyleDefinition{ID: "Heading1", Name: "My heading", Size: 20}))
+
+	rd.AddParagraph("Title").Style("Heading1")
+
+	assert.Len(t, rd.DocStyles.StyleList, 1)
+	assert.Equal(t, "My heading", rd.DocStyles.StyleList[0].Name.Val)
+}
+
+func TestParagraphStyle_UnknownStyleNotInjected(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	rd.AddParagraph("Text").Style("Custom")
+
+	assert.Equal(t, "Custom", rd.Document.Body.Children[0].Para.ct.Property.Style.Val)
+	assert.Empty(t, rd.DocStyles.StyleList)
+}
+
+func TestRunStyle_InjectsBuiltinStyle(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	rd.AddParagraph("").AddText("important").Style("Strong")
+
+	strong := rd.GetStyleByID("Strong", stypes.StyleTypeCharacter)
+	require.NotNil(t, strong)
+	assert.Equal(t, stypes.OnOffTrue, *strong.RunProp.Bold.Val)
+}
+
+func TestAddHeading_InjectsStyle(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	_, err := rd.AddHeading("Summary", 2)
+	require.NoError(t, err)
+
+	assert.NotNil(t, rd.GetStyleByID("Heading2", stypes.StyleTypeParagraph))
+}
+
+func TestAddParagraphStyle(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	err := rd.AddParagraphStyle(StyleDefinition{
+		Name:    "Side Note",
+		BasedOn: "Normal",
+		Next:    "Normal",
+		Font:    "Georgia",
+		Size:    9,
+		Bold:    true,
+		Italic:  true,
+		Color:   "808080",
+		Space:   ctypes.NewParagraphSpacing(120, 60),
+	})
+	require.NoError(t, err)
+
+	styles := marshalStyles(t, rd)
+	assert.Contains(t, styles, `<w:style w:type="paragraph" w:styleId="SideNote"><w:name w:val="Side Note"></w:name><w:basedOn w:val="Normal"></w:basedOn><w:next w:val="Normal"></w:next>`)
+	assert.Contains(t, styles, `<w:spacing w:before="120" w:after="60"></w:spacing>`)
+	assert.Contains(t, styles, `w:ascii="Georgia"`)
+	assert.Contains(t, styles, `<w:sz w:val="18"></w:sz>`)
+	assert.Contains(t, styles, `<w:color w:val="808080"></w:color>`)
+
+	// Redefining the style replaces it
+	require.NoError(t, rd.AddParagraphStyle(StyleDefinition{ID: "SideNote", Name: "Side Note"}))
+	assert.Len(t, rd.DocStyles.StyleList, 1)
+	assert.Nil(t, rd.DocStyles.StyleList[0].RunProp)
+}
+
+func TestAddParagraphStyle_FullProperties(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	runProp := &ctypes.RunProperty{SmallCaps: &ctypes.OnOff{}, Bold: ctypes.OnOffFromBool(false)}
+	paraProp := &ctypes.ParagraphProp{
+		Indent:  &ctypes.Indent{Left: internal.ToPtr(720)},
+		Spacing: ctypes.NewParagraphSpacing(0, 0),
+	}
+	require.NoError(t, rd.AddParagraphStyle(StyleDefinition{
+		ID:       "Quote",
+		Bold:     true,
+		Space:    ctypes.NewParagraphSpacing(240, 240),
+		RunProp:  runProp,
+		ParaProp: paraProp,
+	}))
+
+	quote := rd.GetStyleByID("Quote", stypes.StyleTypeParagraph)
+	require.NotNil(t, quote)
+	assert.Equal(t, 720, *quote.ParaProp.Indent.Left)
+	assert.NotNil(t, quote.RunProp.SmallCaps)
+
+	// The simple fields take precedence, and the given properties are not modified
+	assert.Equal(t, uint64(240), *quote.ParaProp.Spacing.Before)
+	assert.Equal(t, stypes.OnOffTrue, *quote.RunProp.Bold.Val)
+	assert.Equal(t, uint64(0), *paraProp.Spacing.Before)
+	assert.Equal(t, stypes.OnOffFalse, *runProp.Bold.Val)
+	assert.NotSame(t, paraProp.Indent, quote.ParaProp.Indent)
+
+	styles := marshalStyles(t, rd)
+	assert.Contains(t, styles, `<w:ind w:left="720"></w:ind>`)
+	assert.Contains(t, styles, `<w:smallCaps></w:smallCaps>`)
+
+	require.NoError(t, rd.AddCharacterStyle(StyleDefinition{ID: "Caps", RunProp: runProp, ParaProp: paraProp}))
+	caps := rd.GetStyleByID("Caps", stypes.StyleTypeCharacter)
+	assert.Nil(t, caps.ParaProp)
+	assert.NotNil(t, caps.RunProp.SmallCaps)
+}
+
+func TestAddParagraphStyle_NeedsID(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	assert.ErrorIs(t, rd.AddParagraphStyle(StyleDefinition{Bold: true}), ErrStyleID)
+	assert.Empty(t, rd.DocStyles.StyleList)
+}
+
+func TestAddCharacterStyle(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	require.NoError(t, rd.AddCharacterStyle(StyleDefinition{ID: "Code", Font: "Consolas", Space: ctypes.NewParagraphSpacing(0, 0)}))
+
+	code := rd.GetStyleByID("Code", stypes.StyleTypeCharacter)
+	require.NotNil(t, code)
+	assert.Nil(t, code.ParaProp)
+	assert.Equal(t, "Consolas", code.RunProp.Fonts.Ascii)
+}
+
+func TestAddTableStyle(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	border := &ctypes.Border{Val: stypes.BorderStyleSingle, Size: internal.ToPtr(4), Color: internal.ToPtr("4472C4")}
+	header := &ctypes.RunProperty{Bold: ctypes.OnOffFromBool(true)}
+	require.NoError(t, rd.AddTableStyle(TableStyleDefinition{
+		Name:      "Invoice Table",
+		BasedOn:   "TableNormal",
+		TableProp: &ctypes.TableProp{Borders: &ctypes.TableBorders{Top: border, InsideH: border}},
+		Conditional: []ctypes.TableStyleProp{
+			{Type: stypes.TblStyleOverrideFirstRow, RunProp: header},
+			{Type: stypes.TblStyleOverrideBand1Horz, CellProp: &ctypes.CellProperty{Shading: &ctypes.Shading{Val: stypes.ShdClear, Fill: internal.ToPtr("D9E2F3")}}},
+		},
+	}))
+	header.Bold = nil
+
+	tbl := rd.AddTable(2, 2).SetStyle("InvoiceTable")
+	assert.Equal(t, "InvoiceTable", tbl.ct.TableProp.Style.Val)
+
+	styles := marshalStyles(t, rd)
+	assert.Contains(t, styles, `<w:style w:type="table" w:styleId="InvoiceTable"><w:name w:val="Invoice Table"></w:name><w:basedOn w:val="TableNormal"></w:basedOn>`+
+		`<w:tblPr><w:tblBorders><w:top w:val="single" w:color="4472C4" w:sz="4"></w:top><w:insideH w:val="single" w:color="4472C4" w:sz="4"></w:insideH></w:tblBorders></w:tblPr>`+
+		`<w:tblStylePr w:type="firstRow"><w:rPr><w:b w:val="true"></w:b></w:rPr></w:tblStylePr>`+
+		`<w:tblStylePr w:type="band1Horz"><w:tcPr><w:shd w:val="clear" w:fill="D9E2F3"></w:shd></w:tcPr></w:tblStylePr></w:style>`)
+
+	loaded, err := LoadStyles("word/styles.xml", []byte(styles))
+	require.NoError(t, err)
+	require.Len(t, loaded.StyleList, 1)
+	require.Len(t, loaded.StyleList[0].TableStylePr, 2)
+	assert.Equal(t, stypes.TblStyleOverrideBand1Horz, loaded.StyleList[0].TableStylePr[1].Type)
+
+	// Redefining the style replaces it
+	require.NoError(t, rd.AddTableStyle(TableStyleDefinition{ID: "InvoiceTable"}))
+	assert.Len(t, rd.DocStyles.StyleList, 1)
+	assert.Empty(t, rd.DocStyles.StyleList[0].TableStylePr)
+	assert.ErrorIs(t, rd.AddTableStyle(TableStyleDefinition{}), ErrStyleID)
+}
+
+func TestSetDefaultFont(t *testing.T) {
+	rd := setupRootDoc(t)
+	rd.SetDefaultFont("Arial", "SimSun", 21)
+
+	require.NotNil(t, rd.DocStyles.DocDefaults)
+	rPr := rd.DocStyles.DocDefaults.RunProp.RunProp
+	assert.Equal(t, "Arial", rPr.Fonts.Ascii)
+	assert.Equal(t, "Arial", rPr.Fonts.HAnsi)
+	assert.Equal(t, "SimSun", rPr.Fonts.EastAsia)
+	assert.Equal(t, uint64(21), rPr.Size.Value)
+	assert.Equal(t, uint64(21), rPr.SizeCs.Value)
+	assert.Contains(t, marshalStyles(t, rd), `<w:docDefaults><w:rPrDefault><w:rPr><w:rFonts`)
+}
+
+func TestSetDefaultDirection(t *testing.T) {
+	rd := setupRootDoc(t)
+	rd.SetDefaultDirection(true)
+
+	pPr := rd.DocStyles.DocDefaults.ParaProp.ParaProp
+	require.NotNil(t, pPr.Bidi)
+	assert.Contains(t, marshalStyles(t, rd), `<w:pPrDefault><w:pPr><w:bidi w:val="true"></w:bidi></w:pPr></w:pPrDefault>`)
+	require.NotNil(t, rd.Document.Body.SectPr.Bidi)
+
+	// New sections keep the layout
+	next := rd.AddSectionBreak(stypes.SectionMarkNextPage)
+	assert.NotNil(t, rd.Document.Body.SectPr.Bidi)
+	next.SetBiDi(false)
+	assert.Nil(t, rd.Document.Body.SectPr.Bidi)
+
+	rd.SetDefaultDirection(false)
+	assert.Nil(t, pPr.Bidi)
+}
+
+func TestSetDefaultFont_MergesDefaults(t *testing.T) {
+	rd := setupRootDoc(t)
+	lang := "en-US"
+	rd.DocStyles.DocDefaults = &ctypes.DocDefault{
+		RunProp: &ctypes.RunPropDefault{RunProp: &ctypes.RunProperty{
+			Fonts: &ctypes.RunFonts{AsciiTheme: stypes.ThemeFontMinorHAnsi, HAnsiTheme: stypes.ThemeFontMinorHAnsi, EastAsiaTheme: stypes.ThemeFontMinorEastAsia, CSTheme: stypes.ThemeFontMinorBidi},
+			Size:  ctypes.NewFontSize(22),
+			Lang:  &ctypes.Lang{Val: &lang},
+		}},
+	}
+
+	rd.SetDefaultFont("Georgia", "", 0)
+
+	rPr := rd.DocStyles.DocDefaults.RunProp.RunProp
+	assert.Equal(t, "Georgia", rPr.Fonts.Ascii)
+	assert.Empty(t, rPr.Fonts.AsciiTheme)
+	assert.Empty(t, rPr.Fonts.HAnsiTheme)
+	assert.Equal(t, stypes.ThemeFontMinorEastAsia, rPr.Fonts.EastAsiaTheme)
+	assert.Equal(t, uint64(22), rPr.Size.Value)
+	require.NotNil(t, rPr.Lang)
+	assert.Equal(t, "en-US", *rPr.Lang.Val)
+}
+
+func TestSetDefaultLanguageAndSpacing(t *testing.T) {
+	rd := setupRootDoc(t)
+	rd.SetDefaultFont("Georgia", "", 24)
+	rd.SetDefaultDirection(true)
+
+	rd.SetDefaultLanguage("en-GB", "zh-CN", "")
+	rd.SetDefaultLanguage("fr-FR", "", "ar-SA")
+	spacing := ctypes.NewParagraphSpacing(0, 160)
+	rd.SetDefaultSpacing(spacing)
+	*spacing.After = 0
+
+	defaults := rd.DocStyles.DocDefaults
+	rPr := defaults.RunProp.RunProp
+	assert.Equal(t, "Georgia", rPr.Fonts.Ascii)
+	assert.Equal(t, &ctypes.Lang{Val: internal.ToPtr("fr-FR"), EastAsia: internal.ToPtr("zh-CN"), Bidi: internal.ToPtr("ar-SA")}, rPr.Lang)
+	pPr := defaults.ParaProp.ParaProp
+	assert.NotNil(t, pPr.Bidi)
+	assert.Equal(t, uint64(160), *pPr.Spacing.After)
+
+	styles := marshalStyles(t, rd)
+	assert.Contains(t, styles, `<w:lang w:val="fr-FR" w:eastAsia="zh-CN" w:bidi="ar-SA"></w:lang>`)
+	assert.Contains(t, styles, `<w:spacing w:before="0" w:after="160"></w:spacing>`)
+
+	rd.SetDefaultSpacing(nil)
+	assert.Nil(t, pPr.Spacing)
+	assert.NotNil(t, pPr.Bidi)
+}
+
+func TestSetDefaultFont_UpdatesTheme(t *testing.T) {
+	rd := setupRootDoc(t)
+	rd.Document.DocRels.Relationships = append(rd.Document.DocRels.Relationships, &Relationship{
+		ID: "rId9", Type: themeRelType, Target: "theme/theme1.xml",
+	})
+	rd.FileMap.Store("word/theme/theme1.xml", []byte(`<a:theme><a:fontScheme name="Office">`+
+		`<a:majorFont><a:latin typeface="Calibri" panose="020F0302020204030204"/><a:ea typeface=""/></a:majorFont>`+
+		`<a:minorFont><a:latin typeface="Cambria"/><a:ea typeface=""/></a:minorFont>`+
+		`</a:fontScheme><a:fmtScheme><a:latin typeface="Other"/></a:fmtScheme></a:theme>`))
+
+	rd.SetDefaultFont("Arial & Co", "SimSun", 0)
+
+	theme, ok := rd.FileMap.Load("word/theme/theme1.xml")
+	require.True(t, ok)
+	assert.Equal(t, `<a:theme><a:fontScheme name="Office">`+
+		`<a:majorFont><a:latin typeface="Arial &amp; Co"/><a:ea typeface="SimSun"/></a:majorFont>`+
+		`<a:minorFont><a:latin typeface="Arial &amp; Co"/><a:ea typeface="SimSun"/></a:minorFont>`+
+		`</a:fontScheme><a:fmtScheme><a:latin typeface="Other"/></a:fmtScheme></a:theme>`, string(theme.([]byte)))
+}
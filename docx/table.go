@@ -3,16 +3,23 @@ package docx
 import (
 	"encoding/xml"
 
+	"github.com/MamaShip/godocx/internal"
 	"github.com/MamaShip/godocx/wml/ctypes"
 	"github.com/MamaShip/godocx/wml/stypes"
 )
 
+// defaultCellMargin is the left and right margin of table cells in twips, as in Word.
+const defaultCellMargin = 108
+
 type Table struct {
 	// Reverse inheriting the Rootdoc into paragraph to access other elements
 	root *RootDoc
 
 	// Table Complex Type
 	ct ctypes.Table
+
+	// Cell wrappers created by the table builder, so that Cell returns the same instance
+	cells map[*ctypes.Cell]*Cell
 }
 
 func (t *Table) unmarshalXML(d *xml.Decoder, start xml.StartElement) error {
@@ -74,8 +81,13 @@ func NewTable(root *RootDoc) *Table {
 // AddTable adds a new table to the root document.
 //
 // It creates and initializes a new table, appends it to the root document's body, and returns a pointer to the created table.
-// The table is initially empty, with no rows or cells. To add content to the table, use the provided methods on the returned
-// table instance.
+//
+// Called without arguments, the table is initially empty, with no rows or cells. To add content to the table, use the
+// provided methods on the returned table instance.
+//
+// Called with a number of rows and columns, the table is built with that many empty cells, which are accessed with
+// Table.Cell. The columns share the content width of the section equally and the "TableGrid" style is applied so that
+// gridlines are rendered.
 //
 // Example usage:
 //   document := godocx.NewDocument()
@@ -87,18 +99,26 @@ func NewTable(root *RootDoc) *Table {
 //   cell := row.AddCell()
 //   cell.AddParagraph("Hello, World!")
 //
+//   // Or build a grid of cells at once
+//   grid := document.AddTable(2, 3)
+//   grid.Cell(0, 0).AddParagraph("Name")
+//
 // Parameters:
-//   - rd: A pointer to the RootDoc instance.
+//   - size: Optionally the number of rows followed by the number of columns.
 //
 // Returns:
 //   - *elements.Table: A pointer to the newly added table.
 
-func (rd *RootDoc) AddTable() *Table {
+func (rd *RootDoc) AddTable(size ...int) *Table {
 	tbl := Table{
 		root: rd,
 		ct:   *ctypes.DefaultTable(),
 	}
 
+	if len(size) >= 2 {
+		tbl.build(size[0], size[1], rd.ContentWidth())
+	}
+
 	rd.Document.Body.Children = append(rd.Document.Body.Children, DocumentChild{
 		Table: &tbl,
 	})
@@ -106,6 +126,210 @@ func (rd *RootDoc) AddTable() *Table {
 	return &tbl
 }
 
+// AddTableFromData adds a new table holding the given text, one row per slice and one cell per string.
+//
+// The table has as many columns as the longest row; cells missing from shorter rows are left empty.
+// See AddTable for the layout of the table.
+//
+// Example:
+//
+//	document.AddTableFromData([][]string{
+//		{"Product", "Units"},
+//		{"Apples", "12"},
+//	})
+func (rd *RootDoc) AddTableFromData(data [][]string) *Table {
+	cols := 0
+	for _, row := range data {
+		if len(row) > cols {
+			cols = len(row)
+		}
+	}
+
+	tbl := rd.AddTable(len(data), cols)
+	for r, row := range data {
+		for c, text := range row {
+			tbl.Cell(r, c).AddParagraph(text)
+		}
+	}
+
+	return tbl
+}
+
+// build fills the table with rows and columns of empty cells sharing the width equally.
+func (t *Table) build(rows, cols, width int) {
+	if rows <= 0 || cols <= 0 {
+		return
+	}
+
+	t.Style("TableGrid")
+	t.Width(0, stypes.TableWidthAuto)
+
+	colWidth := width / cols
+	widths := make([]uint64, cols)
+	for i := range widths {
+		widths[i] = uint64(colWidth)
+	}
+	t.Grid(widths...)
+
+	t.cells = make(map[*ctypes.Cell]*Cell, rows*cols)
+	for r := 0; r < rows; r++ {
+		row := t.AddRow()
+		for c := 0; c < cols; c++ {
+			cell := row.AddCell().Width(colWidth, stypes.TableWidthDxa)
+
+			// A cell must contain a paragraph; it is replaced by the first one added
+			cell.placeholder = cell.AddEmptyPara()
+			t.cells[cell.ct] = cell
+		}
+	}
+}
+
+// Row returns the row at the given zero-based index, or nil if there is no such row.
+func (t *Table) Row(index int) *Row {
+	for _, rowContent := range t.ct.RowContents {
+		if rowContent.Row == nil {
+			continue
+		}
+		if index == 0 {
+			return &Row{root: t.root, ct: rowContent.Row, table: t}
+		}
+		index--
+	}
+	return nil
+}
+
+// Cell returns the cell at the given zero-based row and column, or nil if there is no such cell.
+//
+// Columns are counted by cell, so a cell spanning several grid columns counts once.
+func (t *Table) Cell(row, col int) *Cell {
+	r := t.Row(row)
+	if r == nil {
+		return nil
+	}
+	return r.Cell(col)
+}
+
+// Rows returns the rows of the table, in order.
+func (t *Table) Rows() []*Row {
+	var rows []*Row
+	for _, rowContent := range t.ct.RowContents {
+		if rowContent.Row != nil {
+			rows = append(rows, &Row{root: t.root, ct: rowContent.Row, table: t})
+		}
+	}
+	return rows
+}
+
+// SetColumnWidths sets the width of the table columns, in twips.
+//
+// The table grid and the width of every cell are updated; a cell spanning several
+// columns gets the sum of their widths. Cells beyond the given widths keep their width.
+//
+// Returns:
+//   - *Table: The table instance for method chaining.
+func (t *Table) SetColumnWidths(widths []int) *Table {
+	t.ct.Grid.Col = nil
+	for _, w := range widths {
+		t.Grid(uint64(w))
+	}
+
+	for _, rowContent := range t.ct.RowContents {
+		if rowContent.Row == nil {
+			continue
+		}
+
+		gridCol := 0
+		for _, cellContent := range rowContent.Row.Contents {
+			cell := cellContent.Cell
+			if cell == nil {
+				continue
+			}
+
+			span := cellSpan(cell)
+			if gridCol+span <= len(widths) {
+				width := 0
+				for _, w := range widths[gridCol : gridCol+span] {
+					width += w
+				}
+				if cell.Property == nil {
+					cell.Property = &ctypes.CellProperty{}
+				}
+				cell.Property.Width = ctypes.NewTableWidth(width, stypes.TableWidthDxa)
+			}
+
+			gridCol += span
+		}
+	}
+
+	return t
+}
+
+// SetBorders sets the borders of the table (w:tblBorders), including the inside
+// horizontal and vertical edges between cells.
+//
+// Returns:
+//   - *Table: The table instance for method chaining.
+//
+// Example:
+//
+//	border := &ctypes.Border{Val: stypes.BorderStyleSingle, Size: internal.ToPtr(4), Color: internal.ToPtr("auto")}
+//	table.SetBorders(&ctypes.TableBorders{Top: border, Left: border, Bottom: border, Right: border, InsideH: border, InsideV: border})
+func (t *Table) SetBorders(borders *ctypes.TableBorders) *Table {
+	t.ct.TableProp.Borders = borders
+	return t
+}
+
+// SetAllBorders sets the same border on the outer edges of the table and on the inside
+// horizontal and vertical edges between cells, as the "All Borders" button of Word.
+//
+// Parameters:
+//   - style: The line style, e.g. stypes.BorderStyleSingle; stypes.BorderStyleNone removes the borders.
+//   - size: The width of the line in eighths of a point, e.g. 4 for half a point.
+//   - color: The color of the line as a hex RGB value such as "FF0000", or "auto".
+//
+// Returns:
+//   - *Table: The table instance for method chaining.
+func (t *Table) SetAllBorders(style stypes.BorderStyle, size int, color string) *Table {
+	border := func() *ctypes.Border {
+		return &ctypes.Border{Val: style, Size: internal.ToPtr(size), Color: internal.ToPtr(color)}
+	}
+	return t.SetBorders(&ctypes.TableBorders{
+		Top:     border(),
+		Left:    border(),
+		Bottom:  border(),
+		Right:   border(),
+		InsideH: border(),
+		InsideV: border(),
+	})
+}
+
+// SetAlignment sets the alignment of the table between the margins of the page (w:jc),
+// e.g. stypes.JustificationCenter to center it.
+//
+// Returns:
+//   - *Table: The table instance for method chaining.
+func (t *Table) SetAlignment(jc stypes.Justification) *Table {
+	t.ct.TableProp.Justification = ctypes.NewGenSingleStrVal(jc)
+	return t
+}
+
+// SetHeaderRows makes the first n rows of the table its header rows, repeated at the top
+// of each page when the table spans several pages, and kept on a single page. The other
+// rows are no longer header rows.
+//
+// Returns:
+//   - *Table: The table instance for method chaining.
+func (t *Table) SetHeaderRows(n int) *Table {
+	for i, row := range t.Rows() {
+		if i < n {
+			row.SetAsHeader(true).CantSplit(true)
+		} else if row.IsHeader() {
+			row.ct.Property.Header = nil
+		}
+	}
+	return t
+}
+
 // AddRow adds a new row to the table.
 //
 // It creates a new row and appends it to the table's row contents. Use this method to construct the structure
@@ -116,12 +340,13 @@ func (rd *RootDoc) AddTable() *Table {
 
 func (t *Table) AddRow() *Row {
 	row := Row{
-		root: t.root,
-		ct:   *ctypes.DefaultRow(),
+		root:  t.root,
+		ct:    ctypes.DefaultRow(),
+		table: t,
 	}
 
 	t.ct.RowContents = append(t.ct.RowContents, ctypes.RowContent{
-		Row: &row.ct,
+		Row: row.ct,
 	})
 
 	return &row
@@ -130,6 +355,25 @@ func (t *Table) AddRow() *Row {
 func (t *Table) ensureProp() {
 }
 
+// SetLook sets the parts of the table style applied to the table (w:tblLook), as the
+// "Table Style Options" of Word do: the special formatting of the header row, the total
+// row, the first and last columns, and the banding of the rows and columns.
+//
+// Word applies the header row and banded rows of a style when no look is set; banded
+// table styles such as "LightList-Accent1" need the look to render as in Word otherwise.
+//
+// Returns:
+//   - *Table: The table instance for method chaining.
+//
+// Example:
+//
+//	table.Style("LightList-Accent1")
+//	table.SetLook(true, false, true, false, false, true) // Word's default look
+func (t *Table) SetLook(firstRow, lastRow, firstCol, lastCol, noHBand, noVBand bool) *Table {
+	t.ct.TableProp.TableLook = ctypes.NewTableLook(firstRow, lastRow, firstCol, lastCol, noHBand, noVBand)
+	return t
+}
+
 // Indent sets the indent width for the table.
 //
 // Parameters:
@@ -187,60 +431,233 @@ func (t *Table) Style(value string) {
 	t.ct.TableProp.Style = ctypes.NewCTString(value)
 }
 
+// SetStyle sets the style of the table, like Style, e.g. a style added with
+// RootDoc.AddTableStyle. The regions of the style that are applied are set with SetLook.
+//
+// Returns:
+//   - *Table: The table instance for method chaining.
+func (t *Table) SetStyle(styleID string) *Table {
+	t.Style(styleID)
+	return t
+}
+
 // Row Wrapper
 type Row struct {
 	// Reverse inheriting the Rootdoc into paragraph to access other elements
 	root *RootDoc
 
 	// Row Complex Type
-	ct ctypes.Row
+	ct *ctypes.Row
+
+	// Table containing the row
+	table *Table
 }
 
 // Add Cell to row and returns Cell
 func (r *Row) AddCell() *Cell {
 	cell := Cell{
-		root: r.root,
-		ct:   *ctypes.DefaultCell(),
+		root:  r.root,
+		ct:    ctypes.DefaultCell(),
+		table: r.table,
 	}
 
 	r.ct.Contents = append(r.ct.Contents, ctypes.TRCellContent{
-		Cell: &cell.ct,
+		Cell: cell.ct,
 	})
 
 	return &cell
 }
 
+// Cell returns the cell at the given zero-based index in the row, or nil if there is no such
+// cell. A cell spanning several grid columns counts once.
+func (r *Row) Cell(index int) *Cell {
+	for _, cellContent := range r.ct.Contents {
+		if cellContent.Cell == nil {
+			continue
+		}
+		if index == 0 {
+			return r.cellWrapper(cellContent.Cell)
+		}
+		index--
+	}
+	return nil
+}
+
+// Cells returns the cells of the row, in order.
+func (r *Row) Cells() []*Cell {
+	var cells []*Cell
+	for _, cellContent := range r.ct.Contents {
+		if cellContent.Cell != nil {
+			cells = append(cells, r.cellWrapper(cellContent.Cell))
+		}
+	}
+	return cells
+}
+
+// cellWrapper returns the wrapper of a cell of the row, the one created by the table builder
+// if there is one.
+func (r *Row) cellWrapper(ct *ctypes.Cell) *Cell {
+	if r.table != nil {
+		if cell, ok := r.table.cells[ct]; ok {
+			return cell
+		}
+	}
+	return &Cell{root: r.root, ct: ct, table: r.table}
+}
+
+// SetHeight sets the height of the row, in twips.
+//
+// Parameters:
+//   - height: The row height.
+//   - rule: How the height is applied: stypes.HeightRuleAtLeast, stypes.HeightRuleExact or stypes.HeightRuleAuto.
+//
+// Returns:
+//   - *Row: The row instance for method chaining.
+func (r *Row) SetHeight(height int, rule stypes.HeightRule) *Row {
+	r.ensureProp().Height = ctypes.NewTableRowHeight(height, rule)
+	return r
+}
+
+// SetAsHeader sets whether the row is a header row, repeated at the top of each page when
+// the table spans several pages (w:tblHeader).
+//
+// Word only repeats header rows that start the table, so the header rows should be the
+// first rows of the table.
+//
+// Returns:
+//   - *Row: The row instance for method chaining.
+func (r *Row) SetAsHeader(header bool) *Row {
+	r.ensureProp().Header = ctypes.OnOffFromBool(header)
+	return r
+}
+
+// CantSplit sets whether the row is kept on a single page, rather than split across a page
+// break when its content does not fit on the current page (w:cantSplit).
+//
+// Returns:
+//   - *Row: The row instance for method chaining.
+func (r *Row) CantSplit(cantSplit bool) *Row {
+	r.ensureProp().CantSplit = ctypes.OnOffFromBool(cantSplit)
+	return r
+}
+
+// IsHeader reports whether the row is a header row, see SetAsHeader.
+func (r *Row) IsHeader() bool {
+	return r.ct.Property != nil && isOn(r.ct.Property.Header)
+}
+
+// IsCantSplit reports whether the row is kept on a single page, see CantSplit.
+func (r *Row) IsCantSplit() bool {
+	return r.ct.Property != nil && isOn(r.ct.Property.CantSplit)
+}
+
+func (r *Row) ensureProp() *ctypes.RowProperty {
+	if r.ct.Property == nil {
+		r.ct.Property = &ctypes.RowProperty{}
+	}
+	return r.ct.Property
+}
+
+// isOn reports whether the on/off property is present and turned on: the element turns
+// the property on unless its value is explicitly off.
+func isOn(o *ctypes.OnOff) bool {
+	if o == nil {
+		return false
+	}
+	return o.Val == nil || (*o.Val != stypes.OnOffFalse && *o.Val != stypes.OnOffZero && *o.Val != stypes.OnOffOff)
+}
+
 // Cell Wrapper
 type Cell struct {
 	// Reverse inheriting the Rootdoc into paragraph to access other elements
 	root *RootDoc
 
 	// Cell Complex Type
-	ct ctypes.Cell
+	ct *ctypes.Cell
+
+	// Table containing the cell
+	table *Table
+
+	// Empty paragraph ending the cell, added by the table builder or after a nested table, and
+	// replaced by the next paragraph added to the cell
+	placeholder *Paragraph
 }
 
 // Adds paragraph with text and returns Paragraph
 func (c *Cell) AddParagraph(text string) *Paragraph {
 	p := newParagraph(c.root, paraWithText(text))
-	tblContent := ctypes.TCBlockContent{
-		Paragraph: &p.ct,
-	}
-
-	c.ct.Contents = append(c.ct.Contents, tblContent)
-
+	c.addParagraph(p)
 	return p
 }
 
 // Add empty paragraph without any text and returns Paragraph
 func (c *Cell) AddEmptyPara() *Paragraph {
 	p := newParagraph(c.root)
-	tblContent := ctypes.TCBlockContent{
+	c.addParagraph(p)
+	return p
+}
+
+func (c *Cell) addParagraph(p *Paragraph) {
+	c.removePlaceholder()
+	c.ct.Contents = append(c.ct.Contents, ctypes.TCBlockContent{
 		Paragraph: &p.ct,
+	})
+}
+
+// AddTable adds a table nested in the cell, after its content, e.g. for the lines of an
+// invoice or a layout grid. It takes the same optional size as RootDoc.AddTable, the columns
+// sharing the width of the cell.
+//
+// As Word requires a cell to end with a paragraph, an empty paragraph is added after the
+// table; it is replaced by the next paragraph added to the cell.
+//
+// Returns:
+//   - *Table: A pointer to the nested table.
+//
+// Example:
+//
+//	lines := invoice.Cell(1, 0).AddTable(3, 2)
+//	lines.Cell(0, 0).AddParagraph("Item")
+func (c *Cell) AddTable(size ...int) *Table {
+	tbl := &Table{
+		root: c.root,
+		ct:   *ctypes.DefaultTable(),
+	}
+
+	if len(size) >= 2 {
+		tbl.build(size[0], size[1], c.contentWidth())
 	}
 
-	c.ct.Contents = append(c.ct.Contents, tblContent)
+	c.removePlaceholder()
+	c.ct.Contents = append(c.ct.Contents, ctypes.TCBlockContent{
+		Table: &tbl.ct,
+	})
+	c.placeholder = c.AddEmptyPara()
 
-	return p
+	return tbl
+}
+
+// removePlaceholder removes the empty paragraph ending the cell, if it is still there.
+func (c *Cell) removePlaceholder() {
+	if c.placeholder == nil {
+		return
+	}
+
+	if n := len(c.ct.Contents); n > 0 && c.ct.Contents[n-1].Paragraph == &c.placeholder.ct {
+		c.ct.Contents = c.ct.Contents[:n-1]
+	}
+	c.placeholder = nil
+}
+
+// contentWidth returns the width in twips available to the content of the cell: its width
+// less the default cell margins of Word, or the content width of the section if the cell
+// has no width in twips.
+func (c *Cell) contentWidth() int {
+	if prop := c.ct.Property; prop != nil && prop.Width != nil && prop.Width.Width != nil &&
+		prop.Width.WidthType != nil && *prop.Width.WidthType == stypes.TableWidthDxa && *prop.Width.Width > 2*defaultCellMargin {
+		return *prop.Width.Width - 2*defaultCellMargin
+	}
+	return c.root.ContentWidth()
 }
 
 // ColSpan sets the number of columns a cell should span across in a table.
@@ -277,18 +694,40 @@ func (c *Cell) VerticalAlign(valign string) *Cell {
 	return c
 }
 
+// BackgroundColor sets the fill color of the cell, as a hex RGB value such as "FFCC00".
 func (c *Cell) BackgroundColor(color string) *Cell {
+	c.ensureProp()
+	if c.ct.Property.Shading == nil {
+		c.ct.Property.Shading = ctypes.DefaultShading()
+	}
 	c.ct.Property.Shading.Fill = &color
 	return c
 }
 
+// SetShading sets the shading of the cell: a pattern drawn in the color over the fill color,
+// both hex RGB values such as "FFCC00" or "auto".
+//
+// Example:
+//
+//	cell.SetShading(stypes.ShdPct25, "000000", "FFFFFF")
+func (c *Cell) SetShading(pattern stypes.Shading, color, fill string) *Cell {
+	c.ensureProp()
+	c.ct.Property.Shading = &ctypes.Shading{Val: pattern, Color: &color, Fill: &fill}
+	return c
+}
+
+// Width sets the preferred width of the cell.
 func (c *Cell) Width(width int, widthType stypes.TableWidth) *Cell {
+	c.ensureProp()
 	c.ct.Property.Width = ctypes.NewTableWidth(width, widthType)
 	return c
 }
 
+// Borders sets the borders of the cell, replacing the table borders on its edges.
+// Nil borders are left to the table.
 func (c *Cell) Borders(top *ctypes.Border, left *ctypes.Border, bottom *ctypes.Border, right *ctypes.Border,
 	insideH *ctypes.Border, insideV *ctypes.Border, tl2br *ctypes.Border, tr2bl *ctypes.Border) *Cell {
+	c.ensureProp()
 	c.ct.Property.Borders = &ctypes.CellBorders{
 		Top:     top,
 		Left:    left,
@@ -301,3 +740,45 @@ func (c *Cell) Borders(top *ctypes.Border, left *ctypes.Border, bottom *ctypes.B
 	}
 	return c
 }
+
+// Margins sets the space between the borders and the content of the cell, replacing the
+// default cell margins of the table, see Table.CellMargin. Nil margins are left to the table.
+//
+// Returns:
+//   - *Cell: The cell instance for method chaining.
+//
+// Example:
+//
+//	cell.Margins(ctypes.NewTableWidth(0, stypes.TableWidthDxa), nil, ctypes.NewTableWidth(0, stypes.TableWidthDxa), nil)
+func (c *Cell) Margins(top *ctypes.TableWidth, left *ctypes.TableWidth, bottom *ctypes.TableWidth, right *ctypes.TableWidth) *Cell {
+	c.ensureProp()
+	c.ct.Property.Margins = &ctypes.CellMargins{
+		Top:    top,
+		Left:   left,
+		Bottom: bottom,
+		Right:  right,
+	}
+	return c
+}
+
+// NoWrap sets whether the text of the cell is kept on a single line, widening the column
+// when the table layout allows it (w:noWrap).
+//
+// Returns:
+//   - *Cell: The cell instance for method chaining.
+func (c *Cell) NoWrap(noWrap bool) *Cell {
+	c.ensureProp()
+	c.ct.Property.NoWrap = ctypes.OnOffFromBool(noWrap)
+	return c
+}
+
+// TextDirection sets the direction of the text of the cell, e.g. stypes.TextDirectionBtLr to
+// read it bottom to top in a narrow header column.
+//
+// Returns:
+//   - *Cell: The cell instance for method chaining.
+func (c *Cell) TextDirection(direction stypes.TextDirection) *Cell {
+	c.ensureProp()
+	c.ct.Property.TextDirection = ctypes.NewGenSingleStrVal(direction)
+	return c
+}
@@ -0,0 +1,428 @@
+package docx
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/MamaShip/godocx/internal"
+	"github.com/MamaShip/godocx/wml/ctypes"
+	"github.com/MamaShip/godocx/wml/stypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddTable_WithSize(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	tbl := rd.AddTable(2, 3)
+	tbl.Cell(0, 0).AddParagraph("Name")
+	tbl.Cell(1, 2).AddParagraph("Last")
+	tbl.Cell(1, 2).AddParagraph("Second paragraph")
+
+	assert.Nil(t, tbl.Cell(2, 0))
+	assert.Nil(t, tbl.Cell(0, 3))
+
+	out, err := xml.Marshal(tbl.ct)
+	assert.NoError(t, err)
+	xmlStr := string(out)
+
+	assert.Contains(t, xmlStr, `<w:tblStyle w:val="TableGrid"></w:tblStyle>`)
+	assert.Contains(t, xmlStr, `<w:tblGrid><w:gridCol w:w="3120"></w:gridCol><w:gridCol w:w="3120"></w:gridCol><w:gridCol w:w="3120"></w:gridCol></w:tblGrid>`)
+	assert.Equal(t, 2, strings.Count(xmlStr, "<w:tr>"))
+	assert.Equal(t, 6, strings.Count(xmlStr, "<w:tc>"))
+	assert.Equal(t, 7, strings.Count(xmlStr, "<w:p>"), "every cell holds a paragraph, without leftover placeholders")
+
+	last := tbl.ct.RowContents[1].Row.Contents[2].Cell
+	assert.Len(t, last.Contents, 2)
+	assert.Equal(t, "Last", last.Contents[0].Paragraph.Children[0].Run.Children[0].Text.Text)
+}
+
+func TestAddTable_WithoutSize(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	tbl := rd.AddTable()
+
+	assert.Empty(t, tbl.ct.RowContents)
+	assert.Nil(t, tbl.ct.TableProp.Style)
+	assert.Nil(t, tbl.Cell(0, 0))
+}
+
+func TestAddTableFromData(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	tbl := rd.AddTableFromData([][]string{
+		{"Product", "Units", "Price"},
+		{"Apples", "12"},
+	})
+
+	assert.Len(t, tbl.ct.Grid.Col, 3)
+	assert.Equal(t, "Product", tbl.ct.RowContents[0].Row.Contents[0].Cell.Contents[0].Paragraph.Children[0].Run.Children[0].Text.Text)
+	assert.Equal(t, "12", tbl.ct.RowContents[1].Row.Contents[1].Cell.Contents[0].Paragraph.Children[0].Run.Children[0].Text.Text)
+
+	// The missing cell keeps its empty paragraph
+	missing := tbl.ct.RowContents[1].Row.Contents[2].Cell
+	assert.Len(t, missing.Contents, 1)
+	assert.Empty(t, missing.Contents[0].Paragraph.Children)
+}
+
+type tableTestBase struct {
+	ID int `docx:"#"`
+}
+
+type tableTestProduct struct {
+	tableTestBase
+	Name  string
+	Price *float64 `docx:"Unit price"`
+	SKU   string   `docx:"-"`
+	note  string
+}
+
+func TestAddTableFromStructs(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	price := 1.5
+	tbl, err := rd.AddTableFromStructs([]*tableTestProduct{
+		{tableTestBase{1}, "Apples", &price, "A-1", ""},
+		nil,
+		{tableTestBase{3}, "Pears", nil, "P-1", ""},
+	}, StructTableOptions{StripeColor: "F2F2F2"})
+	require.NoError(t, err)
+
+	rows := tbl.Rows()
+	require.Len(t, rows, 4)
+	var texts [][]string
+	for _, row := range rows {
+		var line []string
+		for _, cell := range row.Cells() {
+			line = append(line, cell.paragraphsText())
+		}
+		texts = append(texts, line)
+	}
+	assert.Equal(t, [][]string{{"#", "Name", "Unit price"}, {"1", "Apples", "1.5"}, {"", "", ""}, {"3", "Pears", ""}}, texts)
+
+	assert.True(t, rows[0].IsHeader())
+	assert.True(t, *rows[0].Cell(0).ct.Contents[0].Paragraph.Children[0].Run.Property.Bold.Val == stypes.OnOffTrue)
+	assert.NotEqual(t, "F2F2F2", *rows[1].Cell(0).ct.Property.Shading.Fill)
+	assert.Equal(t, "F2F2F2", *rows[2].Cell(0).ct.Property.Shading.Fill)
+	assert.NotEqual(t, "F2F2F2", *rows[3].Cell(0).ct.Property.Shading.Fill)
+
+	tbl, err = rd.AddTableFromStructs([]tableTestProduct{{Name: "Plums", SKU: "P-2"}}, StructTableOptions{Fields: []string{"SKU", "Name"}, Tag: "json"})
+	require.NoError(t, err)
+	assert.Equal(t, "SKU", tbl.Cell(0, 0).paragraphsText())
+	assert.Equal(t, "P-2", tbl.Cell(1, 0).paragraphsText())
+	assert.Equal(t, "Plums", tbl.Cell(1, 1).paragraphsText())
+}
+
+func TestAddTableFromStructs_Errors(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	_, err := rd.AddTableFromStructs([]string{"a"}, StructTableOptions{})
+	assert.Error(t, err)
+	_, err = rd.AddTableFromStructs(tableTestProduct{}, StructTableOptions{})
+	assert.Error(t, err)
+	_, err = rd.AddTableFromStructs([]tableTestProduct{}, StructTableOptions{Fields: []string{"note"}})
+	assert.Error(t, err)
+	_, err = rd.AddTableFromStructs([]struct{ hidden int }{}, StructTableOptions{})
+	assert.Error(t, err)
+	assert.Empty(t, rd.Document.Body.Children)
+}
+
+func TestCell_AddTable(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	outer := rd.AddTable(1, 2)
+	cell := outer.Cell(0, 1)
+	cell.AddParagraph("Lines")
+	inner := cell.AddTable(2, 2)
+	inner.Cell(1, 1).AddParagraph("42")
+
+	// The nested table is followed by the empty paragraph that ends the cell
+	contents := cell.ct.Contents
+	require.Len(t, contents, 3)
+	assert.NotNil(t, contents[1].Table)
+	assert.Empty(t, contents[2].Paragraph.Children)
+
+	cellWidth := *cell.ct.Property.Width.Width
+	assert.Equal(t, uint64((cellWidth-2*defaultCellMargin)/2), *inner.ct.Grid.Col[0].Width)
+
+	out, err := xml.Marshal(cell.ct)
+	require.NoError(t, err)
+	assert.Regexp(t, `<w:t>Lines</w:t></w:r></w:p><w:tbl>.*<w:t>42</w:t>.*</w:tbl><w:p></w:p></w:tc>$`, string(out))
+
+	// The next paragraph replaces the closing one
+	cell.AddParagraph("Total")
+	contents = cell.ct.Contents
+	require.Len(t, contents, 3)
+	assert.Equal(t, "Lines\n\t\n\t42\nTotal", cellText(cell.ct))
+
+	doc, err := xml.Marshal(rd.Document)
+	require.NoError(t, err)
+	loaded, err := LoadDocXml(setupRootDoc(t), "word/document.xml", doc)
+	require.NoError(t, err)
+	nested := loaded.Body.Children[0].Table.ct.RowContents[0].Row.Contents[1].Cell.Contents[1].Table
+	require.NotNil(t, nested)
+	assert.Len(t, nested.RowContents, 2)
+}
+
+func TestTable_SetColumnWidths(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	tbl := rd.AddTable(2, 3)
+	tbl.Cell(1, 0).ColSpan(2)
+	tbl.ct.RowContents[1].Row.Contents = tbl.ct.RowContents[1].Row.Contents[:2]
+
+	tbl.SetColumnWidths([]int{1000, 2000, 3000})
+
+	assert.Equal(t, uint64(2000), *tbl.ct.Grid.Col[1].Width)
+	assert.Equal(t, 3000, *tbl.Cell(0, 2).ct.Property.Width.Width)
+	assert.Equal(t, 3000, *tbl.Cell(1, 0).ct.Property.Width.Width, "spanning cell gets the sum of its columns")
+	assert.Equal(t, 3000, *tbl.Cell(1, 1).ct.Property.Width.Width)
+}
+
+func TestTable_SetBordersAndRowHeight(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	border := &ctypes.Border{Val: stypes.BorderStyleSingle, Size: internal.ToPtr(4), Color: internal.ToPtr("auto")}
+	tbl := rd.AddTable(1, 1).SetBorders(&ctypes.TableBorders{Top: border, InsideH: border})
+	tbl.Row(0).SetHeight(500, stypes.HeightRuleAtLeast)
+
+	out, err := xml.Marshal(tbl.ct)
+	assert.NoError(t, err)
+	xmlStr := string(out)
+
+	assert.Contains(t, xmlStr, `<w:tblBorders><w:top w:val="single" w:color="auto" w:sz="4"></w:top><w:insideH w:val="single" w:color="auto" w:sz="4"></w:insideH></w:tblBorders>`)
+	assert.Contains(t, xmlStr, `<w:trHeight w:val="500" w:hRule="atLeast"></w:trHeight>`)
+}
+
+func TestTable_AllBordersAndAlignment(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	tbl := rd.AddTable(2, 2).SetAllBorders(stypes.BorderStyleDouble, 6, "FF0000").SetAlignment(stypes.JustificationCenter)
+	tbl.Cell(0, 1).SetShading(stypes.ShdPct25, "000000", "FFFF00")
+
+	out, err := xml.Marshal(tbl.ct)
+	assert.NoError(t, err)
+	xmlStr := string(out)
+
+	assert.Contains(t, xmlStr, `<w:jc w:val="center"></w:jc>`)
+	for _, edge := range []string{"top", "left", "bottom", "right", "insideH", "insideV"} {
+		assert.Contains(t, xmlStr, `<w:`+edge+` w:val="double" w:color="FF0000" w:sz="6"></w:`+edge+`>`)
+	}
+	assert.Contains(t, xmlStr, `<w:shd w:val="pct25" w:color="000000" w:fill="FFFF00"></w:shd>`)
+
+	// Each edge has its own border
+	tbl.ct.TableProp.Borders.Top.Val = stypes.BorderStyleNone
+	assert.Equal(t, stypes.BorderStyleDouble, tbl.ct.TableProp.Borders.Bottom.Val)
+}
+
+func TestTable_HeaderRowsAndLook(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	tbl := rd.AddTable(3, 2).SetLook(true, false, true, false, false, true)
+	tbl.Row(0).SetAsHeader(true).CantSplit(true)
+	tbl.Row(1).CantSplit(true)
+	tbl.Row(2).SetAsHeader(true)
+	tbl.Row(2).SetAsHeader(false)
+
+	out, err := xml.Marshal(tbl.ct)
+	assert.NoError(t, err)
+	xmlStr := string(out)
+
+	assert.Contains(t, xmlStr, `<w:tblLook w:val="04A0" w:firstRow="1" w:lastRow="0" w:firstColumn="1" w:lastColumn="0" w:noHBand="0" w:noVBand="1"></w:tblLook>`)
+	assert.Contains(t, xmlStr, `<w:trPr><w:cantSplit w:val="true"></w:cantSplit><w:tblHeader w:val="true"></w:tblHeader></w:trPr>`)
+	assert.Equal(t, 1, strings.Count(xmlStr, `<w:tblHeader w:val="true">`))
+	assert.Contains(t, xmlStr, `<w:tblHeader w:val="false">`)
+
+	doc, err := LoadDocXml(setupRootDoc(t), "word/document.xml", []byte(`<w:document><w:body>`+xmlStr+`</w:body></w:document>`))
+	assert.NoError(t, err)
+	loaded := doc.Body.Children[0].Table.ct
+	assert.Equal(t, tbl.ct.TableProp.TableLook, loaded.TableProp.TableLook)
+	assert.Equal(t, tbl.ct.RowContents[0].Row.Property, loaded.RowContents[0].Row.Property)
+}
+
+func TestTable_SetHeaderRows(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	tbl := rd.AddTable(4, 1)
+	tbl.Row(3).SetAsHeader(true)
+	tbl.SetHeaderRows(2)
+
+	for i, row := range tbl.Rows() {
+		assert.Equal(t, i < 2, row.IsHeader(), "row %d", i)
+		assert.Equal(t, i < 2, row.IsCantSplit(), "row %d", i)
+	}
+
+	// A header element without value turns the property on
+	tbl.Row(2).ct.Property = &ctypes.RowProperty{Header: &ctypes.OnOff{}}
+	assert.True(t, tbl.Row(2).IsHeader())
+	tbl.SetHeaderRows(0)
+	for _, row := range tbl.Rows() {
+		assert.False(t, row.IsHeader())
+	}
+}
+
+func TestCell_MergeRight(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	tbl := rd.AddTableFromData([][]string{
+		{"A", "B", "C"},
+		{"D", "E", "F"},
+	})
+
+	assert.NoError(t, tbl.Cell(0, 0).MergeRight(2))
+
+	row := tbl.ct.RowContents[0].Row
+	assert.Len(t, row.Contents, 1)
+	merged := row.Contents[0].Cell
+	assert.Equal(t, 3, merged.Property.GridSpan.Val)
+	assert.Equal(t, 9360, *merged.Property.Width.Width)
+	assert.Len(t, merged.Contents, 3, "content of merged cells is kept")
+
+	// Out of bounds merges leave the table unchanged
+	assert.ErrorIs(t, tbl.Cell(1, 1).MergeRight(2), ErrCellMergeOutOfRange)
+	assert.Len(t, tbl.ct.RowContents[1].Row.Contents, 3)
+	assert.Nil(t, tbl.Cell(1, 1).ct.Property.GridSpan)
+
+	assert.NoError(t, tbl.Cell(1, 1).MergeRight(0))
+
+	var detached Cell
+	assert.ErrorIs(t, detached.MergeRight(1), ErrCellNotInTable)
+}
+
+func TestCell_MergeRightAfterMergeDown(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	tbl := rd.AddTableFromData([][]string{
+		{"A", "B", "C"},
+		{"D", "E", "F"},
+		{"G", "H", "I"},
+	})
+	assert.NoError(t, tbl.Cell(0, 0).MergeDown(1))
+	assert.NoError(t, tbl.Cell(0, 0).MergeRight(1))
+
+	// Both rows of the vertical merge span the same columns
+	for r, expected := range []int{2, 2, 3} {
+		assert.Len(t, tbl.Row(r).Cells(), expected, "row %d", r)
+	}
+	assert.Equal(t, 2, tbl.Cell(1, 0).ct.Property.GridSpan.Val)
+	assert.Nil(t, tbl.Cell(2, 0).ct.Property.GridSpan)
+	assert.Equal(t, "ADBE", tbl.Cell(0, 0).paragraphsText())
+}
+
+func TestTable_MergeCells(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	tbl := rd.AddTable(4, 4)
+	tbl.Cell(1, 1).AddParagraph("Block")
+	tbl.Cell(2, 3).AddParagraph("Corner")
+
+	assert.ErrorIs(t, tbl.MergeCells(1, 1, 4, 2), ErrCellMergeOutOfRange)
+	assert.ErrorIs(t, tbl.MergeCells(2, 2, 1, 1), ErrCellMergeOutOfRange)
+	assert.NoError(t, tbl.MergeCells(1, 1, 2, 3))
+
+	out, err := xml.Marshal(tbl.ct)
+	assert.NoError(t, err)
+	xmlStr := string(out)
+	assert.Equal(t, 2, strings.Count(xmlStr, `<w:gridSpan w:val="3">`))
+	assert.Equal(t, 1, strings.Count(xmlStr, `<w:vMerge w:val="restart">`))
+	assert.Equal(t, 1, strings.Count(xmlStr, `<w:vMerge w:val="continue">`))
+	assert.Equal(t, "BlockCorner", tbl.Cell(1, 1).paragraphsText())
+	assert.Len(t, tbl.Row(0).Cells(), 4)
+	assert.Len(t, tbl.Row(2).Cells(), 2)
+
+	// Rows that do not span the same columns are not merged
+	assert.ErrorIs(t, tbl.MergeCells(0, 0, 1, 2), ErrCellMergeOutOfRange)
+	assert.Len(t, tbl.Row(0).Cells(), 4)
+}
+
+func TestCell_MergeDown(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	tbl := rd.AddTable(3, 2)
+	tbl.Cell(0, 1).AddParagraph("Top")
+	tbl.Cell(2, 1).AddParagraph("Bottom")
+
+	assert.ErrorIs(t, tbl.Cell(1, 1).MergeDown(2), ErrCellMergeOutOfRange)
+	assert.NoError(t, tbl.Cell(0, 1).MergeDown(2))
+
+	out, err := xml.Marshal(tbl.ct)
+	assert.NoError(t, err)
+	xmlStr := string(out)
+
+	assert.Equal(t, 1, strings.Count(xmlStr, `<w:vMerge w:val="restart"></w:vMerge>`))
+	assert.Equal(t, 2, strings.Count(xmlStr, `<w:vMerge w:val="continue"></w:vMerge>`))
+	assert.Equal(t, 6, strings.Count(xmlStr, "<w:tc>"), "continuation cells are still emitted")
+
+	top := tbl.Cell(0, 1).ct
+	assert.Len(t, top.Contents, 2)
+	assert.Equal(t, "Bottom", top.Contents[1].Paragraph.Children[0].Run.Children[0].Text.Text)
+
+	bottom := tbl.Cell(2, 1).ct
+	assert.Len(t, bottom.Contents, 1)
+	assert.Empty(t, bottom.Contents[0].Paragraph.Children)
+}
+
+func TestCell_MergeDown_RequiresAlignedCells(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	tbl := rd.AddTable(2, 3)
+	assert.NoError(t, tbl.Cell(1, 0).MergeRight(1))
+
+	// The cell below the first column now spans two columns
+	assert.ErrorIs(t, tbl.Cell(0, 0).MergeDown(1), ErrCellMergeOutOfRange)
+	assert.Nil(t, tbl.Cell(0, 0).ct.Property.VMerge)
+
+	assert.NoError(t, tbl.Cell(0, 2).MergeDown(1))
+	assert.Equal(t, stypes.MergeCellContinue, *tbl.Cell(1, 1).ct.Property.VMerge.Val)
+}
+
+func TestTable_RowsAndCells(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	tbl := rd.AddTable(2, 3)
+	rows := tbl.Rows()
+	assert.Len(t, rows, 2)
+	cells := rows[1].Cells()
+	assert.Len(t, cells, 3)
+	assert.Same(t, tbl.Cell(1, 2), cells[2])
+	assert.Same(t, tbl.Cell(0, 1), rows[0].Cell(1))
+	assert.Nil(t, rows[0].Cell(3))
+
+	// Adding to a cell found through its row replaces the placeholder paragraph
+	cells[0].AddParagraph("Total")
+	assert.Len(t, cells[0].ct.Contents, 1)
+}
+
+func TestCell_Formatting(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	cell := rd.AddTable(1, 1).Cell(0, 0)
+	cell.Margins(ctypes.NewTableWidth(0, stypes.TableWidthDxa), nil, nil, ctypes.NewTableWidth(57, stypes.TableWidthDxa)).
+		NoWrap(true).
+		TextDirection(stypes.TextDirectionBtLr)
+
+	out, err := xml.Marshal(cell.ct.Property)
+	if err != nil {
+		t.Fatalf("marshal cell properties: %v", err)
+	}
+	assert.Contains(t, string(out), `<w:noWrap w:val="true"></w:noWrap><w:tcMar><w:top w:w="0" w:type="dxa"></w:top><w:right w:w="57" w:type="dxa"></w:right></w:tcMar>`+
+		`<w:textDirection w:val="btLr"></w:textDirection>`)
+
+	// Cells without properties, e.g. from a loaded document, can be formatted too
+	loaded := &Cell{root: rd, ct: &ctypes.Cell{}}
+	loaded.BackgroundColor("FFCC00").Width(1200, stypes.TableWidthDxa).Borders(nil, nil, nil, nil, nil, nil, nil, nil)
+	assert.Equal(t, "FFCC00", *loaded.ct.Property.Shading.Fill)
+	assert.Equal(t, 1200, *loaded.ct.Property.Width.Width)
+}
+
+// paragraphsText returns the text of the paragraphs of the cell, concatenated.
+func (c *Cell) paragraphsText() string {
+	var sb strings.Builder
+	for _, block := range c.ct.Contents {
+		if block.Paragraph != nil {
+			writeParagraphText(&sb, block.Paragraph)
+		}
+	}
+	return sb.String()
+}
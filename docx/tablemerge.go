@@ -0,0 +1,356 @@
+package docx
+
+import (
+	"errors"
+
+	"github.com/MamaShip/godocx/wml/ctypes"
+	"github.com/MamaShip/godocx/wml/stypes"
+)
+
+var (
+	// ErrCellMergeOutOfRange is returned when a merge extends past the last column or row of the table.
+	ErrCellMergeOutOfRange = errors.New("cell merge exceeds the table bounds")
+
+	// ErrCellNotInTable is returned when merging a cell that does not belong to a table.
+	ErrCellNotInTable = errors.New("cell is not part of a table")
+)
+
+// cellPosition locates a cell within its table.
+type cellPosition struct {
+	rows    []*ctypes.Row // rows of the table
+	row     int           // index of the row holding the cell in rows
+	index   int           // index of the cell in the contents of its row
+	gridCol int           // first grid column covered by the cell
+}
+
+// MergeRight merges the cell with the n cells to its right, so that it spans their grid columns (w:gridSpan).
+//
+// The merged cells are removed from the row and their non-empty content is moved into the cell.
+// If the cell starts a vertical merge, see MergeDown, the cells it continues in are merged the
+// same way, so that the merged region stays rectangular. The table is left unchanged if the
+// merge fails.
+//
+// Returns:
+//   - error: ErrCellMergeOutOfRange if the row has fewer than n cells after the cell, or the
+//     rows of a vertical merge would not span the same grid columns, or ErrCellNotInTable if
+//     the cell was not obtained from a table.
+//
+// Example:
+//
+//	table := document.AddTable(2, 3)
+//	table.Cell(0, 0).AddParagraph("Title")
+//	err := table.Cell(0, 0).MergeRight(2)
+func (c *Cell) MergeRight(n int) error {
+	if n <= 0 {
+		return nil
+	}
+
+	pos, err := c.position()
+	if err != nil {
+		return err
+	}
+
+	// Plan the merge of every row of the region before changing anything
+	type rowMerge struct {
+		row    *ctypes.Row
+		cell   *ctypes.Cell
+		merged []int
+		span   int
+	}
+	var plans []rowMerge
+	for r := pos.row; r < len(pos.rows); r++ {
+		row := pos.rows[r]
+		index := pos.index
+		if r > pos.row {
+			index = cellIndexAtGridCol(row, pos.gridCol)
+			if index < 0 || !isVMergeContinue(row.Contents[index].Cell) || cellSpan(row.Contents[index].Cell) != cellSpan(c.ct) {
+				break
+			}
+		}
+
+		plan := rowMerge{row: row, cell: row.Contents[index].Cell, span: cellSpan(row.Contents[index].Cell)}
+		for i := index + 1; i < len(row.Contents) && len(plan.merged) < n; i++ {
+			if cell := row.Contents[i].Cell; cell != nil {
+				plan.merged = append(plan.merged, i)
+				plan.span += cellSpan(cell)
+			}
+		}
+		if len(plan.merged) < n || (len(plans) > 0 && plan.span != plans[0].span) {
+			return ErrCellMergeOutOfRange
+		}
+		plans = append(plans, plan)
+	}
+
+	var moved []ctypes.TCBlockContent
+	for _, plan := range plans {
+		width, sumWidths := cellWidth(plan.cell)
+		for _, i := range plan.merged {
+			cell := plan.row.Contents[i].Cell
+			if w, ok := cellWidth(cell); ok && sumWidths {
+				width += w
+			} else {
+				sumWidths = false
+			}
+			moved = append(moved, nonEmptyBlocks(cell)...)
+		}
+
+		// Remove the merged cells from the row, last first so indexes stay valid
+		for i := len(plan.merged) - 1; i >= 0; i-- {
+			idx := plan.merged[i]
+			plan.row.Contents = append(plan.row.Contents[:idx], plan.row.Contents[idx+1:]...)
+		}
+
+		if plan.cell.Property == nil {
+			plan.cell.Property = &ctypes.CellProperty{}
+		}
+		plan.cell.Property.GridSpan = &ctypes.DecimalNum{Val: plan.span}
+		if sumWidths {
+			plan.cell.Property.Width = ctypes.NewTableWidth(width, stypes.TableWidthDxa)
+		}
+	}
+	c.appendBlocks(moved)
+
+	return nil
+}
+
+// MergeCells merges the cells of the rectangle from the cell at fromRow, fromCol to the cell
+// at toRow, toCol, inclusive, into its top left cell, which receives their non-empty content.
+// Rows and columns are zero-based and counted as in Cell. The table is left unchanged if the
+// merge fails.
+//
+// Returns:
+//   - error: ErrCellMergeOutOfRange if the rectangle is not within the table or its rows do not
+//     span the same grid columns.
+//
+// Example:
+//
+//	table := document.AddTable(4, 4)
+//	err := table.MergeCells(1, 1, 2, 3) // A 2 x 3 block
+func (t *Table) MergeCells(fromRow, fromCol, toRow, toCol int) error {
+	if fromRow > toRow || fromCol > toCol {
+		return ErrCellMergeOutOfRange
+	}
+
+	topLeft := t.Cell(fromRow, fromCol)
+	if topLeft == nil {
+		return ErrCellMergeOutOfRange
+	}
+	pos, err := topLeft.position()
+	if err != nil {
+		return err
+	}
+	if toRow >= len(pos.rows) {
+		return ErrCellMergeOutOfRange
+	}
+
+	// Every row must have the cells of the rectangle, spanning the same grid columns
+	n := toCol - fromCol
+	span := 0
+	for r := fromRow; r <= toRow; r++ {
+		row := pos.rows[r]
+		index := cellIndexAtGridCol(row, pos.gridCol)
+		if index < 0 {
+			return ErrCellMergeOutOfRange
+		}
+
+		rowSpan, count := 0, 0
+		for i := index; i < len(row.Contents) && count <= n; i++ {
+			if cell := row.Contents[i].Cell; cell != nil {
+				rowSpan += cellSpan(cell)
+				count++
+			}
+		}
+		if count <= n || (r > fromRow && rowSpan != span) {
+			return ErrCellMergeOutOfRange
+		}
+		span = rowSpan
+	}
+
+	// Bottom up, so that a row is merged before the vertical merges it continues
+	for r := toRow; r >= fromRow; r-- {
+		row := pos.rows[r]
+		cell := &Cell{root: t.root, ct: row.Contents[cellIndexAtGridCol(row, pos.gridCol)].Cell, table: t}
+		if err := cell.MergeRight(n); err != nil {
+			return err
+		}
+	}
+	return topLeft.MergeDown(toRow - fromRow)
+}
+
+// MergeDown merges the cell with the cells below it in the next n rows (w:vMerge).
+//
+// The cell starts the merged region and the cells below continue it. The continuation cells
+// stay in the table as required by the specification, holding an empty paragraph; their
+// non-empty content is moved into the cell. The table is left unchanged if the merge fails.
+//
+// Returns:
+//   - error: ErrCellMergeOutOfRange if the table has fewer than n rows below the cell or a row
+//     has no cell aligned with it, or ErrCellNotInTable if the cell was not obtained from a table.
+//
+// Example:
+//
+//	table := document.AddTable(3, 2)
+//	err := table.Cell(0, 0).MergeDown(2)
+func (c *Cell) MergeDown(n int) error {
+	if n <= 0 {
+		return nil
+	}
+
+	pos, err := c.position()
+	if err != nil {
+		return err
+	}
+
+	if pos.row+n >= len(pos.rows) {
+		return ErrCellMergeOutOfRange
+	}
+
+	span := cellSpan(c.ct)
+	below := make([]*ctypes.Cell, 0, n)
+	for r := pos.row + 1; r <= pos.row+n; r++ {
+		cell := cellAtGridCol(pos.rows[r], pos.gridCol)
+		if cell == nil || cellSpan(cell) != span {
+			return ErrCellMergeOutOfRange
+		}
+		below = append(below, cell)
+	}
+
+	c.ensureProp()
+	c.ct.Property.VMerge = ctypes.NewGenOptStrVal(stypes.MergeCellRestart)
+
+	var moved []ctypes.TCBlockContent
+	for _, cell := range below {
+		moved = append(moved, nonEmptyBlocks(cell)...)
+
+		if cell.Property == nil {
+			cell.Property = &ctypes.CellProperty{}
+		}
+		cell.Property.VMerge = ctypes.NewGenOptStrVal(stypes.MergeCellContinue)
+		cell.Contents = []ctypes.TCBlockContent{{Paragraph: &ctypes.Paragraph{}}}
+	}
+	c.appendBlocks(moved)
+
+	return nil
+}
+
+func (c *Cell) ensureProp() {
+	if c.ct.Property == nil {
+		c.ct.Property = &ctypes.CellProperty{}
+	}
+}
+
+// appendBlocks adds the content moved from merged cells, replacing the content of the cell
+// if it only holds an empty paragraph.
+func (c *Cell) appendBlocks(blocks []ctypes.TCBlockContent) {
+	if len(blocks) == 0 {
+		return
+	}
+
+	if len(nonEmptyBlocks(c.ct)) == 0 {
+		c.ct.Contents = nil
+	}
+	c.ct.Contents = append(c.ct.Contents, blocks...)
+}
+
+// position locates the cell within its table.
+func (c *Cell) position() (*cellPosition, error) {
+	if c.table == nil {
+		return nil, ErrCellNotInTable
+	}
+
+	pos := &cellPosition{row: -1}
+	for _, rowContent := range c.table.ct.RowContents {
+		if rowContent.Row == nil {
+			continue
+		}
+
+		if pos.row < 0 {
+			gridCol := 0
+			for i, cellContent := range rowContent.Row.Contents {
+				if cellContent.Cell == nil {
+					continue
+				}
+				if cellContent.Cell == c.ct {
+					pos.row, pos.index, pos.gridCol = len(pos.rows), i, gridCol
+					break
+				}
+				gridCol += cellSpan(cellContent.Cell)
+			}
+		}
+
+		pos.rows = append(pos.rows, rowContent.Row)
+	}
+
+	if pos.row < 0 {
+		return nil, ErrCellNotInTable
+	}
+	return pos, nil
+}
+
+// cellAtGridCol returns the cell of the row starting at the given grid column, or nil.
+func cellAtGridCol(row *ctypes.Row, gridCol int) *ctypes.Cell {
+	if i := cellIndexAtGridCol(row, gridCol); i >= 0 {
+		return row.Contents[i].Cell
+	}
+	return nil
+}
+
+// cellIndexAtGridCol returns the index in the contents of the row of the cell starting at the
+// given grid column, or -1.
+func cellIndexAtGridCol(row *ctypes.Row, gridCol int) int {
+	col := 0
+	for i, cellContent := range row.Contents {
+		if cellContent.Cell == nil {
+			continue
+		}
+		if col == gridCol {
+			return i
+		}
+		if col > gridCol {
+			return -1
+		}
+		col += cellSpan(cellContent.Cell)
+	}
+	return -1
+}
+
+// isVMergeContinue reports whether the cell continues a vertical merge.
+func isVMergeContinue(cell *ctypes.Cell) bool {
+	if cell.Property == nil || cell.Property.VMerge == nil {
+		return false
+	}
+	// A w:vMerge element without value continues the merge
+	return cell.Property.VMerge.Val == nil || *cell.Property.VMerge.Val == stypes.MergeCellContinue
+}
+
+// cellSpan returns the number of grid columns covered by the cell.
+func cellSpan(cell *ctypes.Cell) int {
+	if cell.Property != nil && cell.Property.GridSpan != nil && cell.Property.GridSpan.Val > 1 {
+		return cell.Property.GridSpan.Val
+	}
+	return 1
+}
+
+// cellWidth returns the width of the cell in twips, if it is set in twips.
+func cellWidth(cell *ctypes.Cell) (int, bool) {
+	if cell.Property == nil || cell.Property.Width == nil || cell.Property.Width.Width == nil {
+		return 0, false
+	}
+	w := cell.Property.Width
+	if w.WidthType == nil || *w.WidthType != stypes.TableWidthDxa {
+		return 0, false
+	}
+	return *w.Width, true
+}
+
+// nonEmptyBlocks returns the content of the cell, leaving out empty paragraphs.
+func nonEmptyBlocks(cell *ctypes.Cell) []ctypes.TCBlockContent {
+	var blocks []ctypes.TCBlockContent
+	for _, block := range cell.Contents {
+		if block.Paragraph != nil && block.Paragraph.Property == nil && len(block.Paragraph.Children) == 0 {
+			continue
+		}
+		blocks = append(blocks, block)
+	}
+	return blocks
+}
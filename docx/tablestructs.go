@@ -0,0 +1,177 @@
+package docx
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// defaultStructTag is the struct tag giving the column headers of AddTableFromStructs.
+const defaultStructTag = "docx"
+
+// StructTableOptions customizes the tables built by AddTableFromStructs.
+type StructTableOptions struct {
+	// Tag is the key of the struct tags giving the column headers, "docx" if empty, e.g.
+	// `docx:"Unit price"`. Fields tagged "-" are left out; the others are headed by their
+	// name when they have no tag.
+	Tag string
+
+	// Fields are the names of the fields making the columns, in order. All the exported
+	// fields, including those of embedded structs, are used when empty.
+	Fields []string
+
+	// StripeColor is the fill color of every other data row, as a hex RGB value such as
+	// "F2F2F2", for zebra striping. The rows are not striped when empty.
+	StripeColor string
+}
+
+// structColumn is a column of a table built from structs.
+type structColumn struct {
+	header string
+	index  []int
+}
+
+// AddTableFromStructs adds a new table with a header row followed by one row per element of
+// the slice, a slice of structs or of pointers to structs, and one column per field.
+//
+// The header row is repeated on each page. The values are formatted with fmt.Sprint; nil
+// pointers give empty cells. See AddTable for the layout of the table.
+//
+// Returns:
+//   - *Table: A pointer to the newly added table.
+//   - error: An error if data is not a slice of structs or if a field of opts.Fields does
+//     not exist; nothing is added to the document then.
+//
+// Example:
+//
+//	type product struct {
+//		Name  string  `docx:"Product"`
+//		Price float64 `docx:"Unit price"`
+//		SKU   string  `docx:"-"`
+//	}
+//
+//	document.AddTableFromStructs([]product{{"Apples", 1.2, "A-1"}}, docx.StructTableOptions{StripeColor: "F2F2F2"})
+func (rd *RootDoc) AddTableFromStructs(data any, opts StructTableOptions) (*Table, error) {
+	slice := reflect.ValueOf(data)
+	if slice.Kind() != reflect.Slice && slice.Kind() != reflect.Array {
+		return nil, fmt.Errorf("table data must be a slice of structs, got %T", data)
+	}
+
+	elemType := slice.Type().Elem()
+	if elemType.Kind() == reflect.Pointer {
+		elemType = elemType.Elem()
+	}
+	if elemType.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("table data must be a slice of structs, got %T", data)
+	}
+
+	columns, err := structColumns(elemType, opts)
+	if err != nil {
+		return nil, err
+	}
+	if len(columns) == 0 {
+		return nil, errors.New("table data has no fields to display")
+	}
+
+	rows := make([][]string, 0, slice.Len()+1)
+	header := make([]string, len(columns))
+	for i, col := range columns {
+		header[i] = col.header
+	}
+	rows = append(rows, header)
+
+	for i := 0; i < slice.Len(); i++ {
+		elem := slice.Index(i)
+		if elem.Kind() == reflect.Pointer {
+			elem = elem.Elem()
+		}
+
+		row := make([]string, len(columns))
+		if elem.IsValid() {
+			for c, col := range columns {
+				row[c] = structFieldText(elem, col.index)
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	tbl := rd.AddTableFromData(rows)
+	tbl.SetHeaderRows(1)
+	for _, cell := range tbl.Row(0).Cells() {
+		for _, p := range cell.ct.Contents {
+			for _, child := range p.Paragraph.Children {
+				if child.Run != nil {
+					newRun(rd, child.Run).Bold(true)
+				}
+			}
+		}
+	}
+
+	if opts.StripeColor != "" {
+		for r := 2; r < len(rows); r += 2 {
+			for _, cell := range tbl.Row(r).Cells() {
+				cell.BackgroundColor(opts.StripeColor)
+			}
+		}
+	}
+
+	return tbl, nil
+}
+
+// structColumns returns the columns of a table built from structs of the type.
+func structColumns(t reflect.Type, opts StructTableOptions) ([]structColumn, error) {
+	tag := opts.Tag
+	if tag == "" {
+		tag = defaultStructTag
+	}
+
+	var columns []structColumn
+	byName := map[string]structColumn{}
+	for _, field := range reflect.VisibleFields(t) {
+		if field.Anonymous || !field.IsExported() {
+			continue
+		}
+
+		header, ok := field.Tag.Lookup(tag)
+		if header == "-" {
+			continue
+		}
+		if !ok || header == "" {
+			header = field.Name
+		}
+
+		col := structColumn{header: header, index: field.Index}
+		columns = append(columns, col)
+		byName[field.Name] = col
+	}
+
+	if len(opts.Fields) == 0 {
+		return columns, nil
+	}
+
+	selected := make([]structColumn, 0, len(opts.Fields))
+	for _, name := range opts.Fields {
+		col, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("%s has no exported field %q", t, name)
+		}
+		selected = append(selected, col)
+	}
+	return selected, nil
+}
+
+// structFieldText returns the text of the field of the struct at the index, or an empty
+// string if the field is a nil pointer or is in a nil embedded struct.
+func structFieldText(v reflect.Value, index []int) string {
+	field, err := v.FieldByIndexErr(index)
+	if err != nil {
+		return ""
+	}
+	if field.Kind() == reflect.Pointer {
+		if field.IsNil() {
+			return ""
+		}
+		field = field.Elem()
+	}
+	return fmt.Sprint(field.Interface())
+}
@@ -0,0 +1,141 @@
+package docx
+
+import (
+	"strings"
+
+	"github.com/MamaShip/godocx/wml/ctypes"
+)
+
+// Text returns the visible text of the paragraph.
+//
+// Text of runs and hyperlinks is concatenated; tabs are returned as "\t" and
+// breaks as "\n". Field instructions are skipped, while field results are included.
+func (p *Paragraph) Text() string {
+	var sb strings.Builder
+	writeParagraphText(&sb, &p.ct)
+	return sb.String()
+}
+
+// PlainText returns the visible text of the document body.
+//
+// Paragraphs are separated by "\n". Table rows are written as one line each,
+// with "\t" between the cells.
+//
+// Example:
+//
+//	text := document.PlainText()
+func (rd *RootDoc) PlainText() string {
+	if rd.Document == nil || rd.Document.Body == nil {
+		return ""
+	}
+
+	return childrenText(rd.Document.Body.Children)
+}
+
+// childrenText returns the text of the body children, one line per paragraph and table row.
+func childrenText(children []DocumentChild) string {
+	lines := make([]string, 0, len(children))
+	for _, child := range children {
+		if child.Para != nil {
+			lines = append(lines, child.Para.Text())
+		}
+
+		if child.Table != nil {
+			lines = append(lines, tableText(&child.Table.ct))
+		}
+
+		if child.Sdt != nil {
+			lines = append(lines, child.Sdt.Text())
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// tableText returns the text of the table, one line per row with tab separated cells.
+func tableText(tbl *ctypes.Table) string {
+	rows := make([]string, 0, len(tbl.RowContents))
+	for _, rowContent := range tbl.RowContents {
+		if rowContent.Sdt != nil && len(rowContent.Sdt.Rows) > 0 {
+			rows = append(rows, tableText(&ctypes.Table{RowContents: rowContent.Sdt.Rows}))
+		}
+		if rowContent.Row == nil {
+			continue
+		}
+
+		cells := make([]string, 0, len(rowContent.Row.Contents))
+		for _, cellContent := range rowContent.Row.Contents {
+			if cellContent.Cell == nil {
+				continue
+			}
+			cells = append(cells, cellText(cellContent.Cell))
+		}
+
+		rows = append(rows, strings.Join(cells, "\t"))
+	}
+
+	return strings.Join(rows, "\n")
+}
+
+// cellText returns the text of the cell's paragraphs and nested tables, separated by "\n".
+func cellText(cell *ctypes.Cell) string {
+	blocks := make([]string, 0, len(cell.Contents))
+	for _, block := range cell.Contents {
+		if block.Paragraph != nil {
+			var sb strings.Builder
+			writeParagraphText(&sb, block.Paragraph)
+			blocks = append(blocks, sb.String())
+		}
+
+		if block.Table != nil {
+			blocks = append(blocks, tableText(block.Table))
+		}
+	}
+
+	return strings.Join(blocks, "\n")
+}
+
+func writeParagraphText(sb *strings.Builder, p *ctypes.Paragraph) {
+	writeParagraphChildrenText(sb, p.Children)
+}
+
+func writeParagraphChildrenText(sb *strings.Builder, children []ctypes.ParagraphChild) {
+	for _, child := range children {
+		if child.Run != nil {
+			writeRunText(sb, child.Run)
+		}
+
+		if child.Link != nil {
+			if child.Link.Run != nil {
+				writeRunText(sb, child.Link.Run)
+			}
+			writeParagraphChildrenText(sb, child.Link.Children)
+		}
+
+		if child.Sdt != nil {
+			writeParagraphChildrenText(sb, child.Sdt.Content)
+		}
+
+		// Inserted text is included and deleted text left out, as in the final view of the changes
+		if child.Ins != nil {
+			for _, run := range child.Ins.Runs {
+				writeRunText(sb, run)
+			}
+		}
+	}
+}
+
+func writeRunText(sb *strings.Builder, r *ctypes.Run) {
+	for _, child := range r.Children {
+		switch {
+		case child.Text != nil:
+			sb.WriteString(child.Text.Text)
+		case child.Tab != nil, child.PTab != nil:
+			sb.WriteByte('\t')
+		case child.Break != nil, child.CarrRtn != nil:
+			sb.WriteByte('\n')
+		case child.NoBreakHyphen != nil:
+			sb.WriteByte('-')
+		}
+	}
+}
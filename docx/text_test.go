@@ -0,0 +1,48 @@
+package docx
+
+import (
+	"testing"
+
+	"github.com/MamaShip/godocx/internal"
+	"github.com/MamaShip/godocx/wml/ctypes"
+	"github.com/MamaShip/godocx/wml/stypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParagraph_Text(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	p := rd.AddParagraph("Name:")
+	tabRun := p.AddRun()
+	tabRun.ct.Children = append(tabRun.ct.Children, ctypes.RunChild{Tab: &ctypes.Empty{}})
+	p.AddText("Jane")
+	p.AddText("Line").AddBreak(nil)
+	p.AddText("Next ")
+	p.AddHyperlink("link", "https://example.com")
+
+	assert.Equal(t, "Name:\tJaneLine\nNext link", p.Text())
+}
+
+func TestParagraph_TextFields(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	p := rd.AddTableOfContents(TOCOptions{})
+	assert.Equal(t, "Right-click and choose Update Field to build the table of contents.", p.Text())
+}
+
+func TestRootDoc_PlainText(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	rd.AddParagraph("Title")
+	tbl := rd.AddTable()
+	row := tbl.AddRow()
+	row.AddCell().AddParagraph("A1")
+	row.AddCell().AddParagraph("B1")
+	row = tbl.AddRow()
+	row.AddCell().AddParagraph("A2")
+	row.AddCell().AddParagraph("B2")
+	rd.AddParagraph("End")
+	rd.AddEmptyParagraph().AddText("Page").AddBreak(internal.ToPtr(stypes.BreakTypePage))
+
+	assert.Equal(t, "Title\nA1\tB1\nA2\tB2\nEnd\nPage\n", rd.PlainText())
+}
@@ -0,0 +1,40 @@
+package docx
+
+import "fmt"
+
+// AddTableOfAuthorities adds a new paragraph containing a table of authorities (TOA) field.
+//
+// The table lists the citations marked with Run.AddAuthorityEntry for the given category.
+// Word builds the table when the fields are updated.
+//
+// Parameters:
+//   - category: The citation category to list (1 = Cases, 2 = Statutes, 3 = Other Authorities, ...).
+//
+// Returns:
+//   - *Paragraph: The paragraph holding the field.
+//
+// Example:
+//
+//	p := document.AddParagraph("See ")
+//	p.AddText("Marbury v. Madison").AddAuthorityEntry("Marbury v. Madison, 5 U.S. 137 (1803)", 1)
+//	document.AddTableOfAuthorities(1)
+func (rd *RootDoc) AddTableOfAuthorities(category int) *Paragraph {
+	p := rd.AddEmptyParagraph()
+	p.addField(fmt.Sprintf(`TOA \h \c "%d" \p`, category),
+		"Right-click to update the table of authorities.")
+	return p
+}
+
+// AddAuthorityEntry marks a citation for the table of authorities by appending a TA field to the run.
+//
+// Parameters:
+//   - text: The citation as it should appear in the table of authorities.
+//   - category: The citation category, matching the one passed to AddTableOfAuthorities.
+//
+// Returns:
+//   - *Run: The run instance for method chaining.
+func (r *Run) AddAuthorityEntry(text string, category int) *Run {
+	instr := fmt.Sprintf(`TA \l %s \s %s \c %d`, quoteFieldArg(text), quoteFieldArg(text), category)
+	r.ct.Children = append(r.ct.Children, fieldChildren(instr, "")...)
+	return r
+}
@@ -0,0 +1,44 @@
+package docx
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddTableOfAuthorities(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	p := rd.AddTableOfAuthorities(2)
+
+	out, err := xml.Marshal(p.ct)
+	if err != nil {
+		t.Fatalf("marshal paragraph: %v", err)
+	}
+	xmlStr := string(out)
+
+	assert.True(t, strings.Contains(xmlStr, `<w:fldChar w:fldCharType="begin" w:dirty="true"></w:fldChar>`), xmlStr)
+	assert.True(t, strings.Contains(xmlStr, `<w:instrText xml:space="preserve"> TOA \h \c &#34;2&#34; \p </w:instrText>`), xmlStr)
+	assert.True(t, strings.Contains(xmlStr, `<w:fldChar w:fldCharType="separate"></w:fldChar>`), xmlStr)
+	assert.True(t, strings.Contains(xmlStr, `<w:fldChar w:fldCharType="end"></w:fldChar>`), xmlStr)
+}
+
+func TestRun_AddAuthorityEntry(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	p := rd.AddEmptyParagraph()
+	run := p.AddText("Marbury").AddAuthorityEntry(`Marbury v. "Madison"`, 1)
+
+	out, err := xml.Marshal(run.ct)
+	if err != nil {
+		t.Fatalf("marshal run: %v", err)
+	}
+
+	expected := `<w:r><w:t>Marbury</w:t>` +
+		`<w:fldChar w:fldCharType="begin" w:dirty="true"></w:fldChar>` +
+		`<w:instrText xml:space="preserve"> TA \l &#34;Marbury v. \&#34;Madison\&#34;&#34; \s &#34;Marbury v. \&#34;Madison\&#34;&#34; \c 1 </w:instrText>` +
+		`<w:fldChar w:fldCharType="end"></w:fldChar></w:r>`
+	assert.Equal(t, expected, string(out))
+}
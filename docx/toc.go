@@ -0,0 +1,301 @@
+package docx
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/MamaShip/godocx/wml/ctypes"
+	"github.com/MamaShip/godocx/wml/stypes"
+)
+
+// tocPlaceholder is the result of a table of contents field without entries.
+const tocPlaceholder = "Right-click and choose Update Field to build the table of contents."
+
+// updateFieldsSuccessors lists the settings that follow w:updateFields in the schema order
+// and are commonly present; the element is inserted before the earliest one found.
+var updateFieldsSuccessors = []string{
+	"<w:hdrShapeDefaults",
+	"<w:footnotePr",
+	"<w:endnotePr",
+	"<w:compat",
+	"<w:docVars",
+	"<w:rsids",
+	"<m:mathPr",
+	"<w:attachedSchema",
+	"<w:themeFontLang",
+	"<w:clrSchemeMapping",
+	"<w:doNotIncludeSubdocsInStats",
+	"<w:doNotAutoCompressPictures",
+	"<w:forceUpgrade",
+	"<w:captions",
+	"<w:readModeInkLockDown",
+	"<w:smartTagType",
+	"<sl:schemaLibrary",
+	"<w:shapeDefaults",
+	"<w:doNotEmbedSmartTags",
+	"<w:decimalSymbol",
+	"<w:listSeparator",
+	"</w:settings>",
+}
+
+// tocLevelsSwitch matches the heading levels switch of a TOC field instruction.
+var tocLevelsSwitch = regexp.MustCompile(`\\o\s*"(\d)-(\d)"`)
+
+// TOCOptions configures the table of contents added by AddTableOfContents.
+type TOCOptions struct {
+	// MinLevel and MaxLevel select the range of heading levels to include.
+	// They default to 1 and 3 when zero.
+	MinLevel int
+	MaxLevel int
+
+	// Hyperlinks makes the entries clickable links to the headings (\h).
+	Hyperlinks bool
+
+	// HidePageNumbersInWeb hides tab leaders and page numbers in Web layout view (\z).
+	HidePageNumbersInWeb bool
+
+	// UseOutlineLevels also includes paragraphs with an outline level applied (\u).
+	UseOutlineLevels bool
+
+	// PlaceholderEntries fills the table of contents with the text of the Heading paragraphs
+	// already in the document, one paragraph per entry styled TOC1 to TOC9, so that it is not
+	// empty until Word updates it. The entries have no page numbers.
+	PlaceholderEntries bool
+}
+
+// DefaultTOCOptions returns the options Word uses for its built-in tables of contents:
+// heading levels 1-3, hyperlinked entries, hidden page numbers in Web view and outline levels.
+func DefaultTOCOptions() TOCOptions {
+	return TOCOptions{
+		MinLevel:             1,
+		MaxLevel:             3,
+		Hyperlinks:           true,
+		HidePageNumbersInWeb: true,
+		UseOutlineLevels:     true,
+	}
+}
+
+// levels returns the range of heading levels of the options, with the defaults applied.
+func (o TOCOptions) levels() (int, int) {
+	minLevel, maxLevel := o.MinLevel, o.MaxLevel
+	if minLevel <= 0 {
+		minLevel = 1
+	}
+	if maxLevel <= 0 {
+		maxLevel = 3
+	}
+	if maxLevel < minLevel {
+		maxLevel = minLevel
+	}
+	return minLevel, maxLevel
+}
+
+// instruction returns the TOC field instruction for the options.
+func (o TOCOptions) instruction() string {
+	minLevel, maxLevel := o.levels()
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf(`TOC \o "%d-%d"`, minLevel, maxLevel))
+	if o.Hyperlinks {
+		sb.WriteString(` \h`)
+	}
+	if o.HidePageNumbersInWeb {
+		sb.WriteString(` \z`)
+	}
+	if o.UseOutlineLevels {
+		sb.WriteString(` \u`)
+	}
+	return sb.String()
+}
+
+// AddTableOfContents adds a table of contents (TOC) field at the end of the document.
+//
+// godocx does not lay out pages, so the field result is a placeholder: either a text, or the
+// headings already in the document when opts.PlaceholderEntries is set. The field is marked
+// dirty and the updateFields setting is turned on in settings.xml, so that Word offers to
+// update the fields when the document is opened, filling in the entries and page numbers.
+//
+// Returns:
+//   - *Paragraph: The first paragraph of the table of contents.
+//
+// Example:
+//
+//	document.AddTableOfContents(docx.DefaultTOCOptions())
+//	document.AddHeading("Introduction", 1)
+func (rd *RootDoc) AddTableOfContents(opts TOCOptions) *Paragraph {
+	rd.replaceSetting("updateFields", `<w:updateFields w:val="true"/>`, updateFieldsSuccessors)
+
+	var entries []tocEntry
+	if opts.PlaceholderEntries {
+		entries = rd.tocEntries(opts.levels())
+	}
+
+	paras := rd.tocParagraphs(opts.instruction(), entries)
+	for _, p := range paras {
+		rd.Document.Body.Children = append(rd.Document.Body.Children, DocumentChild{Para: p})
+	}
+	return paras[0]
+}
+
+// UpdateTableOfContents replaces the placeholder entries of the tables of contents of the
+// document body with the Heading paragraphs currently in the document, e.g. once the headings
+// following a table of contents have been added. Page numbers are still left to Word.
+//
+// Example:
+//
+//	opts := docx.DefaultTOCOptions()
+//	opts.PlaceholderEntries = true
+//	document.AddTableOfContents(opts)
+//	document.AddHeading("Introduction", 1)
+//	document.UpdateTableOfContents()
+func (rd *RootDoc) UpdateTableOfContents() {
+	if rd.Document == nil || rd.Document.Body == nil {
+		return
+	}
+
+	children := rd.Document.Body.Children
+	for i := 0; i < len(children); i++ {
+		instr, ok := tocInstruction(children[i].Para)
+		if !ok {
+			continue
+		}
+		end := fieldEnd(children, i)
+		if end < 0 {
+			continue
+		}
+
+		minLevel, maxLevel := 1, 9
+		if m := tocLevelsSwitch.FindStringSubmatch(instr); m != nil {
+			minLevel, _ = strconv.Atoi(m[1])
+			maxLevel, _ = strconv.Atoi(m[2])
+		}
+
+		// The current entries are not headings, so they are left out whatever their style
+		paras := rd.tocParagraphs(instr, rd.tocEntries(minLevel, maxLevel))
+		replaced := make([]DocumentChild, 0, len(children)-(end-i+1)+len(paras))
+		replaced = append(replaced, children[:i]...)
+		for _, p := range paras {
+			replaced = append(replaced, DocumentChild{Para: p})
+		}
+		children = append(replaced, children[end+1:]...)
+		i += len(paras) - 1
+	}
+	rd.Document.Body.Children = children
+}
+
+// tocEntry is a placeholder entry of a table of contents.
+type tocEntry struct {
+	level int
+	text  string
+}
+
+// tocEntries returns the entries of a table of contents of the Heading paragraphs of the
+// document body with a level in the range, in document order.
+func (rd *RootDoc) tocEntries(minLevel, maxLevel int) []tocEntry {
+	var entries []tocEntry
+	rd.walkParagraphs(func(p *ctypes.Paragraph) {
+		if p.Property == nil || p.Property.Style == nil {
+			return
+		}
+		level, ok := headingLevel(p.Property.Style.Val)
+		if !ok || level < minLevel || level > maxLevel {
+			return
+		}
+
+		var sb strings.Builder
+		writeParagraphText(&sb, p)
+		if text := strings.TrimSpace(sb.String()); text != "" {
+			entries = append(entries, tocEntry{level: level, text: text})
+		}
+	})
+	return entries
+}
+
+// tocParagraphs returns the paragraphs of a table of contents field with the instruction,
+// spanning one paragraph per entry, or a single paragraph with a placeholder text.
+func (rd *RootDoc) tocParagraphs(instr string, entries []tocEntry) []*Paragraph {
+	if len(entries) == 0 {
+		p := newParagraph(rd)
+		p.addField(instr, tocPlaceholder)
+		return []*Paragraph{p}
+	}
+
+	paras := make([]*Paragraph, len(entries))
+	for i, entry := range entries {
+		p := newParagraph(rd)
+		p.Style(fmt.Sprintf("TOC%d", entry.level))
+
+		run := &ctypes.Run{Children: []ctypes.RunChild{{Text: ctypes.TextFromString(entry.text)}}}
+		if i == 0 {
+			// The field begins with the first entry, and ends with the last one
+			field := fieldChildren(instr, entry.text)
+			run.Children = field[:len(field)-1]
+		}
+		p.ct.Children = append(p.ct.Children, ctypes.ParagraphChild{Run: run})
+		paras[i] = p
+	}
+
+	last := &paras[len(paras)-1].ct
+	last.Children = append(last.Children, ctypes.ParagraphChild{Run: &ctypes.Run{
+		Children: []ctypes.RunChild{{FldChar: ctypes.NewFldChar(stypes.FldCharTypeEnd)}},
+	}})
+	return paras
+}
+
+// tocInstruction returns the instruction of the TOC field beginning in the paragraph, and
+// reports whether there is one.
+func tocInstruction(p *Paragraph) (string, bool) {
+	if p == nil {
+		return "", false
+	}
+
+	begun := false
+	for _, child := range p.ct.Children {
+		if child.Run == nil {
+			continue
+		}
+		for _, runChild := range child.Run.Children {
+			switch {
+			case runChild.FldChar != nil && runChild.FldChar.FldCharType == stypes.FldCharTypeBegin:
+				begun = true
+			case begun && runChild.InstrText != nil:
+				instr := strings.TrimSpace(runChild.InstrText.Text)
+				return instr, strings.HasPrefix(instr, "TOC ") || instr == "TOC"
+			}
+		}
+	}
+	return "", false
+}
+
+// fieldEnd returns the index of the paragraph of the children where the field beginning in
+// the paragraph at index start ends, or -1 if it does not end in a paragraph of the body.
+func fieldEnd(children []DocumentChild, start int) int {
+	depth := 0
+	for i := start; i < len(children); i++ {
+		if children[i].Para == nil {
+			return -1
+		}
+		for _, child := range children[i].Para.ct.Children {
+			if child.Run == nil {
+				continue
+			}
+			for _, runChild := range child.Run.Children {
+				if runChild.FldChar == nil {
+					continue
+				}
+				switch runChild.FldChar.FldCharType {
+				case stypes.FldCharTypeBegin:
+					depth++
+				case stypes.FldCharTypeEnd:
+					depth--
+					if depth == 0 {
+						return i
+					}
+				}
+			}
+		}
+	}
+	return -1
+}
@@ -0,0 +1,109 @@
+package docx
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/MamaShip/godocx/wml/stypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTOCOptions_instruction(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     TOCOptions
+		expected string
+	}{
+		{"Defaults", DefaultTOCOptions(), `TOC \o "1-3" \h \z \u`},
+		{"Zero value", TOCOptions{}, `TOC \o "1-3"`},
+		{"Custom range", TOCOptions{MinLevel: 2, MaxLevel: 4, Hyperlinks: true}, `TOC \o "2-4" \h`},
+		{"Inverted range", TOCOptions{MinLevel: 3, MaxLevel: 1}, `TOC \o "3-3"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.opts.instruction())
+		})
+	}
+}
+
+func TestAddTableOfContents(t *testing.T) {
+	rd := setupRootDoc(t)
+
+	p := rd.AddTableOfContents(DefaultTOCOptions())
+	assert.Len(t, rd.Document.Body.Children, 1)
+
+	out, err := xml.Marshal(p.ct)
+	if err != nil {
+		t.Fatalf("marshal paragraph: %v", err)
+	}
+
+	expected := `<w:p><w:r>` +
+		`<w:fldChar w:fldCharType="begin" w:dirty="true"></w:fldChar>` +
+		`<w:instrText xml:space="preserve"> TOC \o &#34;1-3&#34; \h \z \u </w:instrText>` +
+		`<w:fldChar w:fldCharType="separate"></w:fldChar>` +
+		`<w:t>Right-click and choose Update Field to build the table of contents.</w:t>` +
+		`<w:fldChar w:fldCharType="end"></w:fldChar>` +
+		`</w:r></w:p>`
+	assert.Equal(t, expected, string(out))
+}
+
+func TestAddTableOfContents_PlaceholderEntries(t *testing.T) {
+	rd := setupRootDoc(t)
+	_, err := rd.AddHeading("Introduction", 1)
+	require.NoError(t, err)
+	_, err = rd.AddHeading("Scope", 2)
+	require.NoError(t, err)
+	_, err = rd.AddHeading("Details", 4)
+	require.NoError(t, err)
+
+	opts := TOCOptions{MaxLevel: 3, Hyperlinks: true, PlaceholderEntries: true}
+	p := rd.AddTableOfContents(opts)
+	children := rd.Document.Body.Children
+	require.Len(t, children, 5)
+	assert.Same(t, p, children[3].Para)
+
+	first, err := xml.Marshal(children[3].Para.ct)
+	require.NoError(t, err)
+	assert.Equal(t, `<w:p><w:pPr><w:pStyle w:val="TOC1"></w:pStyle></w:pPr><w:r>`+
+		`<w:fldChar w:fldCharType="begin" w:dirty="true"></w:fldChar>`+
+		`<w:instrText xml:space="preserve"> TOC \o &#34;1-3&#34; \h </w:instrText>`+
+		`<w:fldChar w:fldCharType="separate"></w:fldChar>`+
+		`<w:t>Introduction</w:t></w:r></w:p>`, string(first))
+
+	last, err := xml.Marshal(children[4].Para.ct)
+	require.NoError(t, err)
+	assert.Equal(t, `<w:p><w:pPr><w:pStyle w:val="TOC2"></w:pStyle></w:pPr>`+
+		`<w:r><w:t>Scope</w:t></w:r><w:r><w:fldChar w:fldCharType="end"></w:fldChar></w:r></w:p>`, string(last))
+
+	toc2 := rd.GetStyleByID("TOC2", stypes.StyleTypeParagraph)
+	require.NotNil(t, toc2)
+	assert.Equal(t, 220, *toc2.ParaProp.Indent.Left)
+}
+
+func TestUpdateTableOfContents(t *testing.T) {
+	rd := setupRootDoc(t)
+	opts := DefaultTOCOptions()
+	opts.PlaceholderEntries = true
+	rd.AddTableOfContents(opts)
+	require.Len(t, rd.Document.Body.Children, 1, "no headings yet")
+
+	_, err := rd.AddHeading("Introduction", 1)
+	require.NoError(t, err)
+	_, err = rd.AddHeading("Background", 2)
+	require.NoError(t, err)
+	rd.AddParagraph("Text")
+
+	rd.UpdateTableOfContents()
+	children := rd.Document.Body.Children
+	require.Len(t, children, 5)
+	assert.Equal(t, "Introduction", children[0].Para.Text())
+	assert.Equal(t, "Background", children[1].Para.Text())
+	assert.Equal(t, "TOC2", children[1].Para.ct.Property.Style.Val)
+	assert.Equal(t, 1, fieldEnd(children, 0))
+
+	// Updating again rebuilds the entries instead of adding them
+	rd.UpdateTableOfContents()
+	assert.Len(t, rd.Document.Body.Children, 5)
+}
@@ -0,0 +1,262 @@
+package docx
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ValidationError describes a value of the document that Word may reject or report as corrupt.
+type ValidationError struct {
+	Path    string // Path of the offending element, e.g. w:document/w:body/w:p[2]/w:pPr/w:pBdr/w:bottom
+	Message string // Description of the problem
+}
+
+// Error implements the error interface.
+func (ve ValidationError) Error() string {
+	return ve.Path + ": " + ve.Message
+}
+
+// ValidationErrors is the error returned when a document written in strict mode fails to validate.
+type ValidationErrors []ValidationError
+
+// Error implements the error interface, reporting the first problem and the number of others.
+func (errs ValidationErrors) Error() string {
+	if len(errs) == 0 {
+		return "document is valid"
+	}
+	if len(errs) == 1 {
+		return "invalid document: " + errs[0].Error()
+	}
+	return "invalid document: " + errs[0].Error() + " (and " + strconv.Itoa(len(errs)-1) + " more)"
+}
+
+// Border sizes, in eighths of a point, accepted by Word for line borders.
+const (
+	minBorderSize = 2
+	maxBorderSize = 96
+)
+
+var (
+	hexColorPattern = regexp.MustCompile(`^[0-9A-Fa-f]{6}$`)
+	numDefPattern   = regexp.MustCompile(`<w:num\b[^>]*\bw:numId="(\d+)"`)
+)
+
+// SetStrictValidation sets whether the document is validated before being written; when set,
+// Save, SaveTo, Write and WriteTo fail with ValidationErrors instead of writing a document
+// that Validate reports as invalid.
+func (rd *RootDoc) SetStrictValidation(strict bool) {
+	rd.strict = strict
+}
+
+// Validate checks the main document part against constraints of the OOXML schema that are
+// commonly broken when building documents, and returns the problems found, if any:
+//   - colors are 6 hex digits or "auto" (w:color, and the color and fill of borders and shading);
+//   - line border sizes are between 2 and 96 eighths of a point;
+//   - paragraph spacing values are not negative;
+//   - numbering references (w:numId) resolve to a list of the numbering part;
+//   - relationship IDs (r:id, r:embed, ...) resolve to a relationship of the document;
+//   - the columns of a section fit in the width of its text area.
+//
+// Example:
+//
+//	for _, problem := range document.Validate() {
+//		log.Println(problem)
+//	}
+func (rd *RootDoc) Validate() []ValidationError {
+	if rd.Document == nil {
+		return nil
+	}
+
+	content, err := xml.Marshal(rd.Document)
+	if err != nil {
+		return []ValidationError{{Path: "w:document", Message: err.Error()}}
+	}
+
+	v := &validator{
+		relIDs: make(map[string]bool),
+		numIDs: map[int]bool{0: true}, // 0 removes the numbering of a paragraph
+	}
+	for _, rel := range rd.Document.DocRels.Relationships {
+		v.relIDs[rel.ID] = true
+	}
+	if numbering, ok := rd.FileMap.Load("word/numbering.xml"); ok {
+		for _, match := range numDefPattern.FindAllStringSubmatch(string(numbering.([]byte)), -1) {
+			if id, err := strconv.Atoi(match[1]); err == nil {
+				v.numIDs[id] = true
+			}
+		}
+	}
+	if rd.Numbering != nil {
+		rd.Numbering.mu.Lock()
+		for _, inst := range rd.Numbering.numbering.Instances {
+			v.numIDs[inst.NumId] = true
+		}
+		rd.Numbering.mu.Unlock()
+	}
+
+	if err := v.run(content); err != nil {
+		v.errs = append(v.errs, ValidationError{Path: "w:document", Message: err.Error()})
+	}
+	return v.errs
+}
+
+// validator checks the elements of a part in document order.
+type validator struct {
+	relIDs map[string]bool
+	numIDs map[int]bool
+	errs   []ValidationError
+
+	path   []string         // Names of the open elements, with their position among siblings of the same name
+	counts []map[string]int // Number of children seen per name, for each open element
+
+	textWidth    int // Width of the text area of the current section, in twips
+	columnsWidth int // Total width of the columns of unequal width of the current section, in twips
+}
+
+func (v *validator) run(content []byte) error {
+	d := xml.NewDecoder(bytes.NewReader(content))
+	v.counts = []map[string]int{{}}
+
+	for {
+		token, err := d.RawToken()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			name := prefixedName(t.Name)
+			siblings := v.counts[len(v.counts)-1]
+			siblings[name]++
+			if siblings[name] > 1 {
+				name += "[" + strconv.Itoa(siblings[name]) + "]"
+			}
+			v.path = append(v.path, name)
+			v.counts = append(v.counts, map[string]int{})
+
+			v.check(t)
+		case xml.EndElement:
+			if prefixedName(t.Name) == "w:cols" {
+				v.checkColumns()
+			}
+			v.path = v.path[:len(v.path)-1]
+			v.counts = v.counts[:len(v.counts)-1]
+		}
+	}
+}
+
+func (v *validator) report(format string, args ...any) {
+	message := fmt.Sprintf(format, args...)
+	v.errs = append(v.errs, ValidationError{Path: strings.Join(v.path, "/"), Message: message})
+}
+
+// check validates the attributes of the element at the end of the path.
+func (v *validator) check(elem xml.StartElement) {
+	name := prefixedName(elem.Name)
+	parent := ""
+	if len(v.path) > 1 {
+		parent = v.path[len(v.path)-2]
+		if idx := strings.IndexByte(parent, '['); idx >= 0 {
+			parent = parent[:idx]
+		}
+	}
+
+	attrs := make(map[string]string, len(elem.Attr))
+	for _, attr := range elem.Attr {
+		attrs[prefixedName(attr.Name)] = attr.Value
+
+		if attr.Name.Space == "r" && !v.relIDs[attr.Value] {
+			v.report("relationship %q of %s is not defined", attr.Value, prefixedName(attr.Name))
+		}
+	}
+
+	// Colors
+	if value, ok := attrs["w:val"]; ok && name == "w:color" {
+		v.checkColor("w:val", value)
+	}
+	for _, attr := range []string{"w:color", "w:fill"} {
+		if value, ok := attrs[attr]; ok {
+			v.checkColor(attr, value)
+		}
+	}
+
+	// Line border sizes
+	if size, ok := attrs["w:sz"]; ok && isBorder(name, parent) && attrs["w:val"] != "nil" && attrs["w:val"] != "none" {
+		if n, err := strconv.Atoi(size); err != nil || n < minBorderSize || n > maxBorderSize {
+			v.report("border size %q is outside of the 2 to 96 range (eighths of a point)", size)
+		}
+	}
+
+	// Paragraph spacing
+	if name == "w:spacing" && parent == "w:pPr" {
+		for _, attr := range []string{"w:before", "w:after", "w:line", "w:beforeLines", "w:afterLines"} {
+			if value, ok := attrs[attr]; ok {
+				if n, err := strconv.Atoi(value); err != nil || n < 0 {
+					v.report("spacing %s %q is not a non-negative number", attr, value)
+				}
+			}
+		}
+	}
+
+	// Numbering references
+	if value, ok := attrs["w:val"]; ok && name == "w:numId" && parent == "w:numPr" {
+		if id, err := strconv.Atoi(value); err != nil || !v.numIDs[id] {
+			v.report("numbering %q is not defined", value)
+		}
+	}
+
+	// Section columns, whose page size and margins come first
+	switch {
+	case name == "w:sectPr":
+		v.textWidth = defaultPageWidth - 2*defaultPageMargin
+		v.columnsWidth = 0
+	case name == "w:pgSz" && parent == "w:sectPr":
+		if width, err := strconv.Atoi(attrs["w:w"]); err == nil {
+			v.textWidth += width - defaultPageWidth
+		}
+	case name == "w:pgMar" && parent == "w:sectPr":
+		for _, attr := range []string{"w:left", "w:right"} {
+			if margin, err := strconv.Atoi(attrs[attr]); err == nil {
+				v.textWidth += defaultPageMargin - margin
+			}
+		}
+	case name == "w:col" && parent == "w:cols":
+		width, _ := strconv.Atoi(attrs["w:w"])
+		space, _ := strconv.Atoi(attrs["w:space"])
+		v.columnsWidth += width + space
+	}
+}
+
+// checkColumns reports columns of unequal width wider than the text area of their section.
+func (v *validator) checkColumns() {
+	if v.columnsWidth > v.textWidth {
+		v.report("columns are %d twips wide, more than the %d twips of the text area", v.columnsWidth, v.textWidth)
+	}
+}
+
+func (v *validator) checkColor(attr, value string) {
+	if value != "auto" && !hexColorPattern.MatchString(value) {
+		v.report("color %q of %s is neither 6 hex digits nor \"auto\"", value, attr)
+	}
+}
+
+// isBorder reports whether the element is a border edge, e.g. w:top of w:pBdr or w:bdr of a run.
+func isBorder(name, parent string) bool {
+	return name == "w:bdr" || strings.HasSuffix(parent, "Bdr") || strings.HasSuffix(parent, "Borders")
+}
+
+// prefixedName returns the name as written in the source, e.g. w:p.
+func prefixedName(name xml.Name) string {
+	if name.Space == "" {
+		return name.Local
+	}
+	return name.Space + ":" + name.Local
+}
@@ -0,0 +1,90 @@
+package docx_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	godocx "github.com/MamaShip/godocx"
+	"github.com/MamaShip/godocx/docx"
+	"github.com/MamaShip/godocx/wml/ctypes"
+	"github.com/MamaShip/godocx/wml/stypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate_ValidDocument(t *testing.T) {
+	rd := buildElaborateDoc(t)
+	rd.AddHeader(docx.HeaderFooterDefault).AddParagraph("Header")
+	rd.AddParagraph("See ").AddLink("example", "https://example.com")
+	rd.AddHorizontalLine()
+	rd.AddParagraph("Shaded").Shading(stypes.ShdClear, "auto", "D9D9D9")
+
+	assert.Empty(t, rd.Validate())
+}
+
+func TestValidate_Problems(t *testing.T) {
+	rd, err := godocx.NewDocument()
+	require.NoError(t, err)
+
+	rd.AddParagraph("Red").AddText("!").Color("red")
+	rd.AddCustomHorizontalLine(stypes.BorderStyleSingle, 120, "auto")
+	rd.AddParagraph("Item").Numbering(42, 0)
+	p := rd.AddParagraph("")
+	p.AddLink("broken", "https://example.com")
+	p.GetCT().Children[1].Link.ID = "rId999"
+
+	problems := rd.Validate()
+	require.Len(t, problems, 4)
+
+	assert.Equal(t, "w:document/w:body/w:p/w:r[2]/w:rPr/w:color", problems[0].Path)
+	assert.Contains(t, problems[0].Message, `color "red"`)
+
+	assert.Equal(t, "w:document/w:body/w:p[2]/w:pPr/w:pBdr/w:bottom", problems[1].Path)
+	assert.Contains(t, problems[1].Message, `border size "120"`)
+
+	assert.Equal(t, "w:document/w:body/w:p[3]/w:pPr/w:numPr/w:numId", problems[2].Path)
+	assert.Contains(t, problems[2].Message, `numbering "42"`)
+
+	assert.Equal(t, "w:document/w:body/w:p[4]/w:hyperlink", problems[3].Path)
+	assert.Contains(t, problems[3].Message, `relationship "rId999"`)
+}
+
+func TestValidate_StrictWrite(t *testing.T) {
+	rd, err := godocx.NewDocument()
+	require.NoError(t, err)
+	rd.AddParagraph("Spacing").Spacing(0, 0)
+	rd.AddParagraph("Color").AddText("!").Color("12345")
+
+	// Writing is not validated by default
+	var buf bytes.Buffer
+	require.NoError(t, rd.Write(&buf))
+
+	rd.SetStrictValidation(true)
+	buf.Reset()
+	err = rd.Write(&buf)
+
+	var problems docx.ValidationErrors
+	require.True(t, errors.As(err, &problems))
+	assert.Len(t, problems, 1)
+	assert.Zero(t, buf.Len())
+	assert.Contains(t, err.Error(), `invalid document: w:document/w:body/w:p[2]/w:r[2]/w:rPr/w:color: color "12345"`)
+}
+
+func TestValidate_Columns(t *testing.T) {
+	rd, err := godocx.NewDocument()
+	require.NoError(t, err)
+	rd.SetPageSize(12240, 15840)
+	rd.SetPageMargins(1440, 1440, 1440, 1440, 720, 720, 0)
+
+	rd.AddParagraph("Fits")
+	rd.AddSectionBreak(stypes.SectionMarkNextContinuous).
+		SetColumnsCustom([]ctypes.ColumnDef{{Width: 6120, Space: 360}, {Width: 2880}}, true)
+	assert.Empty(t, rd.Validate())
+
+	rd.SetPageMargins(1440, 2160, 1440, 2160, 720, 720, 0)
+	problems := rd.Validate()
+	require.Len(t, problems, 1)
+	assert.Equal(t, "w:document/w:body/w:sectPr/w:cols", problems[0].Path)
+	assert.Contains(t, problems[0].Message, "9360 twips wide, more than the 7920 twips")
+}
@@ -0,0 +1,65 @@
+package docx
+
+import "github.com/MamaShip/godocx/wml/ctypes"
+
+// walkParagraphs calls fn for every paragraph of the document body, including
+// paragraphs nested in table cells and block level content controls, in document order.
+func (rd *RootDoc) walkParagraphs(fn func(p *ctypes.Paragraph)) {
+	if rd.Document == nil || rd.Document.Body == nil {
+		return
+	}
+
+	walkChildrenParagraphs(rd.Document.Body.Children, fn)
+}
+
+// walkChildrenParagraphs calls fn for every paragraph of the body children, recursing into
+// tables and block level content controls.
+func walkChildrenParagraphs(children []DocumentChild, fn func(p *ctypes.Paragraph)) {
+	for _, child := range children {
+		if child.Para != nil {
+			fn(&child.Para.ct)
+		}
+
+		if child.Table != nil {
+			walkTableParagraphs(&child.Table.ct, fn)
+		}
+
+		if child.Sdt != nil {
+			walkChildrenParagraphs(child.Sdt.children, fn)
+		}
+	}
+}
+
+// walkTableParagraphs calls fn for every paragraph contained in the table, recursing into nested tables.
+func walkTableParagraphs(tbl *ctypes.Table, fn func(p *ctypes.Paragraph)) {
+	walkRowsParagraphs(tbl.RowContents, fn)
+}
+
+// walkRowsParagraphs calls fn for every paragraph of the cells of the rows, including the rows
+// wrapped in content controls.
+func walkRowsParagraphs(rows []ctypes.RowContent, fn func(p *ctypes.Paragraph)) {
+	for _, rowContent := range rows {
+		if rowContent.Sdt != nil {
+			walkRowsParagraphs(rowContent.Sdt.Rows, fn)
+		}
+		if rowContent.Row == nil {
+			continue
+		}
+
+		for _, cellContent := range rowContent.Row.Contents {
+			if cellContent.Cell == nil {
+				continue
+			}
+
+			for _, block := range cellContent.Cell.Contents {
+				if block.Paragraph != nil {
+					fn(block.Paragraph)
+				}
+
+				if block.Table != nil {
+					walkTableParagraphs(block.Table, fn)
+				}
+			}
+		}
+	}
+}
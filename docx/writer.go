@@ -25,17 +25,35 @@ func (rd *RootDoc) Write(w io.Writer) error {
 	return err
 }
 
-// WriteTo implements io.WriterTo to write the RootDoc to an io.Writer.
+// WriteTo implements io.WriterTo to write the RootDoc to an io.Writer, such as an
+// http.ResponseWriter, and returns the number of bytes written.
+//
+// The document is left unchanged, so WriteTo can be called again, e.g. after further edits,
+// and writing the same document twice yields the same bytes.
 func (rd *RootDoc) WriteTo(w io.Writer) (int64, error) {
+	cw := &countingWriter{w: w}
+	err := rd.writeDirectToWriter(cw)
+	return cw.n, err
+}
 
-	if err := rd.writeDirectToWriter(w); err != nil {
-		return 0, err
-	}
-	return 0, nil
+// countingWriter counts the bytes written to the underlying writer.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+	return n, err
 }
 
 // writeDirectToWriter writes the RootDoc directly to an io.Writer using a zip.Writer.
 func (rd *RootDoc) writeDirectToWriter(w io.Writer) error {
+	if err := rd.validateStrict(); err != nil {
+		return err
+	}
+
 	zw := zip.NewWriter(w)
 	if err := rd.writeToZip(zw); err != nil {
 		_ = zw.Close()
@@ -44,6 +62,17 @@ func (rd *RootDoc) writeDirectToWriter(w io.Writer) error {
 	return zw.Close()
 }
 
+// validateStrict returns the problems reported by Validate in strict mode, or nil.
+func (rd *RootDoc) validateStrict() error {
+	if !rd.strict {
+		return nil
+	}
+	if errs := rd.Validate(); len(errs) > 0 {
+		return ValidationErrors(errs)
+	}
+	return nil
+}
+
 // writeToZip provides a function to write to zip.Writer
 func (rd *RootDoc) writeToZip(zw *zip.Writer) error {
 
@@ -85,6 +114,42 @@ func (rd *RootDoc) writeToZip(zw *zip.Writer) error {
 	}
 	snapshot[rd.DocStyles.RelativePath] = docStyleBytes
 
+	for path, hf := range rd.headerFooters {
+		hfContent, err := marshal(hf)
+		if err != nil {
+			return err
+		}
+		snapshot[path] = hfContent
+	}
+
+	if rd.comments != nil {
+		if rd.comments.extPath != "" {
+			rd.comments.assignParaIDs()
+			extContent, err := marshal(commentsExtended{part: rd.comments})
+			if err != nil {
+				return err
+			}
+			snapshot[rd.comments.extPath] = extContent
+		}
+
+		commentsContent, err := marshal(rd.comments)
+		if err != nil {
+			return err
+		}
+		snapshot[rd.comments.path] = commentsContent
+	}
+
+	for _, part := range []*notesPart{rd.footnotes, rd.endnotes} {
+		if part == nil {
+			continue
+		}
+		notesContent, err := marshal(part)
+		if err != nil {
+			return err
+		}
+		snapshot[part.path] = notesContent
+	}
+
 	// Persist numbering instances into numbering.xml if any
 	if rd.Numbering != nil {
 		// Apply numbering into a temporary buffer based on either existing or minimal content
@@ -138,6 +203,11 @@ func (rd *RootDoc) SaveTo(fileName string) error {
 		return errors.New("Destination file path is empty")
 	}
 
+	// Validate before truncating the destination file
+	if err := rd.validateStrict(); err != nil {
+		return err
+	}
+
 	file, err := os.OpenFile(filepath.Clean(fileName), os.O_WRONLY|os.O_TRUNC|os.O_CREATE, os.ModePerm)
 	if err != nil {
 		return err
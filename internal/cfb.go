@@ -0,0 +1,353 @@
+package internal
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"sort"
+	"strings"
+	"unicode/utf16"
+)
+
+// CompoundStream is a stream of a compound file, named by its path from the root storage,
+// e.g. "\x06DataSpaces/Version". The storages of the path are created as needed.
+type CompoundStream struct {
+	Path string
+	Data []byte
+}
+
+// Sizes and special values of a version 3 compound file ([MS-CFB]).
+const (
+	cfbSectorSize     = 512
+	cfbMiniSectorSize = 64
+	cfbMiniCutoff     = 4096
+	cfbDirEntrySize   = 128
+	cfbHeaderDIFAT    = 109
+
+	cfbDIFSect    = 0xFFFFFFFC
+	cfbFATSect    = 0xFFFFFFFD
+	cfbEndOfChain = 0xFFFFFFFE
+	cfbFreeSect   = 0xFFFFFFFF
+	cfbNoStream   = 0xFFFFFFFF
+)
+
+// Object types of the directory entries.
+const (
+	cfbTypeStorage = 1
+	cfbTypeStream  = 2
+	cfbTypeRoot    = 5
+)
+
+// cfbEntry is a directory entry of the compound file being written.
+type cfbEntry struct {
+	name     string
+	kind     byte
+	data     []byte
+	children []*cfbEntry
+
+	id          uint32
+	left, right uint32
+	child       uint32
+	start       uint32
+	size        uint64
+}
+
+// WriteCompoundFile writes the streams as a version 3 compound file binary (OLE) container,
+// the format used by encrypted Office documents. Streams smaller than 4096 bytes are stored
+// in the mini stream, as readers expect.
+func WriteCompoundFile(w io.Writer, streams []CompoundStream) error {
+	root := &cfbEntry{name: "Root Entry", kind: cfbTypeRoot}
+	for _, stream := range streams {
+		if err := root.add(strings.Split(stream.Path, "/"), stream.Data); err != nil {
+			return err
+		}
+	}
+
+	// Directory entries in breadth-first order, the root first
+	entries := []*cfbEntry{root}
+	for i := 0; i < len(entries); i++ {
+		entries[i].id = uint32(i)
+		entries = append(entries, entries[i].children...)
+	}
+	for _, entry := range entries {
+		entry.left, entry.right = cfbNoStream, cfbNoStream
+	}
+	for _, entry := range entries {
+		entry.child = cfbTree(entry.children)
+	}
+
+	// Mini stream, holding the small streams in 64-byte sectors
+	var (
+		miniStream []byte
+		miniFAT    []uint32
+	)
+	for _, entry := range entries {
+		if entry.kind != cfbTypeStream {
+			continue
+		}
+		entry.size = uint64(len(entry.data))
+		if len(entry.data) >= cfbMiniCutoff || len(entry.data) == 0 {
+			continue
+		}
+		entry.start = uint32(len(miniFAT))
+		miniFAT = appendChain(miniFAT, uint32(len(miniFAT)), sectorCount(len(entry.data), cfbMiniSectorSize))
+		miniStream = append(miniStream, pad(entry.data, cfbMiniSectorSize)...)
+	}
+	root.size = uint64(len(miniStream))
+	root.data = miniStream
+
+	// Regular sectors: large streams, then the mini stream, the mini FAT and the directory
+	var (
+		fat     []uint32
+		sectors [][]byte
+	)
+	allocate := func(data []byte) uint32 {
+		start := uint32(len(fat))
+		count := sectorCount(len(data), cfbSectorSize)
+		fat = appendChain(fat, start, count)
+		padded := pad(data, cfbSectorSize)
+		for i := 0; i < count; i++ {
+			sectors = append(sectors, padded[i*cfbSectorSize:(i+1)*cfbSectorSize])
+		}
+		return start
+	}
+
+	for _, entry := range entries {
+		switch {
+		case entry.kind == cfbTypeStream && len(entry.data) >= cfbMiniCutoff:
+			entry.start = allocate(entry.data)
+		case entry.kind == cfbTypeStream && len(entry.data) == 0:
+			entry.start = cfbEndOfChain
+		}
+	}
+
+	root.start = cfbEndOfChain
+	if len(miniStream) > 0 {
+		root.start = allocate(miniStream)
+	}
+
+	miniFATStart, miniFATSectors := uint32(cfbEndOfChain), 0
+	if len(miniFAT) > 0 {
+		miniFATSectors = sectorCount(len(miniFAT)*4, cfbSectorSize)
+		miniFATStart = allocate(uint32Bytes(miniFAT, miniFATSectors*cfbSectorSize/4))
+	}
+
+	dir := make([]byte, 0, len(entries)*cfbDirEntrySize)
+	for _, entry := range entries {
+		dir = append(dir, entry.marshal()...)
+	}
+	for len(dir)%cfbSectorSize != 0 {
+		dir = append(dir, emptyDirEntry()...)
+	}
+	dirStart := allocate(dir)
+
+	// FAT and DIFAT sectors, whose number depends on the total number of sectors
+	fatSectors, difatSectors := 0, 0
+	for {
+		total := len(fat) + fatSectors + difatSectors
+		neededFAT := sectorCount(total*4, cfbSectorSize)
+		neededDIFAT := 0
+		if neededFAT > cfbHeaderDIFAT {
+			neededDIFAT = sectorCount((neededFAT-cfbHeaderDIFAT)*4, cfbSectorSize-4)
+		}
+		if neededFAT == fatSectors && neededDIFAT == difatSectors {
+			break
+		}
+		fatSectors, difatSectors = neededFAT, neededDIFAT
+	}
+
+	fatStart := uint32(len(fat))
+	for i := 0; i < fatSectors; i++ {
+		fat = append(fat, cfbFATSect)
+	}
+	difatStart := uint32(len(fat))
+	for i := 0; i < difatSectors; i++ {
+		fat = append(fat, cfbDIFSect)
+	}
+
+	fatBytes := uint32Bytes(fat, fatSectors*cfbSectorSize/4)
+	for i := 0; i < fatSectors; i++ {
+		sectors = append(sectors, fatBytes[i*cfbSectorSize:(i+1)*cfbSectorSize])
+	}
+
+	fatLocations := make([]uint32, fatSectors)
+	for i := range fatLocations {
+		fatLocations[i] = fatStart + uint32(i)
+	}
+
+	for i := 0; i < difatSectors; i++ {
+		// 127 FAT sector locations, then the location of the next DIFAT sector
+		from := cfbHeaderDIFAT + i*(cfbSectorSize/4-1)
+		to := from + cfbSectorSize/4 - 1
+		if to > fatSectors {
+			to = fatSectors
+		}
+		locations := uint32Bytes(fatLocations[from:to], cfbSectorSize/4)
+		next := uint32(cfbEndOfChain)
+		if i < difatSectors-1 {
+			next = difatStart + uint32(i) + 1
+		}
+		binary.LittleEndian.PutUint32(locations[cfbSectorSize-4:], next)
+		sectors = append(sectors, locations)
+	}
+
+	// Header
+	header := make([]byte, cfbSectorSize)
+	copy(header, []byte{0xD0, 0xCF, 0x11, 0xE0, 0xA1, 0xB1, 0x1A, 0xE1})
+	binary.LittleEndian.PutUint16(header[24:], 0x003E) // Minor version
+	binary.LittleEndian.PutUint16(header[26:], 0x0003) // Major version
+	binary.LittleEndian.PutUint16(header[28:], 0xFFFE) // Byte order
+	binary.LittleEndian.PutUint16(header[30:], 9)      // Sector shift
+	binary.LittleEndian.PutUint16(header[32:], 6)      // Mini sector shift
+	binary.LittleEndian.PutUint32(header[44:], uint32(fatSectors))
+	binary.LittleEndian.PutUint32(header[48:], dirStart)
+	binary.LittleEndian.PutUint32(header[56:], cfbMiniCutoff)
+	binary.LittleEndian.PutUint32(header[60:], miniFATStart)
+	binary.LittleEndian.PutUint32(header[64:], uint32(miniFATSectors))
+	binary.LittleEndian.PutUint32(header[68:], cfbEndOfChain)
+	if difatSectors > 0 {
+		binary.LittleEndian.PutUint32(header[68:], difatStart)
+	}
+	binary.LittleEndian.PutUint32(header[72:], uint32(difatSectors))
+	for i := 0; i < cfbHeaderDIFAT; i++ {
+		location := uint32(cfbFreeSect)
+		if i < fatSectors {
+			location = fatLocations[i]
+		}
+		binary.LittleEndian.PutUint32(header[76+4*i:], location)
+	}
+
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+	for _, sector := range sectors {
+		if _, err := w.Write(sector); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// add adds the stream at the given path below the storage, creating the intermediate storages.
+func (e *cfbEntry) add(path []string, data []byte) error {
+	name := path[0]
+	if name == "" || len(utf16.Encode([]rune(name))) > 31 {
+		return errors.New("invalid compound file entry name: " + name)
+	}
+
+	var entry *cfbEntry
+	for _, child := range e.children {
+		if child.name == name {
+			entry = child
+		}
+	}
+
+	if len(path) == 1 {
+		if entry != nil {
+			return errors.New("duplicate compound file entry: " + name)
+		}
+		e.children = append(e.children, &cfbEntry{name: name, kind: cfbTypeStream, data: data})
+		return nil
+	}
+
+	if entry == nil {
+		entry = &cfbEntry{name: name, kind: cfbTypeStorage}
+		e.children = append(e.children, entry)
+	} else if entry.kind != cfbTypeStorage {
+		return errors.New("compound file entry is not a storage: " + name)
+	}
+	return entry.add(path[1:], data)
+}
+
+// cfbTree links the entries of a storage as a balanced binary search tree in the order of
+// the compound file names (shorter names first, then case-insensitive), and returns its root.
+func cfbTree(children []*cfbEntry) uint32 {
+	if len(children) == 0 {
+		return cfbNoStream
+	}
+
+	sorted := append([]*cfbEntry(nil), children...)
+	sort.Slice(sorted, func(i, j int) bool {
+		a, b := utf16.Encode([]rune(sorted[i].name)), utf16.Encode([]rune(sorted[j].name))
+		if len(a) != len(b) {
+			return len(a) < len(b)
+		}
+		return strings.ToUpper(sorted[i].name) < strings.ToUpper(sorted[j].name)
+	})
+
+	var link func(entries []*cfbEntry) uint32
+	link = func(entries []*cfbEntry) uint32 {
+		if len(entries) == 0 {
+			return cfbNoStream
+		}
+		mid := len(entries) / 2
+		entries[mid].left = link(entries[:mid])
+		entries[mid].right = link(entries[mid+1:])
+		return entries[mid].id
+	}
+	return link(sorted)
+}
+
+// marshal returns the 128-byte directory entry.
+func (e *cfbEntry) marshal() []byte {
+	b := make([]byte, cfbDirEntrySize)
+
+	name := utf16.Encode([]rune(e.name))
+	for i, unit := range name {
+		binary.LittleEndian.PutUint16(b[2*i:], unit)
+	}
+	binary.LittleEndian.PutUint16(b[64:], uint16(2*(len(name)+1)))
+	b[66] = e.kind
+	b[67] = 1 // Black
+	binary.LittleEndian.PutUint32(b[68:], e.left)
+	binary.LittleEndian.PutUint32(b[72:], e.right)
+	binary.LittleEndian.PutUint32(b[76:], e.child)
+	if e.kind != cfbTypeStorage {
+		binary.LittleEndian.PutUint32(b[116:], e.start)
+		binary.LittleEndian.PutUint64(b[120:], e.size)
+	}
+	return b
+}
+
+func emptyDirEntry() []byte {
+	b := make([]byte, cfbDirEntrySize)
+	binary.LittleEndian.PutUint32(b[68:], cfbNoStream)
+	binary.LittleEndian.PutUint32(b[72:], cfbNoStream)
+	binary.LittleEndian.PutUint32(b[76:], cfbNoStream)
+	return b
+}
+
+// appendChain appends a chain of count consecutive sectors starting at start to the
+// allocation table.
+func appendChain(table []uint32, start uint32, count int) []uint32 {
+	for i := 1; i < count; i++ {
+		table = append(table, start+uint32(i))
+	}
+	return append(table, cfbEndOfChain)
+}
+
+func sectorCount(size, sectorSize int) int {
+	return (size + sectorSize - 1) / sectorSize
+}
+
+// pad returns the data padded with zeros to a multiple of size.
+func pad(data []byte, size int) []byte {
+	if len(data)%size == 0 {
+		return data
+	}
+	return append(append([]byte(nil), data...), make([]byte, size-len(data)%size)...)
+}
+
+// uint32Bytes returns the values in little-endian order, followed by free sector markers up
+// to count values.
+func uint32Bytes(values []uint32, count int) []byte {
+	b := make([]byte, 4*count)
+	for i := 0; i < count; i++ {
+		value := uint32(cfbFreeSect)
+		if i < len(values) {
+			value = values[i]
+		}
+		binary.LittleEndian.PutUint32(b[4*i:], value)
+	}
+	return b
+}
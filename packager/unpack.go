@@ -4,6 +4,7 @@ import (
 	"archive/zip"
 	"bytes"
 	"fmt"
+	"io"
 	"path"
 	"strings"
 
@@ -15,7 +16,12 @@ import (
 
 // ReadFromZip reads files from a zip archive.
 func ReadFromZip(content *[]byte) (map[string][]byte, error) {
-	zipReader, err := zip.NewReader(bytes.NewReader(*content), int64(len(*content)))
+	return ReadFromReaderAt(bytes.NewReader(*content), int64(len(*content)))
+}
+
+// ReadFromReaderAt reads files from a zip archive of the given size read from r.
+func ReadFromReaderAt(r io.ReaderAt, size int64) (map[string][]byte, error) {
+	zipReader, err := zip.NewReader(r, size)
 	if err != nil {
 		return nil, err
 	}
@@ -36,15 +42,40 @@ func ReadFromZip(content *[]byte) (map[string][]byte, error) {
 	return fileList, nil
 }
 
+// Unpack parses the docx package in content into a RootDoc.
 func Unpack(content *[]byte) (*docx.RootDoc, error) {
+	return UnpackWithLogger(content, nil)
+}
 
-	rd := docx.NewRootDoc()
-
+// UnpackWithLogger parses the docx package in content into a RootDoc, reporting the parts
+// that were parsed and the unknown elements that were skipped to logger.
+// The logger is kept on the returned RootDoc; a nil logger disables diagnostics.
+func UnpackWithLogger(content *[]byte, logger docx.Logger) (*docx.RootDoc, error) {
 	fileIndex, err := ReadFromZip(content)
 	if err != nil {
 		return nil, err
 	}
 
+	return unpackFiles(fileIndex, logger)
+}
+
+// UnpackReaderAt parses the docx package of the given size read from r into a RootDoc,
+// without loading the whole package in memory first. See UnpackWithLogger for the logger.
+func UnpackReaderAt(r io.ReaderAt, size int64, logger docx.Logger) (*docx.RootDoc, error) {
+	fileIndex, err := ReadFromReaderAt(r, size)
+	if err != nil {
+		return nil, err
+	}
+
+	return unpackFiles(fileIndex, logger)
+}
+
+// unpackFiles parses the files of a docx package, indexed by path, into a RootDoc.
+func unpackFiles(fileIndex map[string][]byte, logger docx.Logger) (*docx.RootDoc, error) {
+
+	rd := docx.NewRootDoc()
+	rd.SetLogger(logger)
+
 	// Load content type details
 	ctBytes := fileIndex[constants.ConentTypeFileIdx]
 	ct, err := LoadContentTypes(ctBytes)
@@ -53,6 +84,7 @@ func Unpack(content *[]byte) (*docx.RootDoc, error) {
 	}
 	delete(fileIndex, constants.ConentTypeFileIdx)
 	rd.ContentType = *ct
+	rd.LogDebug("parsed part", "part", constants.ConentTypeFileIdx)
 
 	rd.ImageCount = 0
 
@@ -68,6 +100,7 @@ func Unpack(content *[]byte) (*docx.RootDoc, error) {
 	}
 	delete(fileIndex, *rootRelURI)
 	rd.RootRels = *rootRelations
+	rd.LogDebug("parsed part", "part", *rootRelURI)
 
 	var docPath string
 
@@ -95,6 +128,7 @@ func Unpack(content *[]byte) (*docx.RootDoc, error) {
 	}
 	delete(fileIndex, docPath)
 	rd.Document = docObj
+	rd.LogDebug("parsed part", "part", docPath)
 
 	// Load Relationship details
 	docRelFile := fileIndex[*docRelURI]
@@ -104,6 +138,7 @@ func Unpack(content *[]byte) (*docx.RootDoc, error) {
 	}
 	delete(fileIndex, *rootRelURI)
 	rd.Document.DocRels = *docRelations
+	rd.LogDebug("parsed part", "part", *docRelURI)
 
 	wordDir := path.Dir(docPath)
 
@@ -127,6 +162,7 @@ func Unpack(content *[]byte) (*docx.RootDoc, error) {
 			}
 			delete(fileIndex, stylesPath)
 			rd.DocStyles = stylesObj
+			rd.LogDebug("parsed part", "part", stylesPath)
 		}
 	}
 
@@ -137,6 +173,7 @@ func Unpack(content *[]byte) (*docx.RootDoc, error) {
 			rd.ImageCount += 1
 		}
 		rd.FileMap.Store(fileName, fileContent)
+		rd.LogDebug("kept part unparsed", "part", fileName)
 	}
 
 	return rd, nil
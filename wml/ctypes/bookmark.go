@@ -0,0 +1,38 @@
+package ctypes
+
+import (
+	"encoding/xml"
+	"strconv"
+)
+
+// BookmarkStart marks the start of a bookmark (w:bookmarkStart).
+type BookmarkStart struct {
+	ID   int    `xml:"id,attr"`
+	Name string `xml:"name,attr"`
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+func (b BookmarkStart) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name.Local = "w:bookmarkStart"
+	start.Attr = []xml.Attr{
+		{Name: xml.Name{Local: "w:id"}, Value: strconv.Itoa(b.ID)},
+		{Name: xml.Name{Local: "w:name"}, Value: b.Name},
+	}
+
+	return e.EncodeElement("", start)
+}
+
+// BookmarkEnd marks the end of the bookmark with the same ID (w:bookmarkEnd).
+type BookmarkEnd struct {
+	ID int `xml:"id,attr"`
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+func (b BookmarkEnd) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name.Local = "w:bookmarkEnd"
+	start.Attr = []xml.Attr{
+		{Name: xml.Name{Local: "w:id"}, Value: strconv.Itoa(b.ID)},
+	}
+
+	return e.EncodeElement("", start)
+}
@@ -0,0 +1,50 @@
+package ctypes
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+)
+
+func TestBookmark_MarshalXML(t *testing.T) {
+	var result strings.Builder
+	encoder := xml.NewEncoder(&result)
+
+	if err := (BookmarkStart{ID: 3, Name: "_Intro"}).MarshalXML(encoder, xml.StartElement{}); err != nil {
+		t.Fatalf("Error marshaling XML: %v", err)
+	}
+	if err := (BookmarkEnd{ID: 3}).MarshalXML(encoder, xml.StartElement{}); err != nil {
+		t.Fatalf("Error marshaling XML: %v", err)
+	}
+	encoder.Flush()
+
+	expected := `<w:bookmarkStart w:id="3" w:name="_Intro"></w:bookmarkStart><w:bookmarkEnd w:id="3"></w:bookmarkEnd>`
+	if result.String() != expected {
+		t.Errorf("Expected XML:\n%s\nGot:\n%s", expected, result.String())
+	}
+}
+
+func TestBookmark_UnmarshalXML(t *testing.T) {
+	inputXML := `<w:p xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main">` +
+		`<w:bookmarkStart w:id="7" w:name="Intro"/><w:r><w:t>Text</w:t></w:r><w:bookmarkEnd w:id="7"/>` +
+		`</w:p>`
+
+	var p Paragraph
+	if err := xml.Unmarshal([]byte(inputXML), &p); err != nil {
+		t.Fatalf("Error unmarshaling XML: %v", err)
+	}
+
+	if len(p.Children) != 3 {
+		t.Fatalf("Expected 3 children, got %d", len(p.Children))
+	}
+
+	start := p.Children[0].BookmarkStart
+	if start == nil || start.ID != 7 || start.Name != "Intro" {
+		t.Errorf("Unexpected bookmark start: %+v", start)
+	}
+
+	end := p.Children[2].BookmarkEnd
+	if end == nil || end.ID != 7 {
+		t.Errorf("Unexpected bookmark end: %+v", end)
+	}
+}
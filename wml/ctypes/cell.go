@@ -84,7 +84,7 @@ loop:
 					Table: &tbl,
 				})
 			default:
-				if err = d.Skip(); err != nil {
+				if err = skipElement(d, elem, "tc"); err != nil {
 					return err
 				}
 			}
@@ -0,0 +1,59 @@
+package ctypes
+
+import (
+	"encoding/xml"
+	"strconv"
+
+	"github.com/MamaShip/godocx/wml/stypes"
+)
+
+// Columns represents the text columns of a section (w:cols).
+type Columns struct {
+	EqualWidth stypes.OnOff `xml:"equalWidth,attr,omitempty"` // Columns of equal width, spaced by Space
+	Space      *int         `xml:"space,attr,omitempty"`      // Spacing between equal width columns, in twips
+	Num        *int         `xml:"num,attr,omitempty"`        // Number of equal width columns
+	Sep        stypes.OnOff `xml:"sep,attr,omitempty"`        // Vertical line between the columns
+	Col        []ColumnDef  `xml:"col,omitempty"`             // Individual columns, when not of equal width
+}
+
+// ColumnDef represents a single column of a section with columns of unequal width (w:col).
+type ColumnDef struct {
+	Width int `xml:"w,attr"`               // Width of the column, in twips
+	Space int `xml:"space,attr,omitempty"` // Spacing after the column, in twips
+}
+
+// MarshalXML implements the xml.Marshaler interface for the Columns type.
+func (c Columns) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name.Local = "w:cols"
+	start.Attr = nil
+
+	if c.EqualWidth != "" {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "w:equalWidth"}, Value: string(c.EqualWidth)})
+	}
+	if c.Space != nil {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "w:space"}, Value: strconv.Itoa(*c.Space)})
+	}
+	if c.Num != nil {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "w:num"}, Value: strconv.Itoa(*c.Num)})
+	}
+	if c.Sep != "" {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "w:sep"}, Value: string(c.Sep)})
+	}
+
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+
+	for _, col := range c.Col {
+		colElem := xml.StartElement{Name: xml.Name{Local: "w:col"}}
+		colElem.Attr = append(colElem.Attr, xml.Attr{Name: xml.Name{Local: "w:w"}, Value: strconv.Itoa(col.Width)})
+		if col.Space != 0 {
+			colElem.Attr = append(colElem.Attr, xml.Attr{Name: xml.Name{Local: "w:space"}, Value: strconv.Itoa(col.Space)})
+		}
+		if err := e.EncodeElement("", colElem); err != nil {
+			return err
+		}
+	}
+
+	return e.EncodeToken(xml.EndElement{Name: start.Name})
+}
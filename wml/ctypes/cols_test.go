@@ -0,0 +1,98 @@
+package ctypes
+
+import (
+	"encoding/xml"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/MamaShip/godocx/wml/stypes"
+)
+
+func TestColumns_MarshalXML(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    Columns
+		expected string
+	}{
+		{
+			name:     "Equal width",
+			input:    Columns{Space: intPtr(720), Num: intPtr(2)},
+			expected: `<w:cols w:space="720" w:num="2"></w:cols>`,
+		},
+		{
+			name: "Unequal width with separator",
+			input: Columns{
+				EqualWidth: stypes.OnOffZero,
+				Num:        intPtr(2),
+				Sep:        stypes.OnOffOne,
+				Col:        []ColumnDef{{Width: 5760, Space: 720}, {Width: 2880}},
+			},
+			expected: `<w:cols w:equalWidth="0" w:num="2" w:sep="1"><w:col w:w="5760" w:space="720"></w:col><w:col w:w="2880"></w:col></w:cols>`,
+		},
+		{
+			name:     "No attributes",
+			input:    Columns{},
+			expected: `<w:cols></w:cols>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var result strings.Builder
+			encoder := xml.NewEncoder(&result)
+
+			err := tt.input.MarshalXML(encoder, xml.StartElement{})
+			if err != nil {
+				t.Fatalf("Error marshaling XML: %v", err)
+			}
+
+			if err = encoder.Flush(); err != nil {
+				t.Fatalf("Error marshaling XML: %v", err)
+			}
+
+			if result.String() != tt.expected {
+				t.Errorf("Expected XML:\n%s\n\nGot:\n%s", tt.expected, result.String())
+			}
+		})
+	}
+}
+
+func TestColumns_UnmarshalXML(t *testing.T) {
+	tests := []struct {
+		name     string
+		inputXML string
+		expected Columns
+	}{
+		{
+			name:     "Equal width",
+			inputXML: `<w:cols w:space="720" w:num="3"></w:cols>`,
+			expected: Columns{Space: intPtr(720), Num: intPtr(3)},
+		},
+		{
+			name:     "Unequal width with separator",
+			inputXML: `<w:cols w:equalWidth="0" w:num="2" w:sep="1"><w:col w:w="5760" w:space="720"/><w:col w:w="2880"/></w:cols>`,
+			expected: Columns{
+				EqualWidth: stypes.OnOffZero,
+				Num:        intPtr(2),
+				Sep:        stypes.OnOffOne,
+				Col:        []ColumnDef{{Width: 5760, Space: 720}, {Width: 2880}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var result Columns
+
+			err := xml.Unmarshal([]byte(tt.inputXML), &result)
+			if err != nil {
+				t.Fatalf("Error during unmarshaling: %v", err)
+			}
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %+v but got %+v", tt.expected, result)
+			}
+		})
+	}
+}
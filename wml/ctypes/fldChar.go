@@ -0,0 +1,35 @@
+package ctypes
+
+import (
+	"encoding/xml"
+
+	"github.com/MamaShip/godocx/wml/stypes"
+)
+
+// FldChar represents a complex field character (w:fldChar).
+//
+// A complex field is made of a begin character, the field instructions (w:instrText),
+// an optional separator followed by the field result, and an end character.
+type FldChar struct {
+	FldCharType stypes.FldCharType `xml:"fldCharType,attr"`
+	Dirty       *stypes.OnOff      `xml:"dirty,attr,omitempty"` // Field result is stale and should be recalculated
+}
+
+// NewFldChar creates a new FldChar element with the given type.
+func NewFldChar(fldCharType stypes.FldCharType) *FldChar {
+	return &FldChar{FldCharType: fldCharType}
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+func (f FldChar) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name.Local = "w:fldChar"
+	start.Attr = []xml.Attr{
+		{Name: xml.Name{Local: "w:fldCharType"}, Value: string(f.FldCharType)},
+	}
+
+	if f.Dirty != nil {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "w:dirty"}, Value: string(*f.Dirty)})
+	}
+
+	return e.EncodeElement("", start)
+}
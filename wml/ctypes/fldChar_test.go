@@ -0,0 +1,58 @@
+package ctypes
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/MamaShip/godocx/internal"
+	"github.com/MamaShip/godocx/wml/stypes"
+)
+
+func TestFldChar_MarshalXML(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    FldChar
+		expected string
+	}{
+		{
+			name:     "Begin",
+			input:    *NewFldChar(stypes.FldCharTypeBegin),
+			expected: `<w:fldChar w:fldCharType="begin"></w:fldChar>`,
+		},
+		{
+			name:     "Dirty",
+			input:    FldChar{FldCharType: stypes.FldCharTypeBegin, Dirty: internal.ToPtr(stypes.OnOffTrue)},
+			expected: `<w:fldChar w:fldCharType="begin" w:dirty="true"></w:fldChar>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var result strings.Builder
+			encoder := xml.NewEncoder(&result)
+
+			if err := tt.input.MarshalXML(encoder, xml.StartElement{}); err != nil {
+				t.Fatalf("Error marshaling XML: %v", err)
+			}
+			encoder.Flush()
+
+			if result.String() != tt.expected {
+				t.Errorf("Expected XML:\n%s\nGot:\n%s", tt.expected, result.String())
+			}
+		})
+	}
+}
+
+func TestFldChar_UnmarshalXML(t *testing.T) {
+	var result FldChar
+	input := `<w:fldChar xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main" w:fldCharType="end"></w:fldChar>`
+
+	if err := xml.Unmarshal([]byte(input), &result); err != nil {
+		t.Fatalf("Error unmarshaling XML: %v", err)
+	}
+
+	if result.FldCharType != stypes.FldCharTypeEnd {
+		t.Errorf("Expected %s but got %s", stypes.FldCharTypeEnd, result.FldCharType)
+	}
+}
@@ -0,0 +1,33 @@
+package ctypes
+
+import (
+	"encoding/xml"
+	"strconv"
+
+	"github.com/MamaShip/godocx/wml/stypes"
+)
+
+// FtnEdnRef is a reference to a footnote (w:footnoteReference) or an endnote (w:endnoteReference),
+// displayed as the note number at its position in the run.
+type FtnEdnRef struct {
+	// ID of the referenced note in the footnotes or endnotes part
+	ID int `xml:"id,attr"`
+
+	// Whether the reference is followed by a custom mark instead of the note number
+	CustomMarkFollows *stypes.OnOff `xml:"customMarkFollows,attr,omitempty"`
+}
+
+// NewFtnEdnRef creates a reference to the note with the given ID.
+func NewFtnEdnRef(id int) *FtnEdnRef {
+	return &FtnEdnRef{ID: id}
+}
+
+// MarshalXML implements the xml.Marshaler interface for the FtnEdnRef type.
+// The element name is taken from start, e.g. w:footnoteReference.
+func (f FtnEdnRef) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if f.CustomMarkFollows != nil {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "w:customMarkFollows"}, Value: string(*f.CustomMarkFollows)})
+	}
+	start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "w:id"}, Value: strconv.Itoa(f.ID)})
+	return e.EncodeElement("", start)
+}
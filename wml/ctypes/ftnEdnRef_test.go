@@ -0,0 +1,80 @@
+package ctypes
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/MamaShip/godocx/internal"
+	"github.com/MamaShip/godocx/wml/stypes"
+)
+
+func TestFtnEdnRef_MarshalXML(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    FtnEdnRef
+		elemName string
+		expected string
+	}{
+		{
+			name:     "Footnote reference",
+			input:    FtnEdnRef{ID: 2},
+			elemName: "w:footnoteReference",
+			expected: `<w:footnoteReference w:id="2"></w:footnoteReference>`,
+		},
+		{
+			name:     "Endnote reference with custom mark",
+			input:    FtnEdnRef{ID: 1, CustomMarkFollows: internal.ToPtr(stypes.OnOffTrue)},
+			elemName: "w:endnoteReference",
+			expected: `<w:endnoteReference w:customMarkFollows="true" w:id="1"></w:endnoteReference>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var result strings.Builder
+			encoder := xml.NewEncoder(&result)
+			start := xml.StartElement{Name: xml.Name{Local: tt.elemName}}
+
+			if err := tt.input.MarshalXML(encoder, start); err != nil {
+				t.Fatalf("Error marshaling XML: %v", err)
+			}
+
+			if err := encoder.Flush(); err != nil {
+				t.Fatalf("Error flushing XML encoder: %v", err)
+			}
+
+			if result.String() != tt.expected {
+				t.Errorf("Expected XML:\n%s\nGot:\n%s", tt.expected, result.String())
+			}
+		})
+	}
+}
+
+func TestRun_NoteReferencesRoundTrip(t *testing.T) {
+	input := `<w:r><w:footnoteReference w:id="3"></w:footnoteReference><w:endnoteReference w:id="1"></w:endnoteReference>` +
+		`<w:footnoteRef></w:footnoteRef><w:separator></w:separator><w:continuationSeparator></w:continuationSeparator></w:r>`
+
+	var run Run
+	if err := xml.Unmarshal([]byte(input), &run); err != nil {
+		t.Fatalf("Error unmarshaling XML: %v", err)
+	}
+
+	if len(run.Children) != 5 {
+		t.Fatalf("Expected 5 children, got %d", len(run.Children))
+	}
+	if run.Children[0].FootnoteReference == nil || run.Children[0].FootnoteReference.ID != 3 {
+		t.Errorf("Expected footnote reference 3, got %+v", run.Children[0])
+	}
+	if run.Children[1].EndnoteReference == nil || run.Children[1].EndnoteReference.ID != 1 {
+		t.Errorf("Expected endnote reference 1, got %+v", run.Children[1])
+	}
+
+	output, err := xml.Marshal(run)
+	if err != nil {
+		t.Fatalf("Error marshaling XML: %v", err)
+	}
+	if string(output) != input {
+		t.Errorf("Expected XML:\n%s\nGot:\n%s", input, string(output))
+	}
+}
@@ -0,0 +1,116 @@
+package ctypes
+
+import (
+	"encoding/xml"
+
+	"github.com/MamaShip/godocx/internal"
+	"github.com/MamaShip/godocx/wml/stypes"
+)
+
+// Hyperlink represents a w:hyperlink element wrapping the runs of a link.
+type Hyperlink struct {
+	// Attributes
+	ID          string        // r:id - Relationship ID of the external link target
+	Anchor      string        // w:anchor - Name of the bookmark targeted by an internal link
+	TgtFrame    string        // w:tgtFrame - Frame the target is opened in, e.g. _blank
+	Tooltip     string        // w:tooltip - Text shown when hovering over the link
+	DocLocation string        // w:docLocation - Location in the target document
+	History     *stypes.OnOff // w:history - Whether the target is added to the viewed hyperlinks
+
+	// Content
+	Run      *Run             // First run of the link, when the link starts with a run
+	Children []ParagraphChild // Run level content following Run
+}
+
+func (h Hyperlink) MarshalXML(e *xml.Encoder, start xml.StartElement) (err error) {
+	start.Name.Local = "w:hyperlink"
+	start.Attr = nil
+
+	if h.ID != "" {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "r:id"}, Value: h.ID})
+	}
+
+	if h.Anchor != "" {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "w:anchor"}, Value: h.Anchor})
+	}
+
+	if h.TgtFrame != "" {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "w:tgtFrame"}, Value: h.TgtFrame})
+	}
+
+	if h.Tooltip != "" {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "w:tooltip"}, Value: h.Tooltip})
+	}
+
+	if h.DocLocation != "" {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "w:docLocation"}, Value: h.DocLocation})
+	}
+
+	if h.History != nil {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "w:history"}, Value: string(*h.History)})
+	}
+
+	if err = e.EncodeToken(start); err != nil {
+		return err
+	}
+
+	if h.Run != nil {
+		if err = h.Run.MarshalXML(e, xml.StartElement{}); err != nil {
+			return err
+		}
+	}
+
+	if err = marshalParagraphChildren(e, h.Children); err != nil {
+		return err
+	}
+
+	return e.EncodeToken(start.End())
+}
+
+func (h *Hyperlink) UnmarshalXML(d *xml.Decoder, start xml.StartElement) (err error) {
+	for _, attr := range start.Attr {
+		switch attr.Name.Local {
+		case "id":
+			h.ID = attr.Value
+		case "anchor":
+			h.Anchor = attr.Value
+		case "tgtFrame":
+			h.TgtFrame = attr.Value
+		case "tooltip":
+			h.Tooltip = attr.Value
+		case "docLocation":
+			h.DocLocation = attr.Value
+		case "history":
+			h.History = internal.ToPtr(stypes.OnOff(attr.Value))
+		}
+	}
+
+loop:
+	for {
+		currentToken, err := d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch elem := currentToken.(type) {
+		case xml.StartElement:
+			child, ok, err := decodeParagraphChild(d, elem, "hyperlink")
+			if err != nil {
+				return err
+			}
+			if !ok {
+				continue
+			}
+
+			if child.Run != nil && h.Run == nil && len(h.Children) == 0 {
+				h.Run = child.Run
+			} else {
+				h.Children = append(h.Children, child)
+			}
+		case xml.EndElement:
+			break loop
+		}
+	}
+
+	return nil
+}
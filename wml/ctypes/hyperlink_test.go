@@ -0,0 +1,138 @@
+package ctypes
+
+import (
+	"encoding/xml"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestHyperlink_MarshalXML(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    Hyperlink
+		expected string
+	}{
+		{
+			name: "External link",
+			input: Hyperlink{
+				ID:  "rId5",
+				Run: &Run{Children: []RunChild{{Text: TextFromString("Example")}}},
+			},
+			expected: `<w:hyperlink r:id="rId5"><w:r><w:t>Example</w:t></w:r></w:hyperlink>`,
+		},
+		{
+			name: "Internal link",
+			input: Hyperlink{
+				Anchor: "Intro",
+				Run:    &Run{Children: []RunChild{{Text: TextFromString("Introduction")}}},
+			},
+			expected: `<w:hyperlink w:anchor="Intro"><w:r><w:t>Introduction</w:t></w:r></w:hyperlink>`,
+		},
+		{
+			name: "Multiple runs",
+			input: Hyperlink{
+				ID:  "rId6",
+				Run: &Run{Children: []RunChild{{Text: TextFromString("A")}}},
+				Children: []ParagraphChild{
+					{Run: &Run{Children: []RunChild{{Text: TextFromString("B")}}}},
+				},
+			},
+			expected: `<w:hyperlink r:id="rId6"><w:r><w:t>A</w:t></w:r><w:r><w:t>B</w:t></w:r></w:hyperlink>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var result strings.Builder
+			encoder := xml.NewEncoder(&result)
+
+			err := tt.input.MarshalXML(encoder, xml.StartElement{})
+			if err != nil {
+				t.Fatalf("Error marshaling XML: %v", err)
+			}
+
+			encoder.Flush()
+
+			if result.String() != tt.expected {
+				t.Errorf("Expected XML:\n%s\nGot:\n%s", tt.expected, result.String())
+			}
+		})
+	}
+}
+
+func TestHyperlink_UnmarshalXML(t *testing.T) {
+	inputXML := `<w:p xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		`<w:r><w:t>See </w:t></w:r>` +
+		`<w:hyperlink r:id="rId7"><w:r><w:rPr><w:rStyle w:val="Hyperlink"/></w:rPr><w:t>here</w:t></w:r><w:r><w:t>!</w:t></w:r></w:hyperlink>` +
+		`</w:p>`
+
+	var p Paragraph
+	if err := xml.Unmarshal([]byte(inputXML), &p); err != nil {
+		t.Fatalf("Error unmarshaling XML: %v", err)
+	}
+
+	if len(p.Children) != 2 || p.Children[1].Link == nil {
+		t.Fatalf("Expected hyperlink as second paragraph child, got %+v", p.Children)
+	}
+
+	link := p.Children[1].Link
+	if link.ID != "rId7" {
+		t.Errorf("Expected ID rId7, got %s", link.ID)
+	}
+	if link.Run == nil || link.Run.Property == nil || link.Run.Property.Style == nil || link.Run.Property.Style.Val != "Hyperlink" {
+		t.Errorf("Expected hyperlink run with Hyperlink style, got %+v", link.Run)
+	}
+	if len(link.Children) != 1 {
+		t.Errorf("Expected 1 extra run, got %d", len(link.Children))
+	}
+
+	// Marshal again and make sure the link is preserved
+	var result strings.Builder
+	encoder := xml.NewEncoder(&result)
+	if err := p.MarshalXML(encoder, xml.StartElement{}); err != nil {
+		t.Fatalf("Error marshaling XML: %v", err)
+	}
+	encoder.Flush()
+
+	expected := `<w:hyperlink r:id="rId7"><w:r><w:rPr><w:rStyle w:val="Hyperlink"></w:rStyle></w:rPr><w:t>here</w:t></w:r><w:r><w:t>!</w:t></w:r></w:hyperlink>`
+	if !strings.Contains(result.String(), expected) {
+		t.Errorf("Expected round-tripped XML to contain:\n%s\nGot:\n%s", expected, result.String())
+	}
+
+	var again Paragraph
+	if err := xml.Unmarshal([]byte(result.String()), &again); err != nil {
+		t.Fatalf("Error unmarshaling round-tripped XML: %v", err)
+	}
+	if !reflect.DeepEqual(p.Children[1].Link, again.Children[1].Link) {
+		t.Errorf("Hyperlink changed after round trip")
+	}
+}
+
+func TestHyperlink_RoundTripContent(t *testing.T) {
+	link := `<w:hyperlink r:id="rId7" w:tgtFrame="_blank" w:tooltip="Open the report" w:docLocation="table2" w:history="1">` +
+		`<w:bookmarkStart w:id="3" w:name="report"></w:bookmarkStart><w:r><w:t>Report </w:t></w:r>` +
+		`<w:ins w:id="4" w:author="Jane Doe"><w:r><w:t>NEW</w:t></w:r></w:ins><w:bookmarkEnd w:id="3"></w:bookmarkEnd>` +
+		`<w:fldSimple w:instr=" PAGE "><w:r><w:t>2</w:t></w:r></w:fldSimple></w:hyperlink>`
+	inputXML := `<w:p xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		link + `</w:p>`
+
+	var p Paragraph
+	if err := xml.Unmarshal([]byte(inputXML), &p); err != nil {
+		t.Fatalf("Error unmarshaling XML: %v", err)
+	}
+	if len(p.Children) != 1 || p.Children[0].Link == nil {
+		t.Fatalf("Expected a hyperlink, got %+v", p.Children)
+	}
+	if got := p.Children[0].Link; got.Run != nil || len(got.Children) != 5 {
+		t.Errorf("Expected the content of the link in its children, got %+v", got)
+	}
+
+	output, err := xml.Marshal(p.Children[0].Link)
+	if err != nil {
+		t.Fatalf("Error marshaling XML: %v", err)
+	}
+	if string(output) != link {
+		t.Errorf("Expected XML:\n%s\nGot:\n%s", link, output)
+	}
+}
@@ -119,6 +119,22 @@ type ParagraphProp struct {
 	PPrChange *PPrChange `xml:"pPrChange,omitempty"`
 }
 
+func (pp *ParagraphProp) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type paragraphProp ParagraphProp
+	aux := struct {
+		*paragraphProp
+		Unknown []unknownElement `xml:",any"`
+	}{paragraphProp: (*paragraphProp)(pp)}
+
+	if err := d.DecodeElement(&aux, &start); err != nil {
+		return err
+	}
+	for _, elem := range aux.Unknown {
+		notifySkipped(d, elem.XMLName, "pPr")
+	}
+	return nil
+}
+
 type binElems struct {
 	elem    *OnOff
 	XMLName string
@@ -385,12 +401,12 @@ func (p PPrChange) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	start.Name.Local = "w:pPrChange"
 
 	start.Attr = []xml.Attr{
-		{Name: xml.Name{Local: "id"}, Value: strconv.Itoa(p.ID)},
-		{Name: xml.Name{Local: "author"}, Value: p.Author},
+		{Name: xml.Name{Local: "w:id"}, Value: strconv.Itoa(p.ID)},
+		{Name: xml.Name{Local: "w:author"}, Value: p.Author},
 	}
 
 	if p.Date != nil {
-		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "date"}, Value: *p.Date})
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "w:date"}, Value: *p.Date})
 	}
 
 	err := e.EncodeToken(start)
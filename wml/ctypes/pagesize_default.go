@@ -70,4 +70,26 @@ var (
 		Orient: stypes.PageOrientPortrait,
 		Code:   &A5Code,
 	}
+
+	// Letter
+	LetterWidth  = uint64(12240) // 8.5in in twips
+	LetterHeight = uint64(15840) // 11in in twips
+	LetterCode   = 2
+	Letter       = &PageSize{
+		Width:  &LetterWidth,
+		Height: &LetterHeight,
+		Orient: stypes.PageOrientPortrait,
+		Code:   &LetterCode,
+	}
+
+	// Legal
+	LegalWidth  = uint64(12240) // 8.5in in twips
+	LegalHeight = uint64(20160) // 14in in twips
+	LegalCode   = 3
+	Legal       = &PageSize{
+		Width:  &LegalWidth,
+		Height: &LegalHeight,
+		Orient: stypes.PageOrientPortrait,
+		Code:   &LegalCode,
+	}
 )
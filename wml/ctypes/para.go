@@ -16,6 +16,7 @@ type Paragraph struct {
 	RsidDel      *stypes.LongHexNum // Revision Identifier for Paragraph Deletion
 	RsidP        *stypes.LongHexNum // Revision Identifier for Paragraph Properties
 	RsidRDefault *stypes.LongHexNum // Default Revision Identifier for Runs
+	ParaID       *stypes.LongHexNum // Paragraph identifier of Word 2010 (w14:paraId), e.g. linking comments to their replies
 
 	// 1. Paragraph Properties
 	Property *ParagraphProp
@@ -25,15 +26,19 @@ type Paragraph struct {
 }
 
 type ParagraphChild struct {
-	Link *Hyperlink // w:hyperlink
-	Run  *Run       // i.e w:r
-}
+	Link          *Hyperlink     // w:hyperlink
+	Sdt           *SdtRun        // w:sdt
+	Run           *Run           // i.e w:r
+	BookmarkStart *BookmarkStart // w:bookmarkStart
+	BookmarkEnd   *BookmarkEnd   // w:bookmarkEnd
 
-type Hyperlink struct {
-	XMLName  xml.Name `xml:"http://schemas.openxmlformats.org/wordprocessingml/2006/main hyperlink,omitempty"`
-	ID       string   `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
-	Run      *Run
-	Children []ParagraphChild
+	CommentRangeStart *Markup // w:commentRangeStart
+	CommentRangeEnd   *Markup // w:commentRangeEnd
+
+	Ins *RunTrackChange // w:ins
+	Del *RunTrackChange // w:del
+
+	Raw *RawXML // Run level content written as is
 }
 
 func (p Paragraph) MarshalXML(e *xml.Encoder, start xml.StartElement) (err error) {
@@ -55,6 +60,9 @@ func (p Paragraph) MarshalXML(e *xml.Encoder, start xml.StartElement) (err error
 	if p.RsidRDefault != nil {
 		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "w:rsidRDefault"}, Value: string(*p.RsidRDefault)})
 	}
+	if p.ParaID != nil {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "w14:paraId"}, Value: string(*p.ParaID)})
+	}
 
 	if err = e.EncodeToken(start); err != nil {
 		return err
@@ -68,7 +76,17 @@ func (p Paragraph) MarshalXML(e *xml.Encoder, start xml.StartElement) (err error
 		}
 	}
 
-	for _, cElem := range p.Children {
+	if err = marshalParagraphChildren(e, p.Children); err != nil {
+		return err
+	}
+
+	// Closing </w:p> element
+	return e.EncodeToken(start.End())
+}
+
+// marshalParagraphChildren encodes the run level content of a paragraph or content control.
+func marshalParagraphChildren(e *xml.Encoder, children []ParagraphChild) (err error) {
+	for _, cElem := range children {
 		if cElem.Run != nil {
 			if err = cElem.Run.MarshalXML(e, xml.StartElement{
 				Name: xml.Name{Local: "w:r"},
@@ -78,16 +96,61 @@ func (p Paragraph) MarshalXML(e *xml.Encoder, start xml.StartElement) (err error
 		}
 
 		if cElem.Link != nil {
-			if err = e.EncodeElement(cElem.Link, xml.StartElement{
-				Name: xml.Name{Local: "w:hyperlink"},
-			}); err != nil {
+			if err = cElem.Link.MarshalXML(e, xml.StartElement{}); err != nil {
+				return err
+			}
+		}
+
+		if cElem.Sdt != nil {
+			if err = cElem.Sdt.MarshalXML(e, xml.StartElement{}); err != nil {
+				return err
+			}
+		}
+
+		if cElem.BookmarkStart != nil {
+			if err = cElem.BookmarkStart.MarshalXML(e, xml.StartElement{}); err != nil {
+				return err
+			}
+		}
+
+		if cElem.BookmarkEnd != nil {
+			if err = cElem.BookmarkEnd.MarshalXML(e, xml.StartElement{}); err != nil {
+				return err
+			}
+		}
+
+		if cElem.CommentRangeStart != nil {
+			if err = cElem.CommentRangeStart.MarshalXML(e, xml.StartElement{Name: xml.Name{Local: "w:commentRangeStart"}}); err != nil {
+				return err
+			}
+		}
+
+		if cElem.CommentRangeEnd != nil {
+			if err = cElem.CommentRangeEnd.MarshalXML(e, xml.StartElement{Name: xml.Name{Local: "w:commentRangeEnd"}}); err != nil {
+				return err
+			}
+		}
+
+		if cElem.Ins != nil {
+			if err = cElem.Ins.MarshalXML(e, xml.StartElement{Name: xml.Name{Local: "w:ins"}}); err != nil {
+				return err
+			}
+		}
+
+		if cElem.Del != nil {
+			if err = cElem.Del.MarshalXML(e, xml.StartElement{Name: xml.Name{Local: "w:del"}}); err != nil {
+				return err
+			}
+		}
+
+		if cElem.Raw != nil {
+			if err = cElem.Raw.MarshalXML(e, xml.StartElement{}); err != nil {
 				return err
 			}
 		}
 	}
 
-	// Closing </w:p> element
-	return e.EncodeToken(start.End())
+	return nil
 }
 
 func (p *Paragraph) UnmarshalXML(d *xml.Decoder, start xml.StartElement) (err error) {
@@ -104,6 +167,8 @@ func (p *Paragraph) UnmarshalXML(d *xml.Decoder, start xml.StartElement) (err er
 			p.RsidP = internal.ToPtr(stypes.LongHexNum(attr.Value))
 		case "rsidRDefault":
 			p.RsidRDefault = internal.ToPtr(stypes.LongHexNum(attr.Value))
+		case "paraId":
+			p.ParaID = internal.ToPtr(stypes.LongHexNum(attr.Value))
 		}
 	}
 
@@ -116,23 +181,20 @@ loop:
 
 		switch elem := currentToken.(type) {
 		case xml.StartElement:
-			switch elem.Name.Local {
-			case "r":
-				r := NewRun()
-				if err = d.DecodeElement(r, &elem); err != nil {
-					return err
-				}
-
-				p.Children = append(p.Children, ParagraphChild{Run: r})
-			case "pPr":
+			if elem.Name.Local == "pPr" {
 				p.Property = &ParagraphProp{}
 				if err = d.DecodeElement(p.Property, &elem); err != nil {
 					return err
 				}
-			default:
-				if err = d.Skip(); err != nil {
-					return err
-				}
+				continue
+			}
+
+			child, ok, err := decodeParagraphChild(d, elem, "p")
+			if err != nil {
+				return err
+			}
+			if ok {
+				p.Children = append(p.Children, child)
 			}
 		case xml.EndElement:
 			break loop
@@ -142,6 +204,75 @@ loop:
 	return nil
 }
 
+// decodeParagraphChild decodes the run level element of the parent element, reporting false
+// if the element is not modeled and has been skipped.
+func decodeParagraphChild(d *xml.Decoder, elem xml.StartElement, parent string) (child ParagraphChild, ok bool, err error) {
+	switch elem.Name.Local {
+	case "r":
+		r := NewRun()
+		if err = d.DecodeElement(r, &elem); err != nil {
+			return child, false, err
+		}
+		child.Run = r
+	case "hyperlink":
+		link := &Hyperlink{}
+		if err = d.DecodeElement(link, &elem); err != nil {
+			return child, false, err
+		}
+		child.Link = link
+	case "sdt":
+		sdt := &SdtRun{}
+		if err = d.DecodeElement(sdt, &elem); err != nil {
+			return child, false, err
+		}
+		child.Sdt = sdt
+	case "bookmarkStart":
+		bm := &BookmarkStart{}
+		if err = d.DecodeElement(bm, &elem); err != nil {
+			return child, false, err
+		}
+		child.BookmarkStart = bm
+	case "bookmarkEnd":
+		bm := &BookmarkEnd{}
+		if err = d.DecodeElement(bm, &elem); err != nil {
+			return child, false, err
+		}
+		child.BookmarkEnd = bm
+	case "commentRangeStart", "commentRangeEnd":
+		mark := &Markup{}
+		if err = d.DecodeElement(mark, &elem); err != nil {
+			return child, false, err
+		}
+
+		if elem.Name.Local == "commentRangeStart" {
+			child.CommentRangeStart = mark
+		} else {
+			child.CommentRangeEnd = mark
+		}
+	case "ins", "del":
+		change := &RunTrackChange{}
+		if err = d.DecodeElement(change, &elem); err != nil {
+			return child, false, err
+		}
+
+		if elem.Name.Local == "ins" {
+			child.Ins = change
+		} else {
+			child.Del = change
+		}
+	case "fldSimple":
+		raw := &RawXML{}
+		if err = d.DecodeElement(raw, &elem); err != nil {
+			return child, false, err
+		}
+		child.Raw = raw
+	default:
+		return child, false, skipElement(d, elem, parent)
+	}
+
+	return child, true, nil
+}
+
 func (p *Paragraph) AddText(text string) *Run {
 	t := TextFromString(text)
 
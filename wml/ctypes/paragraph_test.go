@@ -48,3 +48,45 @@ func TestParagraphXML(t *testing.T) {
 		t.Errorf("Original and unmarshaled paragraphs are not equal.")
 	}
 }
+
+func TestParagraphCommentRangeRoundTrip(t *testing.T) {
+	input := `<w:p><w:commentRangeStart w:id="0"></w:commentRangeStart><w:r><w:t>Reviewed</w:t></w:r>` +
+		`<w:commentRangeEnd w:id="0"></w:commentRangeEnd><w:r><w:commentReference w:id="0"></w:commentReference></w:r></w:p>`
+
+	var p Paragraph
+	if err := xml.Unmarshal([]byte(input), &p); err != nil {
+		t.Fatalf("Error unmarshaling XML: %v", err)
+	}
+
+	if len(p.Children) != 4 || p.Children[0].CommentRangeStart == nil || p.Children[2].CommentRangeEnd == nil {
+		t.Fatalf("Expected comment range around the run, got %+v", p.Children)
+	}
+
+	output, err := xml.Marshal(p)
+	if err != nil {
+		t.Fatalf("Error marshaling XML: %v", err)
+	}
+	if string(output) != input {
+		t.Errorf("Expected XML:\n%s\nGot:\n%s", input, string(output))
+	}
+}
+
+func TestParagraphParaIDRoundTrip(t *testing.T) {
+	input := `<w:p w:rsidR="00A1B2C3" w14:paraId="1A2B3C4D"><w:r><w:t>Reply</w:t></w:r></w:p>`
+
+	var p Paragraph
+	if err := xml.Unmarshal([]byte(input), &p); err != nil {
+		t.Fatalf("Error unmarshaling XML: %v", err)
+	}
+	if p.ParaID == nil || *p.ParaID != "1A2B3C4D" {
+		t.Fatalf("Expected paraId 1A2B3C4D, got %v", p.ParaID)
+	}
+
+	output, err := xml.Marshal(p)
+	if err != nil {
+		t.Fatalf("Error marshaling XML: %v", err)
+	}
+	if string(output) != input {
+		t.Errorf("Expected XML:\n%s\nGot:\n%s", input, string(output))
+	}
+}
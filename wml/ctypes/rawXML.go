@@ -0,0 +1,233 @@
+package ctypes
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ErrInvalidRawXML is returned when a raw XML fragment is not well-formed or uses
+// a namespace prefix that is not declared.
+var ErrInvalidRawXML = errors.New("invalid raw XML fragment")
+
+// RawXML is a pre-validated fragment of WordprocessingML written as is, for content
+// that is not modeled by the typed API.
+type RawXML struct {
+	tokens []xml.Token
+}
+
+// NewRawXML parses the fragment, which may hold several elements, and checks that it is
+// well-formed and that every namespace prefix is either one of the given prefixes, declared
+// on the root element of the part, or declared within the fragment.
+//
+// Text outside of the elements of the fragment is only allowed if it is white space.
+func NewRawXML(fragment string, prefixes []string) (*RawXML, error) {
+	declared := map[string]bool{"xml": true, "xmlns": true}
+	for _, prefix := range prefixes {
+		declared[prefix] = true
+	}
+
+	raw := &RawXML{}
+	d := xml.NewDecoder(strings.NewReader(fragment))
+
+	// Prefixes declared within the fragment, per open element
+	var (
+		open   []xml.Name
+		scopes [][]string
+	)
+	isDeclared := func(prefix string) bool {
+		if prefix == "" || declared[prefix] {
+			return true
+		}
+		for _, scope := range scopes {
+			for _, p := range scope {
+				if p == prefix {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	for {
+		token, err := d.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidRawXML, err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			var scope []string
+			for _, attr := range t.Attr {
+				if attr.Name.Space == "xmlns" {
+					scope = append(scope, attr.Name.Local)
+				}
+			}
+			scopes = append(scopes, scope)
+			open = append(open, t.Name)
+
+			if !isDeclared(t.Name.Space) {
+				return nil, fmt.Errorf("%w: undeclared prefix %q", ErrInvalidRawXML, t.Name.Space)
+			}
+			for _, attr := range t.Attr {
+				if !isDeclared(attr.Name.Space) {
+					return nil, fmt.Errorf("%w: undeclared prefix %q", ErrInvalidRawXML, attr.Name.Space)
+				}
+			}
+		case xml.EndElement:
+			if len(open) == 0 || open[len(open)-1] != t.Name {
+				return nil, fmt.Errorf("%w: unexpected end element %s", ErrInvalidRawXML, prefixedName(t.Name))
+			}
+			open = open[:len(open)-1]
+			scopes = scopes[:len(scopes)-1]
+		case xml.CharData:
+			if len(open) == 0 && strings.TrimSpace(string(t)) != "" {
+				return nil, fmt.Errorf("%w: text outside of an element", ErrInvalidRawXML)
+			}
+		case xml.ProcInst, xml.Directive:
+			return nil, fmt.Errorf("%w: processing instructions and directives are not allowed", ErrInvalidRawXML)
+		}
+
+		raw.tokens = append(raw.tokens, xml.CopyToken(token))
+	}
+
+	if len(open) > 0 {
+		return nil, fmt.Errorf("%w: element %s is not closed", ErrInvalidRawXML, prefixedName(open[len(open)-1]))
+	}
+
+	return raw, nil
+}
+
+// MarshalXML implements the xml.Marshaler interface for the RawXML type.
+// The fragment is written in place of the start element, which is ignored.
+func (r RawXML) MarshalXML(e *xml.Encoder, _ xml.StartElement) error {
+	for _, token := range r.tokens {
+		switch t := token.(type) {
+		case xml.StartElement:
+			start := xml.StartElement{Name: xml.Name{Local: prefixedName(t.Name)}}
+			for _, attr := range t.Attr {
+				start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: prefixedName(attr.Name)}, Value: attr.Value})
+			}
+			token = start
+		case xml.EndElement:
+			token = xml.EndElement{Name: xml.Name{Local: prefixedName(t.Name)}}
+		}
+
+		if err := e.EncodeToken(token); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// prefixedName returns the name as written in the source, e.g. w:p.
+func prefixedName(name xml.Name) string {
+	if name.Space == "" {
+		return name.Local
+	}
+	return name.Space + ":" + name.Local
+}
+
+// namespacePrefixes are the prefixes of the namespaces declared on the root element of the
+// document parts, used to write back the elements decoded into a RawXML.
+var namespacePrefixes = map[string]string{
+	"http://schemas.openxmlformats.org/wordprocessingml/2006/main":           "w",
+	"urn:schemas-microsoft-com:office:office":                                "o",
+	"http://schemas.openxmlformats.org/officeDocument/2006/relationships":    "r",
+	"urn:schemas-microsoft-com:vml":                                          "v",
+	"urn:schemas-microsoft-com:office:word":                                  "w10",
+	"http://schemas.openxmlformats.org/drawingml/2006/wordprocessingDrawing": "wp",
+	"http://schemas.microsoft.com/office/word/2010/wordprocessingShape":      "wps",
+	"http://schemas.microsoft.com/office/word/2010/wordprocessingGroup":      "wpg",
+	"http://schemas.openxmlformats.org/markup-compatibility/2006":            "mc",
+	"http://schemas.microsoft.com/office/word/2010/wordprocessingDrawing":    "wp14",
+	"http://schemas.microsoft.com/office/word/2010/wordml":                   "w14",
+	"http://schemas.microsoft.com/office/word/2012/wordml":                   "w15",
+	"http://www.w3.org/XML/1998/namespace":                                   "xml",
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface for the RawXML type. The element is
+// kept with its content, for elements that are not modeled but must be written back.
+//
+// The namespaces are written back with the prefixes declared within the element, or else
+// with those of the root element of the document parts; other namespaces are declared on
+// the element that uses them.
+func (r *RawXML) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	// Names written for the open elements, and prefix of the namespaces declared within them
+	var (
+		open   []xml.Name
+		scopes []map[string]string
+	)
+	generated := 0
+
+	token := xml.Token(start)
+	for {
+		switch t := token.(type) {
+		case xml.StartElement:
+			scope := map[string]string{}
+			for _, attr := range t.Attr {
+				if attr.Name.Space == "xmlns" {
+					scope[attr.Value] = attr.Name.Local
+				} else if attr.Name.Space == "" && attr.Name.Local == "xmlns" {
+					scope[attr.Value] = ""
+				}
+			}
+			scopes = append(scopes, scope)
+
+			var declared []xml.Attr
+			prefix := func(space string) string {
+				for i := len(scopes) - 1; i >= 0; i-- {
+					if p, ok := scopes[i][space]; ok {
+						return p
+					}
+				}
+				if p, ok := namespacePrefixes[space]; ok {
+					return p
+				}
+				if !strings.Contains(space, ":") {
+					// A prefix that is not declared, left as is by the decoder
+					return space
+				}
+				generated++
+				p := fmt.Sprintf("ns%d", generated)
+				scope[space] = p
+				declared = append(declared, xml.Attr{Name: xml.Name{Space: "xmlns", Local: p}, Value: space})
+				return p
+			}
+
+			elem := xml.StartElement{Name: t.Name}
+			if t.Name.Space != "" {
+				elem.Name.Space = prefix(t.Name.Space)
+			}
+			for _, attr := range t.Attr {
+				if attr.Name.Space != "" && attr.Name.Space != "xmlns" {
+					attr.Name.Space = prefix(attr.Name.Space)
+				}
+				elem.Attr = append(elem.Attr, attr)
+			}
+			elem.Attr = append(elem.Attr, declared...)
+			r.tokens = append(r.tokens, elem)
+			open = append(open, elem.Name)
+		case xml.EndElement:
+			r.tokens = append(r.tokens, xml.EndElement{Name: open[len(open)-1]})
+			open = open[:len(open)-1]
+			scopes = scopes[:len(scopes)-1]
+			if len(open) == 0 {
+				return nil
+			}
+		case xml.CharData, xml.Comment:
+			r.tokens = append(r.tokens, xml.CopyToken(t))
+		}
+
+		var err error
+		if token, err = d.Token(); err != nil {
+			return err
+		}
+	}
+}
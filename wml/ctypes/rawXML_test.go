@@ -0,0 +1,128 @@
+package ctypes
+
+import (
+	"encoding/xml"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestNewRawXML_MarshalXML(t *testing.T) {
+	tests := []struct {
+		name     string
+		fragment string
+		expected string
+	}{
+		{
+			name:     "Single element",
+			fragment: `<w:r><w:t xml:space="preserve">Hi </w:t></w:r>`,
+			expected: `<w:r><w:t xml:space="preserve">Hi </w:t></w:r>`,
+		},
+		{
+			name:     "Several elements with self-closing tags",
+			fragment: `<w:r><w:tab/></w:r> <w:r><w:sym w:font="Wingdings" w:char="F04A"/></w:r>`,
+			expected: `<w:r><w:tab></w:tab></w:r> <w:r><w:sym w:font="Wingdings" w:char="F04A"></w:sym></w:r>`,
+		},
+		{
+			name:     "Prefix declared in the fragment",
+			fragment: `<w:r><m:oMath xmlns:m="http://schemas.openxmlformats.org/officeDocument/2006/math"><m:r/></m:oMath></w:r>`,
+			expected: `<w:r><m:oMath xmlns:m="http://schemas.openxmlformats.org/officeDocument/2006/math"><m:r></m:r></m:oMath></w:r>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw, err := NewRawXML(tt.fragment, []string{"w", "r"})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			var result strings.Builder
+			encoder := xml.NewEncoder(&result)
+			if err := raw.MarshalXML(encoder, xml.StartElement{}); err != nil {
+				t.Fatalf("Error marshaling XML: %v", err)
+			}
+			if err := encoder.Flush(); err != nil {
+				t.Fatalf("Error flushing XML encoder: %v", err)
+			}
+
+			if result.String() != tt.expected {
+				t.Errorf("Expected XML:\n%s\nGot:\n%s", tt.expected, result.String())
+			}
+		})
+	}
+}
+
+func TestNewRawXML_Invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		fragment string
+	}{
+		{"Unclosed element", `<w:r><w:t>Hi</w:t>`},
+		{"Mismatched end element", `<w:r><w:t>Hi</w:r></w:t>`},
+		{"Stray end element", `<w:r></w:r></w:p>`},
+		{"Undeclared element prefix", `<x:r></x:r>`},
+		{"Undeclared attribute prefix", `<w:r x:id="1"></w:r>`},
+		{"Prefix declared in a closed element", `<m:a xmlns:m="urn:m"></m:a><m:b></m:b>`},
+		{"Text outside of elements", `Hi<w:r></w:r>`},
+		{"Syntax error", `<w:r><</w:r>`},
+		{"Directive", `<!DOCTYPE w><w:r></w:r>`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewRawXML(tt.fragment, []string{"w", "r"})
+			if !errors.Is(err, ErrInvalidRawXML) {
+				t.Errorf("Expected ErrInvalidRawXML, got %v", err)
+			}
+		})
+	}
+}
+
+func TestRawXML_UnmarshalXML(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "Prefixes of the document",
+			input:    `<w:sdtPr xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main" xmlns:w15="http://schemas.microsoft.com/office/word/2012/wordml"><w15:appearance w15:val="hidden"/></w:sdtPr>`,
+			expected: `<w15:appearance w15:val="hidden"></w15:appearance>`,
+		},
+		{
+			name:     "Prefix declared in the element",
+			input:    `<w:r xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><m:oMath xmlns:m="http://schemas.openxmlformats.org/officeDocument/2006/math"><m:r xml:space="preserve"> x</m:r></m:oMath></w:r>`,
+			expected: `<m:oMath xmlns:m="http://schemas.openxmlformats.org/officeDocument/2006/math"><m:r xml:space="preserve"> x</m:r></m:oMath>`,
+		},
+		{
+			name:     "Namespace declared on the root element only",
+			input:    `<w:sdtPr xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main" xmlns:x="urn:example"><x:tag x:val="1"><x:a/></x:tag></w:sdtPr>`,
+			expected: `<ns1:tag ns1:val="1" xmlns:ns1="urn:example"><ns1:a></ns1:a></ns1:tag>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var container struct {
+				Raw RawXML `xml:",any"`
+			}
+			if err := xml.Unmarshal([]byte(tt.input), &container); err != nil {
+				t.Fatalf("Error unmarshaling XML: %v", err)
+			}
+
+			var result strings.Builder
+			encoder := xml.NewEncoder(&result)
+			if err := container.Raw.MarshalXML(encoder, xml.StartElement{}); err != nil {
+				t.Fatalf("Error marshaling XML: %v", err)
+			}
+			if err := encoder.Flush(); err != nil {
+				t.Fatalf("Error flushing XML encoder: %v", err)
+			}
+
+			if result.String() != tt.expected {
+				t.Errorf("Expected XML:\n%s\nGot:\n%s", tt.expected, result.String())
+			}
+		})
+	}
+}
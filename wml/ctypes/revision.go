@@ -0,0 +1,84 @@
+package ctypes
+
+import (
+	"encoding/xml"
+	"strconv"
+)
+
+// RunTrackChange is a tracked change of runs within a paragraph: an insertion (w:ins)
+// or a deletion (w:del) that can be accepted or rejected in Word.
+type RunTrackChange struct {
+	ID     int     `xml:"id,attr"`             // Unique revision ID
+	Author string  `xml:"author,attr"`         // Author of the change
+	Date   *string `xml:"date,attr,omitempty"` // Date of the change, as an ISO 8601 timestamp
+
+	Runs []*Run // Inserted or deleted runs
+}
+
+// MarshalXML implements the xml.Marshaler interface for the RunTrackChange type.
+// The element name is taken from start, e.g. w:ins.
+func (t RunTrackChange) MarshalXML(e *xml.Encoder, start xml.StartElement) (err error) {
+	start.Attr = []xml.Attr{
+		{Name: xml.Name{Local: "w:id"}, Value: strconv.Itoa(t.ID)},
+		{Name: xml.Name{Local: "w:author"}, Value: t.Author},
+	}
+	if t.Date != nil {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "w:date"}, Value: *t.Date})
+	}
+
+	if err = e.EncodeToken(start); err != nil {
+		return err
+	}
+
+	for _, run := range t.Runs {
+		if err = run.MarshalXML(e, xml.StartElement{}); err != nil {
+			return err
+		}
+	}
+
+	return e.EncodeToken(xml.EndElement{Name: start.Name})
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface for the RunTrackChange type.
+func (t *RunTrackChange) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		switch attr.Name.Local {
+		case "id":
+			id, err := strconv.Atoi(attr.Value)
+			if err != nil {
+				return err
+			}
+			t.ID = id
+		case "author":
+			t.Author = attr.Value
+		case "date":
+			date := attr.Value
+			t.Date = &date
+		}
+	}
+
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch elem := token.(type) {
+		case xml.StartElement:
+			if elem.Name.Local != "r" {
+				if err = skipElement(d, elem, start.Name.Local); err != nil {
+					return err
+				}
+				continue
+			}
+
+			run := NewRun()
+			if err = d.DecodeElement(run, &elem); err != nil {
+				return err
+			}
+			t.Runs = append(t.Runs, run)
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
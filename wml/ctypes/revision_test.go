@@ -0,0 +1,31 @@
+package ctypes
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+func TestRunTrackChange_RoundTrip(t *testing.T) {
+	input := `<w:p><w:ins w:id="1" w:author="Jane Doe" w:date="2024-03-01T09:30:00Z"><w:r><w:t>new</w:t></w:r></w:ins>` +
+		`<w:del w:id="2" w:author="Jane Doe"><w:r><w:delText xml:space="preserve">old </w:delText></w:r></w:del></w:p>`
+
+	var p Paragraph
+	if err := xml.Unmarshal([]byte(input), &p); err != nil {
+		t.Fatalf("Error unmarshaling XML: %v", err)
+	}
+
+	if len(p.Children) != 2 || p.Children[0].Ins == nil || p.Children[1].Del == nil {
+		t.Fatalf("Expected an insertion and a deletion, got %+v", p.Children)
+	}
+	if del := p.Children[1].Del; del.ID != 2 || del.Date != nil || del.Runs[0].Children[0].DelText.Text != "old " {
+		t.Errorf("Unexpected deletion %+v", del)
+	}
+
+	output, err := xml.Marshal(p)
+	if err != nil {
+		t.Fatalf("Error marshaling XML: %v", err)
+	}
+	if string(output) != input {
+		t.Errorf("Expected XML:\n%s\nGot:\n%s", input, string(output))
+	}
+}
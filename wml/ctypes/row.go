@@ -91,7 +91,7 @@ loop:
 				})
 
 			default:
-				if err = d.Skip(); err != nil {
+				if err = skipElement(d, elem, "tr"); err != nil {
 					return err
 				}
 			}
@@ -115,12 +115,16 @@ func (c TRCellContent) MarshalXML(e *xml.Encoder, start xml.StartElement) error
 }
 
 type RowContent struct {
-	Row *Row `xml:"tr,omitempty"`
+	Row *Row    `xml:"tr,omitempty"`
+	Sdt *SdtRow `xml:"sdt,omitempty"` // Content control wrapping rows
 }
 
 func (r RowContent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if r.Row != nil {
 		return r.Row.MarshalXML(e, xml.StartElement{})
 	}
+	if r.Sdt != nil {
+		return r.Sdt.MarshalXML(e, xml.StartElement{})
+	}
 	return nil
 }
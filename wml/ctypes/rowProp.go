@@ -195,7 +195,7 @@ loop:
 					return err
 				}
 			default:
-				if err = d.Skip(); err != nil {
+				if err = skipElement(d, elem, "trPr"); err != nil {
 					return err
 				}
 			}
@@ -93,13 +93,18 @@ type RunChild struct {
 	// Picture reference
 	Pict *Pict `xml:"pict,omitempty"`
 
+	//Complex Field Character
+	FldChar *FldChar `xml:"fldChar,omitempty"`
+
+	//Footnote Reference
+	FootnoteReference *FtnEdnRef `xml:"footnoteReference,omitempty"`
+
+	//Endnote Reference
+	EndnoteReference *FtnEdnRef `xml:"endnoteReference,omitempty"`
+
 	//TODO:
 	// 	w:object    Inline Embedded Object
-	// w:fldChar    Complex Field Character
 	// w:ruby    Phonetic Guide
-	// w:footnoteReference    Footnote Reference
-	// w:endnoteReference    Endnote Reference
-	// w:commentReference    Comment Content Reference Mark
 
 	//Comment Content Reference Mark
 	CmntRef *Markup `xml:"commentReference,omitempty"`
@@ -182,6 +187,27 @@ loop:
 				}
 
 				r.Children = append(r.Children, RunChild{Text: txt})
+			case "delText":
+				txt := NewText()
+				if err = d.DecodeElement(txt, &elem); err != nil {
+					return err
+				}
+
+				r.Children = append(r.Children, RunChild{DelText: txt})
+			case "instrText":
+				txt := NewText()
+				if err = d.DecodeElement(txt, &elem); err != nil {
+					return err
+				}
+
+				r.Children = append(r.Children, RunChild{InstrText: txt})
+			case "fldChar":
+				fldChar := &FldChar{}
+				if err = d.DecodeElement(fldChar, &elem); err != nil {
+					return err
+				}
+
+				r.Children = append(r.Children, RunChild{FldChar: fldChar})
 			case "rPr":
 				r.Property = &RunProperty{}
 				if err = d.DecodeElement(r.Property, &elem); err != nil {
@@ -223,10 +249,43 @@ loop:
 				r.Children = append(r.Children, RunChild{
 					Pict: pictElem,
 				})
-			default:
+			case "commentReference":
+				ref := &Markup{}
+				if err = d.DecodeElement(ref, &elem); err != nil {
+					return err
+				}
+
+				r.Children = append(r.Children, RunChild{CmntRef: ref})
+			case "footnoteReference", "endnoteReference":
+				ref := &FtnEdnRef{}
+				if err = d.DecodeElement(ref, &elem); err != nil {
+					return err
+				}
+
+				if elem.Name.Local == "footnoteReference" {
+					r.Children = append(r.Children, RunChild{FootnoteReference: ref})
+				} else {
+					r.Children = append(r.Children, RunChild{EndnoteReference: ref})
+				}
+			case "footnoteRef", "endnoteRef", "separator", "continuationSeparator":
 				if err = d.Skip(); err != nil {
 					return err
 				}
+
+				switch elem.Name.Local {
+				case "footnoteRef":
+					r.Children = append(r.Children, RunChild{FootnoteRef: &Empty{}})
+				case "endnoteRef":
+					r.Children = append(r.Children, RunChild{EndnoteRef: &Empty{}})
+				case "separator":
+					r.Children = append(r.Children, RunChild{Separator: &Empty{}})
+				default:
+					r.Children = append(r.Children, RunChild{ContSeparator: &Empty{}})
+				}
+			default:
+				if err = skipElement(d, elem, "r"); err != nil {
+					return err
+				}
 			}
 		case xml.EndElement:
 			break loop
@@ -271,6 +330,8 @@ func (r *Run) MarshalChild(e *xml.Encoder) error {
 			err = child.Text.MarshalXML(e, xml.StartElement{Name: xml.Name{Local: "w:t"}})
 		case child.DelText != nil:
 			err = child.DelText.MarshalXML(e, xml.StartElement{Name: xml.Name{Local: "w:delText"}})
+		case child.FldChar != nil:
+			err = child.FldChar.MarshalXML(e, xml.StartElement{})
 		case child.InstrText != nil:
 			err = child.InstrText.MarshalXML(e, xml.StartElement{Name: xml.Name{Local: "w:instrText"}})
 		case child.DelInstrText != nil:
@@ -319,6 +380,10 @@ func (r *Run) MarshalChild(e *xml.Encoder) error {
 			err = child.PTab.MarshalXML(e, xml.StartElement{Name: xml.Name{Local: "w:ptab"}})
 		case child.CmntRef != nil:
 			err = child.CmntRef.MarshalXML(e, xml.StartElement{Name: xml.Name{Local: "w:commentReference"}})
+		case child.FootnoteReference != nil:
+			err = child.FootnoteReference.MarshalXML(e, xml.StartElement{Name: xml.Name{Local: "w:footnoteReference"}})
+		case child.EndnoteReference != nil:
+			err = child.EndnoteReference.MarshalXML(e, xml.StartElement{Name: xml.Name{Local: "w:endnoteReference"}})
 
 		}
 
@@ -3,6 +3,7 @@ package ctypes
 import (
 	"encoding/xml"
 	"fmt"
+	"strconv"
 
 	"github.com/MamaShip/godocx/wml/stypes"
 )
@@ -125,6 +126,15 @@ type RunProperty struct {
 
 	//39.Office Open XML Math
 	OMath *OnOff `xml:"oMath,omitempty"`
+
+	//40.Inserted Paragraph, on the run properties of a paragraph mark only
+	Ins *TrackChange `xml:"ins,omitempty"`
+
+	//41.Deleted Paragraph, on the run properties of a paragraph mark only
+	Del *TrackChange `xml:"del,omitempty"`
+
+	//42.Revision Information for Run Properties
+	RPrChange *RPrChange `xml:"rPrChange,omitempty"`
 }
 
 // NewRunProperty creates a new RunProperty with default values.
@@ -138,6 +148,22 @@ type optBoolElems struct {
 }
 
 // MarshalXML marshals RunProperty to XML.
+func (rp *RunProperty) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type runProperty RunProperty
+	aux := struct {
+		*runProperty
+		Unknown []unknownElement `xml:",any"`
+	}{runProperty: (*runProperty)(rp)}
+
+	if err := d.DecodeElement(&aux, &start); err != nil {
+		return err
+	}
+	for _, elem := range aux.Unknown {
+		notifySkipped(d, elem.XMLName, "rPr")
+	}
+	return nil
+}
+
 func (rp RunProperty) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	start.Name.Local = "w:rPr"
 	err := e.EncodeToken(start)
@@ -145,6 +171,22 @@ func (rp RunProperty) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 		return err
 	}
 
+	//40, 41. Inserted and Deleted Paragraph, which come first
+	if rp.Ins != nil {
+		if err = rp.Ins.MarshalXML(e, xml.StartElement{
+			Name: xml.Name{Local: "w:ins"},
+		}); err != nil {
+			return fmt.Errorf("inserted paragraph: %w", err)
+		}
+	}
+	if rp.Del != nil {
+		if err = rp.Del.MarshalXML(e, xml.StartElement{
+			Name: xml.Name{Local: "w:del"},
+		}); err != nil {
+			return fmt.Errorf("deleted paragraph: %w", err)
+		}
+	}
+
 	// 1. Referenced Character Style
 	if rp.Style != nil {
 		if err = rp.Style.MarshalXML(e, xml.StartElement{
@@ -366,5 +408,48 @@ func (rp RunProperty) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 		}
 	}
 
+	//42.Revision Information for Run Properties
+	if rp.RPrChange != nil {
+		if err = rp.RPrChange.MarshalXML(e, xml.StartElement{}); err != nil {
+			return fmt.Errorf("run properties change: %w", err)
+		}
+	}
+
+	return e.EncodeToken(start.End())
+}
+
+// RPrChange is the revision information of run properties (w:rPrChange): the properties of
+// the run before a tracked formatting change.
+type RPrChange struct {
+	ID      int          `xml:"id,attr"`
+	Author  string       `xml:"author,attr"`
+	Date    *string      `xml:"date,attr,omitempty"`
+	RunProp *RunProperty `xml:"rPr"`
+}
+
+// MarshalXML implements the xml.Marshaler interface for the RPrChange type.
+func (r RPrChange) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name.Local = "w:rPrChange"
+	start.Attr = []xml.Attr{
+		{Name: xml.Name{Local: "w:id"}, Value: strconv.Itoa(r.ID)},
+		{Name: xml.Name{Local: "w:author"}, Value: r.Author},
+	}
+	if r.Date != nil {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "w:date"}, Value: *r.Date})
+	}
+
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+
+	// The previous properties are written even if empty, as Word requires
+	prev := r.RunProp
+	if prev == nil {
+		prev = &RunProperty{}
+	}
+	if err := prev.MarshalXML(e, xml.StartElement{}); err != nil {
+		return err
+	}
+
 	return e.EncodeToken(start.End())
 }
@@ -156,3 +156,42 @@ func TestRunProperty_UnmarshalXML(t *testing.T) {
 		})
 	}
 }
+
+func TestRunProperty_Revisions(t *testing.T) {
+	date := "2024-03-01T09:30:00Z"
+	prop := RunProperty{
+		Ins:    &TrackChange{ID: 1, Author: "Jane Doe", Date: &date},
+		Italic: optBoolElemPtr(OnOff{}),
+		RPrChange: &RPrChange{
+			ID:     2,
+			Author: "Jane Doe",
+		},
+	}
+
+	output, err := xml.Marshal(prop)
+	if err != nil {
+		t.Fatalf("Error marshaling XML: %v", err)
+	}
+	expected := `<w:rPr><w:ins w:id="1" w:author="Jane Doe" w:date="2024-03-01T09:30:00Z"></w:ins><w:i></w:i>` +
+		`<w:rPrChange w:id="2" w:author="Jane Doe"><w:rPr></w:rPr></w:rPrChange></w:rPr>`
+	if string(output) != expected {
+		t.Errorf("Expected XML:\n%s\nBut got:\n%s", expected, output)
+	}
+
+	var loaded RunProperty
+	input := `<w:rPr><w:del w:id="3" w:author="John Roe"/><w:b/>` +
+		`<w:rPrChange w:id="4" w:author="John Roe" w:date="2024-03-02T10:00:00Z"><w:rPr><w:i/></w:rPr></w:rPrChange></w:rPr>`
+	if err := xml.Unmarshal([]byte(input), &loaded); err != nil {
+		t.Fatalf("Error unmarshaling XML: %v", err)
+	}
+	if loaded.Del == nil || loaded.Del.ID != 3 || loaded.Del.Author != "John Roe" {
+		t.Errorf("Expected the paragraph deletion, got %+v", loaded.Del)
+	}
+	change := loaded.RPrChange
+	if change == nil || change.ID != 4 || change.Date == nil || *change.Date != "2024-03-02T10:00:00Z" {
+		t.Fatalf("Expected the run properties change, got %+v", change)
+	}
+	if change.RunProp == nil || change.RunProp.Italic == nil || change.RunProp.Bold != nil {
+		t.Errorf("Expected the previous properties to be italic only, got %+v", change.RunProp)
+	}
+}
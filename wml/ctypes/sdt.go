@@ -0,0 +1,700 @@
+package ctypes
+
+import (
+	"encoding/xml"
+	"sort"
+
+	"github.com/MamaShip/godocx/wml/stypes"
+)
+
+// SdtRun represents a w:sdt element within a paragraph: a content control wrapping runs,
+// e.g. a fillable field of a template.
+type SdtRun struct {
+	Property    *SdtProperty     // w:sdtPr - Properties of the content control
+	EndProperty *RawXML          // w:sdtEndPr - Formatting of the end of the content control, written back as is
+	Content     []ParagraphChild // w:sdtContent - Runs of the content control
+}
+
+// SdtProperty represents the w:sdtPr element holding the properties of a content control.
+type SdtProperty struct {
+	RunProperty        *RunProperty                  // w:rPr - Formatting of the content when it is replaced
+	Alias              *CTString                     // w:alias - Friendly name displayed by Word
+	Tag                *CTString                     // w:tag - Name used to find the content control programmatically
+	ID                 *DecimalNum                   // w:id - Unique ID of the content control
+	Lock               *GenSingleStrVal[stypes.Lock] // w:lock - Whether the content control or its content can be changed
+	ShowingPlaceholder *OnOff                        // w:showingPlcHdr - The content is placeholder text
+	DataBinding        *SdtDataBinding               // w:dataBinding - Custom XML data the content is bound to
+
+	// The type of the content control, at most one of them; a rich text one has none
+	ComboBox     *SdtList     // w:comboBox - Editable list of values
+	Date         *SdtDate     // w:date - Date picker
+	DropDownList *SdtList     // w:dropDownList - Fixed list of values
+	Picture      *Empty       // w:picture - Picture
+	Text         *SdtText     // w:text - The content control holds plain text
+	Checkbox     *SdtCheckbox // w14:checkbox - Check box, from Word 2010
+
+	RepeatingSection     *SdtRepeatingSection // w15:repeatingSection - Section repeated for each item, from Word 2013
+	RepeatingSectionItem *Empty               // w15:repeatingSectionItem - Item of a repeating section
+
+	// Elements that are not modeled, e.g. w:placeholder or w:docPartObj, written back as is
+	extra []sdtExtra
+}
+
+// sdtExtra is an element of w:sdtPr that is not modeled, with its place among the elements.
+type sdtExtra struct {
+	slot int
+	raw  *RawXML
+}
+
+// sdtPropertySlots gives the place of the elements of w:sdtPr, in the order of the schema.
+// The types of content control share a place, a content control having at most one of them.
+// Other elements, e.g. w15:appearance, keep their place after the element preceding them.
+var sdtPropertySlots = map[string]int{
+	"rPr": 0, "alias": 1, "tag": 2, "id": 3, "lock": 4, "placeholder": 5, "temporary": 6,
+	"showingPlcHdr": 7, "dataBinding": 8, "label": 9, "tabIndex": 10,
+	"equation": 11, "comboBox": 11, "date": 11, "docPartObj": 11, "docPartList": 11, "dropDownList": 11,
+	"picture": 11, "richText": 11, "text": 11, "citation": 11, "group": 11, "bibliography": 11,
+	"checkbox": 12, "repeatingSection": 13, "repeatingSectionItem": 14,
+}
+
+// SdtRow represents a w:sdt element within a table: a content control wrapping table rows,
+// e.g. a repeating section of a table.
+type SdtRow struct {
+	Property    *SdtProperty // w:sdtPr - Properties of the content control
+	EndProperty *RawXML      // w:sdtEndPr - Formatting of the end of the content control, written back as is
+	Rows        []RowContent // w:sdtContent - Rows of the content control, possibly nested content controls
+}
+
+// SdtRepeatingSection represents the w15:repeatingSection element making a content control a
+// section repeated for each item, the items being w15:repeatingSectionItem content controls.
+type SdtRepeatingSection struct {
+	SectionTitle                  *CTString `xml:"sectionTitle,omitempty"`                  // w15:sectionTitle - Title of the items in the Word UI
+	DoNotAllowInsertDeleteSection *OnOff    `xml:"doNotAllowInsertDeleteSection,omitempty"` // w15:doNotAllowInsertDeleteSection - Items cannot be added or removed in Word
+}
+
+// SdtDataBinding represents the w:dataBinding element binding the content of a content control
+// to an element of a custom XML part.
+type SdtDataBinding struct {
+	PrefixMappings string `xml:"prefixMappings,attr,omitempty"` // Namespace prefixes of the XPath, e.g. "xmlns:ns0='urn:orders'"
+	XPath          string `xml:"xpath,attr"`                    // XPath of the bound element in the custom XML part
+	StoreItemID    string `xml:"storeItemID,attr,omitempty"`    // Item ID of the custom XML part, e.g. "{8F2D...}"
+}
+
+// SdtList represents the w:comboBox and w:dropDownList elements making a content control a
+// list of values.
+type SdtList struct {
+	LastValue *string       `xml:"lastValue,attr,omitempty"` // Value of the selected item
+	Items     []SdtListItem `xml:"listItem"`                 // Items of the list
+}
+
+// SdtListItem represents a w:listItem of a combo box or drop-down list.
+type SdtListItem struct {
+	DisplayText string `xml:"displayText,attr,omitempty"` // Text shown in the list, the value if empty
+	Value       string `xml:"value,attr"`                 // Value of the item
+}
+
+// SdtDate represents the w:date element making a content control a date picker.
+type SdtDate struct {
+	FullDate          *string   `xml:"fullDate,attr,omitempty"`     // Selected date, as an ISO 8601 timestamp
+	Format            *CTString `xml:"dateFormat,omitempty"`        // Display format, e.g. "dd/MM/yyyy"
+	Lid               *CTString `xml:"lid,omitempty"`               // Language of the display format, e.g. "en-US"
+	StoreMappedDataAs *CTString `xml:"storeMappedDataAs,omitempty"` // Format of the date in XML data bindings
+	Calendar          *CTString `xml:"calendar,omitempty"`          // Calendar, e.g. "gregorian"
+}
+
+// SdtCheckbox represents the w14:checkbox element making a content control a check box.
+type SdtCheckbox struct {
+	Checked        bool              // w14:checked - The box is checked
+	CheckedState   *SdtCheckboxState // w14:checkedState - Symbol of the checked box
+	UncheckedState *SdtCheckboxState // w14:uncheckedState - Symbol of the unchecked box
+}
+
+// SdtCheckboxState is the symbol displayed by a check box in one of its states.
+type SdtCheckboxState struct {
+	Val  string `xml:"val,attr"`  // Character code of the symbol, in hexadecimal, e.g. "2612"
+	Font string `xml:"font,attr"` // Font of the symbol, e.g. "MS Gothic"
+}
+
+// SdtText represents the w:text element making a content control a plain text one.
+type SdtText struct {
+	MultiLine *stypes.OnOff `xml:"multiLine,attr,omitempty"` // Allows line breaks in the content
+}
+
+// MarshalXML implements the xml.Marshaler interface for the SdtRun type.
+func (s SdtRun) MarshalXML(e *xml.Encoder, start xml.StartElement) (err error) {
+	start.Name.Local = "w:sdt"
+	start.Attr = nil
+
+	if err = e.EncodeToken(start); err != nil {
+		return err
+	}
+
+	if s.Property != nil {
+		if err = s.Property.MarshalXML(e, xml.StartElement{}); err != nil {
+			return err
+		}
+	}
+
+	if s.EndProperty != nil {
+		if err = s.EndProperty.MarshalXML(e, xml.StartElement{}); err != nil {
+			return err
+		}
+	}
+
+	content := xml.StartElement{Name: xml.Name{Local: "w:sdtContent"}}
+	if err = e.EncodeToken(content); err != nil {
+		return err
+	}
+	if err = marshalParagraphChildren(e, s.Content); err != nil {
+		return err
+	}
+	if err = e.EncodeToken(content.End()); err != nil {
+		return err
+	}
+
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface for the SdtRun type.
+func (s *SdtRun) UnmarshalXML(d *xml.Decoder, start xml.StartElement) (err error) {
+loop:
+	for {
+		currentToken, err := d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch elem := currentToken.(type) {
+		case xml.StartElement:
+			switch elem.Name.Local {
+			case "sdtPr":
+				s.Property = &SdtProperty{}
+				if err = d.DecodeElement(s.Property, &elem); err != nil {
+					return err
+				}
+			case "sdtEndPr":
+				s.EndProperty = &RawXML{}
+				if err = d.DecodeElement(s.EndProperty, &elem); err != nil {
+					return err
+				}
+			case "sdtContent":
+				if err = s.unmarshalContent(d); err != nil {
+					return err
+				}
+			default:
+				if err = skipElement(d, elem, "sdt"); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			break loop
+		}
+	}
+
+	return nil
+}
+
+func (s *SdtRun) unmarshalContent(d *xml.Decoder) error {
+	for {
+		currentToken, err := d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch elem := currentToken.(type) {
+		case xml.StartElement:
+			child, ok, err := decodeParagraphChild(d, elem, "sdtContent")
+			if err != nil {
+				return err
+			}
+			if ok {
+				s.Content = append(s.Content, child)
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// MarshalXML implements the xml.Marshaler interface for the SdtProperty type.
+func (p SdtProperty) MarshalXML(e *xml.Encoder, start xml.StartElement) (err error) {
+	start.Name.Local = "w:sdtPr"
+	start.Attr = nil
+
+	if err = e.EncodeToken(start); err != nil {
+		return err
+	}
+
+	// The elements that are not modeled are written after the elements of their place
+	extra := make([]sdtExtra, len(p.extra))
+	copy(extra, p.extra)
+	sort.SliceStable(extra, func(i, j int) bool { return extra[i].slot < extra[j].slot })
+	writeExtra := func(slot int) error {
+		for len(extra) > 0 && extra[0].slot <= slot {
+			if err := extra[0].raw.MarshalXML(e, xml.StartElement{}); err != nil {
+				return err
+			}
+			extra = extra[1:]
+		}
+		return nil
+	}
+
+	if err = writeExtra(-1); err != nil {
+		return err
+	}
+
+	if p.RunProperty != nil {
+		if err = p.RunProperty.MarshalXML(e, xml.StartElement{}); err != nil {
+			return err
+		}
+	}
+	if err = writeExtra(0); err != nil {
+		return err
+	}
+
+	if p.Alias != nil {
+		if err = p.Alias.MarshalXML(e, xml.StartElement{Name: xml.Name{Local: "w:alias"}}); err != nil {
+			return err
+		}
+	}
+	if err = writeExtra(1); err != nil {
+		return err
+	}
+
+	if p.Tag != nil {
+		if err = p.Tag.MarshalXML(e, xml.StartElement{Name: xml.Name{Local: "w:tag"}}); err != nil {
+			return err
+		}
+	}
+	if err = writeExtra(2); err != nil {
+		return err
+	}
+
+	if p.ID != nil {
+		if err = p.ID.MarshalXML(e, xml.StartElement{Name: xml.Name{Local: "w:id"}}); err != nil {
+			return err
+		}
+	}
+	if err = writeExtra(3); err != nil {
+		return err
+	}
+
+	if p.Lock != nil {
+		if err = p.Lock.MarshalXML(e, xml.StartElement{Name: xml.Name{Local: "w:lock"}}); err != nil {
+			return err
+		}
+	}
+	if err = writeExtra(6); err != nil {
+		return err
+	}
+
+	if p.ShowingPlaceholder != nil {
+		if err = p.ShowingPlaceholder.MarshalXML(e, xml.StartElement{Name: xml.Name{Local: "w:showingPlcHdr"}}); err != nil {
+			return err
+		}
+	}
+	if err = writeExtra(7); err != nil {
+		return err
+	}
+
+	if p.DataBinding != nil {
+		if err = p.DataBinding.MarshalXML(e, xml.StartElement{}); err != nil {
+			return err
+		}
+	}
+	if err = writeExtra(10); err != nil {
+		return err
+	}
+
+	if p.ComboBox != nil {
+		if err = p.ComboBox.MarshalXML(e, xml.StartElement{Name: xml.Name{Local: "w:comboBox"}}); err != nil {
+			return err
+		}
+	}
+
+	if p.Date != nil {
+		if err = p.Date.MarshalXML(e, xml.StartElement{}); err != nil {
+			return err
+		}
+	}
+
+	if p.DropDownList != nil {
+		if err = p.DropDownList.MarshalXML(e, xml.StartElement{Name: xml.Name{Local: "w:dropDownList"}}); err != nil {
+			return err
+		}
+	}
+
+	if p.Picture != nil {
+		if err = p.Picture.MarshalXML(e, xml.StartElement{Name: xml.Name{Local: "w:picture"}}); err != nil {
+			return err
+		}
+	}
+
+	if p.Text != nil {
+		text := xml.StartElement{Name: xml.Name{Local: "w:text"}}
+		if p.Text.MultiLine != nil {
+			text.Attr = append(text.Attr, xml.Attr{Name: xml.Name{Local: "w:multiLine"}, Value: string(*p.Text.MultiLine)})
+		}
+		if err = e.EncodeElement("", text); err != nil {
+			return err
+		}
+	}
+	if err = writeExtra(11); err != nil {
+		return err
+	}
+
+	if p.Checkbox != nil {
+		if err = p.Checkbox.MarshalXML(e, xml.StartElement{}); err != nil {
+			return err
+		}
+	}
+	if err = writeExtra(12); err != nil {
+		return err
+	}
+
+	if p.RepeatingSection != nil {
+		if err = p.RepeatingSection.MarshalXML(e, xml.StartElement{}); err != nil {
+			return err
+		}
+	}
+	if err = writeExtra(13); err != nil {
+		return err
+	}
+
+	if p.RepeatingSectionItem != nil {
+		if err = p.RepeatingSectionItem.MarshalXML(e, xml.StartElement{Name: xml.Name{Local: "w15:repeatingSectionItem"}}); err != nil {
+			return err
+		}
+	}
+	if err = writeExtra(14); err != nil {
+		return err
+	}
+
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface for the SdtProperty type.
+func (p *SdtProperty) UnmarshalXML(d *xml.Decoder, start xml.StartElement) (err error) {
+	slot := -1
+
+loop:
+	for {
+		currentToken, err := d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch elem := currentToken.(type) {
+		case xml.StartElement:
+			var target interface{}
+			switch elem.Name.Local {
+			case "rPr":
+				p.RunProperty = &RunProperty{}
+				target = p.RunProperty
+			case "alias":
+				p.Alias = &CTString{}
+				target = p.Alias
+			case "tag":
+				p.Tag = &CTString{}
+				target = p.Tag
+			case "id":
+				p.ID = &DecimalNum{}
+				target = p.ID
+			case "lock":
+				p.Lock = &GenSingleStrVal[stypes.Lock]{}
+				target = p.Lock
+			case "showingPlcHdr":
+				p.ShowingPlaceholder = &OnOff{}
+				target = p.ShowingPlaceholder
+			case "dataBinding":
+				p.DataBinding = &SdtDataBinding{}
+				target = p.DataBinding
+			case "comboBox":
+				p.ComboBox = &SdtList{}
+				target = p.ComboBox
+			case "date":
+				p.Date = &SdtDate{}
+				target = p.Date
+			case "dropDownList":
+				p.DropDownList = &SdtList{}
+				target = p.DropDownList
+			case "picture":
+				p.Picture = &Empty{}
+				target = p.Picture
+			case "text":
+				p.Text = &SdtText{}
+				target = p.Text
+			case "checkbox":
+				p.Checkbox = &SdtCheckbox{}
+				target = p.Checkbox
+			case "repeatingSection":
+				p.RepeatingSection = &SdtRepeatingSection{}
+				target = p.RepeatingSection
+			case "repeatingSectionItem":
+				p.RepeatingSectionItem = &Empty{}
+				target = p.RepeatingSectionItem
+			default:
+				raw := &RawXML{}
+				if err = d.DecodeElement(raw, &elem); err != nil {
+					return err
+				}
+				if place, ok := sdtPropertySlots[elem.Name.Local]; ok {
+					slot = place
+				}
+				p.extra = append(p.extra, sdtExtra{slot: slot, raw: raw})
+				continue
+			}
+
+			if err = d.DecodeElement(target, &elem); err != nil {
+				return err
+			}
+			slot = sdtPropertySlots[elem.Name.Local]
+		case xml.EndElement:
+			break loop
+		}
+	}
+
+	return nil
+}
+
+// MarshalXML implements the xml.Marshaler interface for the SdtDataBinding type.
+func (b SdtDataBinding) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name.Local = "w:dataBinding"
+	start.Attr = nil
+	if b.PrefixMappings != "" {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "w:prefixMappings"}, Value: b.PrefixMappings})
+	}
+	start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "w:xpath"}, Value: b.XPath})
+	if b.StoreItemID != "" {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "w:storeItemID"}, Value: b.StoreItemID})
+	}
+	return e.EncodeElement("", start)
+}
+
+// MarshalXML implements the xml.Marshaler interface for the SdtList type. The element name is
+// taken from start, e.g. w:comboBox.
+func (l SdtList) MarshalXML(e *xml.Encoder, start xml.StartElement) (err error) {
+	start.Attr = nil
+	if l.LastValue != nil {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "w:lastValue"}, Value: *l.LastValue})
+	}
+
+	if err = e.EncodeToken(start); err != nil {
+		return err
+	}
+
+	for _, item := range l.Items {
+		elem := xml.StartElement{Name: xml.Name{Local: "w:listItem"}}
+		if item.DisplayText != "" {
+			elem.Attr = append(elem.Attr, xml.Attr{Name: xml.Name{Local: "w:displayText"}, Value: item.DisplayText})
+		}
+		elem.Attr = append(elem.Attr, xml.Attr{Name: xml.Name{Local: "w:value"}, Value: item.Value})
+		if err = e.EncodeElement("", elem); err != nil {
+			return err
+		}
+	}
+
+	return e.EncodeToken(start.End())
+}
+
+// MarshalXML implements the xml.Marshaler interface for the SdtDate type.
+func (d SdtDate) MarshalXML(e *xml.Encoder, start xml.StartElement) (err error) {
+	start.Name.Local = "w:date"
+	start.Attr = nil
+	if d.FullDate != nil {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "w:fullDate"}, Value: *d.FullDate})
+	}
+
+	if err = e.EncodeToken(start); err != nil {
+		return err
+	}
+
+	for _, elem := range []struct {
+		val  *CTString
+		name string
+	}{
+		{d.Format, "w:dateFormat"},
+		{d.Lid, "w:lid"},
+		{d.StoreMappedDataAs, "w:storeMappedDataAs"},
+		{d.Calendar, "w:calendar"},
+	} {
+		if elem.val == nil {
+			continue
+		}
+		if err = elem.val.MarshalXML(e, xml.StartElement{Name: xml.Name{Local: elem.name}}); err != nil {
+			return err
+		}
+	}
+
+	return e.EncodeToken(start.End())
+}
+
+// MarshalXML implements the xml.Marshaler interface for the SdtCheckbox type.
+func (c SdtCheckbox) MarshalXML(e *xml.Encoder, start xml.StartElement) (err error) {
+	start.Name.Local = "w14:checkbox"
+	start.Attr = nil
+
+	if err = e.EncodeToken(start); err != nil {
+		return err
+	}
+
+	checked := "0"
+	if c.Checked {
+		checked = "1"
+	}
+	if err = e.EncodeElement("", xml.StartElement{
+		Name: xml.Name{Local: "w14:checked"},
+		Attr: []xml.Attr{{Name: xml.Name{Local: "w14:val"}, Value: checked}},
+	}); err != nil {
+		return err
+	}
+
+	for _, state := range []struct {
+		val  *SdtCheckboxState
+		name string
+	}{
+		{c.CheckedState, "w14:checkedState"},
+		{c.UncheckedState, "w14:uncheckedState"},
+	} {
+		if state.val == nil {
+			continue
+		}
+		if err = e.EncodeElement("", xml.StartElement{
+			Name: xml.Name{Local: state.name},
+			Attr: []xml.Attr{
+				{Name: xml.Name{Local: "w14:val"}, Value: state.val.Val},
+				{Name: xml.Name{Local: "w14:font"}, Value: state.val.Font},
+			},
+		}); err != nil {
+			return err
+		}
+	}
+
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface for the SdtCheckbox type.
+func (c *SdtCheckbox) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var aux struct {
+		Checked *struct {
+			Val string `xml:"val,attr"`
+		} `xml:"checked"`
+		CheckedState   *SdtCheckboxState `xml:"checkedState"`
+		UncheckedState *SdtCheckboxState `xml:"uncheckedState"`
+	}
+	if err := d.DecodeElement(&aux, &start); err != nil {
+		return err
+	}
+
+	// The value defaults to true when the attribute is omitted, as for on/off properties
+	c.Checked = aux.Checked != nil && (aux.Checked.Val == "" || aux.Checked.Val == "1" || aux.Checked.Val == "true")
+	c.CheckedState = aux.CheckedState
+	c.UncheckedState = aux.UncheckedState
+	return nil
+}
+
+// MarshalXML implements the xml.Marshaler interface for the SdtRepeatingSection type.
+func (r SdtRepeatingSection) MarshalXML(e *xml.Encoder, start xml.StartElement) (err error) {
+	start.Name.Local = "w15:repeatingSection"
+	start.Attr = nil
+
+	if err = e.EncodeToken(start); err != nil {
+		return err
+	}
+
+	if r.SectionTitle != nil {
+		if err = e.EncodeElement("", xml.StartElement{
+			Name: xml.Name{Local: "w15:sectionTitle"},
+			Attr: []xml.Attr{{Name: xml.Name{Local: "w15:val"}, Value: r.SectionTitle.Val}},
+		}); err != nil {
+			return err
+		}
+	}
+
+	if r.DoNotAllowInsertDeleteSection != nil {
+		elem := xml.StartElement{Name: xml.Name{Local: "w15:doNotAllowInsertDeleteSection"}}
+		if r.DoNotAllowInsertDeleteSection.Val != nil {
+			elem.Attr = []xml.Attr{{Name: xml.Name{Local: "w15:val"}, Value: string(*r.DoNotAllowInsertDeleteSection.Val)}}
+		}
+		if err = e.EncodeElement("", elem); err != nil {
+			return err
+		}
+	}
+
+	return e.EncodeToken(start.End())
+}
+
+// MarshalXML implements the xml.Marshaler interface for the SdtRow type.
+func (s SdtRow) MarshalXML(e *xml.Encoder, start xml.StartElement) (err error) {
+	start.Name.Local = "w:sdt"
+	start.Attr = nil
+
+	if err = e.EncodeToken(start); err != nil {
+		return err
+	}
+
+	if s.Property != nil {
+		if err = s.Property.MarshalXML(e, xml.StartElement{}); err != nil {
+			return err
+		}
+	}
+
+	if s.EndProperty != nil {
+		if err = s.EndProperty.MarshalXML(e, xml.StartElement{}); err != nil {
+			return err
+		}
+	}
+
+	content := xml.StartElement{Name: xml.Name{Local: "w:sdtContent"}}
+	if err = e.EncodeToken(content); err != nil {
+		return err
+	}
+	for _, row := range s.Rows {
+		if err = row.MarshalXML(e, xml.StartElement{}); err != nil {
+			return err
+		}
+	}
+	if err = e.EncodeToken(content.End()); err != nil {
+		return err
+	}
+
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface for the SdtRow type.
+func (s *SdtRow) UnmarshalXML(d *xml.Decoder, start xml.StartElement) (err error) {
+	for {
+		currentToken, err := d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch elem := currentToken.(type) {
+		case xml.StartElement:
+			switch elem.Name.Local {
+			case "sdtPr":
+				s.Property = &SdtProperty{}
+				if err = d.DecodeElement(s.Property, &elem); err != nil {
+					return err
+				}
+			case "sdtEndPr":
+				s.EndProperty = &RawXML{}
+				if err = d.DecodeElement(s.EndProperty, &elem); err != nil {
+					return err
+				}
+			case "sdtContent":
+				rows := Table{}
+				if err = rows.UnmarshalXML(d, elem); err != nil {
+					return err
+				}
+				s.Rows = rows.RowContents
+			default:
+				if err = skipElement(d, elem, "sdt"); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
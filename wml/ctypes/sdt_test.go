@@ -0,0 +1,276 @@
+package ctypes
+
+import (
+	"encoding/xml"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/MamaShip/godocx/internal"
+	"github.com/MamaShip/godocx/wml/stypes"
+)
+
+func TestSdtRun_MarshalXML(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    SdtRun
+		expected string
+	}{
+		{
+			name: "Plain text control",
+			input: SdtRun{
+				Property: &SdtProperty{
+					Alias:              NewCTString("Customer name"),
+					Tag:                NewCTString("customer"),
+					ID:                 NewDecimalNum(12),
+					ShowingPlaceholder: &OnOff{},
+					Text:               &SdtText{},
+				},
+				Content: []ParagraphChild{{Run: &Run{Children: []RunChild{{Text: TextFromString("Name")}}}}},
+			},
+			expected: `<w:sdt><w:sdtPr><w:alias w:val="Customer name"></w:alias><w:tag w:val="customer"></w:tag><w:id w:val="12"></w:id>` +
+				`<w:showingPlcHdr></w:showingPlcHdr><w:text></w:text></w:sdtPr>` +
+				`<w:sdtContent><w:r><w:t>Name</w:t></w:r></w:sdtContent></w:sdt>`,
+		},
+		{
+			name: "Multi-line text",
+			input: SdtRun{
+				Property: &SdtProperty{
+					Tag:  NewCTString("address"),
+					Text: &SdtText{MultiLine: internal.ToPtr(stypes.OnOffTrue)},
+				},
+			},
+			expected: `<w:sdt><w:sdtPr><w:tag w:val="address"></w:tag><w:text w:multiLine="true"></w:text></w:sdtPr><w:sdtContent></w:sdtContent></w:sdt>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var result strings.Builder
+			encoder := xml.NewEncoder(&result)
+
+			err := tt.input.MarshalXML(encoder, xml.StartElement{})
+			if err != nil {
+				t.Fatalf("Error marshaling XML: %v", err)
+			}
+
+			encoder.Flush()
+
+			if result.String() != tt.expected {
+				t.Errorf("Expected XML:\n%s\nGot:\n%s", tt.expected, result.String())
+			}
+		})
+	}
+}
+
+func TestSdtRun_UnmarshalXML(t *testing.T) {
+	inputXML := `<w:p xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main">` +
+		`<w:r><w:t>Dear </w:t></w:r>` +
+		`<w:sdt><w:sdtPr><w:rPr><w:b/></w:rPr><w:alias w:val="Name"/><w:tag w:val="name"/><w:id w:val="-1450"/><w:lock w:val="sdtLocked"/><w:text/></w:sdtPr>` +
+		`<w:sdtEndPr/><w:sdtContent><w:r><w:t>Jane</w:t></w:r><w:bookmarkStart w:id="0" w:name="n"/><w:r><w:t> Doe</w:t></w:r><w:bookmarkEnd w:id="0"/></w:sdtContent></w:sdt>` +
+		`</w:p>`
+
+	var p Paragraph
+	if err := xml.Unmarshal([]byte(inputXML), &p); err != nil {
+		t.Fatalf("Error unmarshaling XML: %v", err)
+	}
+
+	if len(p.Children) != 2 || p.Children[1].Sdt == nil {
+		t.Fatalf("Expected content control as second paragraph child, got %+v", p.Children)
+	}
+
+	sdt := p.Children[1].Sdt
+	if sdt.Property == nil || sdt.Property.Tag == nil || sdt.Property.Tag.Val != "name" {
+		t.Fatalf("Expected tag name, got %+v", sdt.Property)
+	}
+	if sdt.Property.Alias == nil || sdt.Property.Alias.Val != "Name" {
+		t.Errorf("Expected alias Name, got %+v", sdt.Property.Alias)
+	}
+	if sdt.Property.ID == nil || sdt.Property.ID.Val != -1450 {
+		t.Errorf("Expected ID -1450, got %+v", sdt.Property.ID)
+	}
+	if sdt.Property.RunProperty == nil || sdt.Property.RunProperty.Bold == nil {
+		t.Errorf("Expected bold run properties, got %+v", sdt.Property.RunProperty)
+	}
+	if sdt.Property.Text == nil {
+		t.Errorf("Expected plain text control")
+	}
+	if len(sdt.Content) != 4 || sdt.Content[2].Run == nil || sdt.Content[1].BookmarkStart == nil {
+		t.Errorf("Expected runs and bookmark in the content, got %+v", sdt.Content)
+	}
+
+	// Marshal again and make sure the content control is preserved
+	var result strings.Builder
+	encoder := xml.NewEncoder(&result)
+	if err := p.MarshalXML(encoder, xml.StartElement{}); err != nil {
+		t.Fatalf("Error marshaling XML: %v", err)
+	}
+	encoder.Flush()
+
+	var again Paragraph
+	if err := xml.Unmarshal([]byte(result.String()), &again); err != nil {
+		t.Fatalf("Error unmarshaling round-tripped XML: %v", err)
+	}
+	if !reflect.DeepEqual(p.Children[1].Sdt, again.Children[1].Sdt) {
+		t.Errorf("Content control changed after round trip:\n%s", result.String())
+	}
+}
+
+func TestSdtProperty_Types(t *testing.T) {
+	prop := SdtProperty{
+		ID:   NewDecimalNum(3),
+		Lock: NewGenSingleStrVal(stypes.LockSdtContentLocked),
+		DropDownList: &SdtList{
+			LastValue: internal.ToPtr("b"),
+			Items:     []SdtListItem{{DisplayText: "Choose an item.", Value: ""}, {DisplayText: "Beta", Value: "b"}},
+		},
+		Date: &SdtDate{
+			FullDate: internal.ToPtr("2024-03-01T00:00:00Z"),
+			Format:   NewCTString("dd/MM/yyyy"),
+			Lid:      NewCTString("en-GB"),
+			Calendar: NewCTString("gregorian"),
+		},
+		Picture: &Empty{},
+		Checkbox: &SdtCheckbox{
+			Checked:        true,
+			CheckedState:   &SdtCheckboxState{Val: "2612", Font: "MS Gothic"},
+			UncheckedState: &SdtCheckboxState{Val: "2610", Font: "MS Gothic"},
+		},
+	}
+
+	output, err := xml.Marshal(prop)
+	if err != nil {
+		t.Fatalf("Error marshaling XML: %v", err)
+	}
+	expected := `<w:sdtPr><w:id w:val="3"></w:id><w:lock w:val="sdtContentLocked"></w:lock>` +
+		`<w:date w:fullDate="2024-03-01T00:00:00Z"><w:dateFormat w:val="dd/MM/yyyy"></w:dateFormat><w:lid w:val="en-GB"></w:lid><w:calendar w:val="gregorian"></w:calendar></w:date>` +
+		`<w:dropDownList w:lastValue="b"><w:listItem w:displayText="Choose an item." w:value=""></w:listItem><w:listItem w:displayText="Beta" w:value="b"></w:listItem></w:dropDownList>` +
+		`<w:picture></w:picture>` +
+		`<w14:checkbox><w14:checked w14:val="1"></w14:checked><w14:checkedState w14:val="2612" w14:font="MS Gothic"></w14:checkedState>` +
+		`<w14:uncheckedState w14:val="2610" w14:font="MS Gothic"></w14:uncheckedState></w14:checkbox></w:sdtPr>`
+	if string(output) != expected {
+		t.Errorf("Expected XML:\n%s\nGot:\n%s", expected, output)
+	}
+
+	var loaded SdtProperty
+	if err := xml.Unmarshal(output, &loaded); err != nil {
+		t.Fatalf("Error unmarshaling XML: %v", err)
+	}
+	if !reflect.DeepEqual(prop, loaded) {
+		t.Errorf("Properties changed after round trip:\nExpected: %+v\nGot: %+v", prop, loaded)
+	}
+
+	var combo SdtProperty
+	input := `<w:sdtPr><w:comboBox><w:listItem w:value="x"/></w:comboBox><w14:checkbox><w14:checked w14:val="0"/></w14:checkbox></w:sdtPr>`
+	if err := xml.Unmarshal([]byte(input), &combo); err != nil {
+		t.Fatalf("Error unmarshaling XML: %v", err)
+	}
+	if combo.ComboBox == nil || len(combo.ComboBox.Items) != 1 || combo.ComboBox.Items[0].Value != "x" {
+		t.Errorf("Expected a combo box with one item, got %+v", combo.ComboBox)
+	}
+	if combo.Checkbox == nil || combo.Checkbox.Checked {
+		t.Errorf("Expected an unchecked box, got %+v", combo.Checkbox)
+	}
+}
+
+func TestSdtProperty_DataBinding(t *testing.T) {
+	prop := SdtProperty{
+		ShowingPlaceholder: &OnOff{},
+		DataBinding: &SdtDataBinding{
+			PrefixMappings: "xmlns:ns0='urn:orders'",
+			XPath:          "/ns0:order[1]/ns0:customer[1]",
+			StoreItemID:    "{0B3E4F6A-1C2D-4E5F-8A9B-0C1D2E3F4A5B}",
+		},
+		Text: &SdtText{},
+	}
+
+	output, err := xml.Marshal(prop)
+	if err != nil {
+		t.Fatalf("Error marshaling XML: %v", err)
+	}
+	expected := `<w:sdtPr><w:showingPlcHdr></w:showingPlcHdr>` +
+		`<w:dataBinding w:prefixMappings="xmlns:ns0=&#39;urn:orders&#39;" w:xpath="/ns0:order[1]/ns0:customer[1]" w:storeItemID="{0B3E4F6A-1C2D-4E5F-8A9B-0C1D2E3F4A5B}"></w:dataBinding>` +
+		`<w:text></w:text></w:sdtPr>`
+	if string(output) != expected {
+		t.Errorf("Expected XML:\n%s\nGot:\n%s", expected, output)
+	}
+
+	var loaded SdtProperty
+	if err := xml.Unmarshal(output, &loaded); err != nil {
+		t.Fatalf("Error unmarshaling XML: %v", err)
+	}
+	if !reflect.DeepEqual(prop, loaded) {
+		t.Errorf("Properties changed after round trip:\nExpected: %+v\nGot: %+v", prop, loaded)
+	}
+}
+
+func TestSdtRow_RoundTrip(t *testing.T) {
+	inputXML := `<w:tbl xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main" xmlns:w15="http://schemas.microsoft.com/office/word/2012/wordml">` +
+		`<w:tr><w:tc><w:p><w:r><w:t>Header</w:t></w:r></w:p></w:tc></w:tr>` +
+		`<w:sdt><w:sdtPr><w:tag w:val="lines"/><w15:repeatingSection><w15:sectionTitle w15:val="Line"/><w15:doNotAllowInsertDeleteSection/></w15:repeatingSection></w:sdtPr><w:sdtContent>` +
+		`<w:sdt><w:sdtPr><w15:repeatingSectionItem/></w:sdtPr><w:sdtContent><w:tr><w:tc><w:p><w:r><w:t>Item</w:t></w:r></w:p></w:tc></w:tr></w:sdtContent></w:sdt>` +
+		`</w:sdtContent></w:sdt></w:tbl>`
+
+	var tbl Table
+	if err := xml.Unmarshal([]byte(inputXML), &tbl); err != nil {
+		t.Fatalf("Error unmarshaling XML: %v", err)
+	}
+
+	if len(tbl.RowContents) != 2 || tbl.RowContents[0].Row == nil || tbl.RowContents[1].Sdt == nil {
+		t.Fatalf("Expected a row and a content control of rows, got %+v", tbl.RowContents)
+	}
+	section := tbl.RowContents[1].Sdt
+	if section.Property == nil || section.Property.RepeatingSection == nil || section.Property.RepeatingSection.SectionTitle == nil ||
+		section.Property.RepeatingSection.SectionTitle.Val != "Line" || section.Property.RepeatingSection.DoNotAllowInsertDeleteSection == nil {
+		t.Fatalf("Expected a repeating section titled Line, got %+v", section.Property)
+	}
+	if len(section.Rows) != 1 || section.Rows[0].Sdt == nil || section.Rows[0].Sdt.Property.RepeatingSectionItem == nil {
+		t.Fatalf("Expected a repeating section item, got %+v", section.Rows)
+	}
+	if item := section.Rows[0].Sdt; len(item.Rows) != 1 || item.Rows[0].Row == nil {
+		t.Errorf("Expected the item to hold a row, got %+v", item.Rows)
+	}
+
+	output, err := xml.Marshal(section)
+	if err != nil {
+		t.Fatalf("Error marshaling XML: %v", err)
+	}
+	expected := `<w:sdt><w:sdtPr><w:tag w:val="lines"></w:tag><w15:repeatingSection><w15:sectionTitle w15:val="Line"></w15:sectionTitle>` +
+		`<w15:doNotAllowInsertDeleteSection></w15:doNotAllowInsertDeleteSection></w15:repeatingSection></w:sdtPr><w:sdtContent>` +
+		`<w:sdt><w:sdtPr><w15:repeatingSectionItem></w15:repeatingSectionItem></w:sdtPr><w:sdtContent><w:tr>`
+	if !strings.HasPrefix(string(output), expected) {
+		t.Errorf("Expected XML to start with:\n%s\nGot:\n%s", expected, output)
+	}
+}
+
+func TestSdtRun_RoundTripUnmodeled(t *testing.T) {
+	inputXML := `<w:p xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main" xmlns:w15="http://schemas.microsoft.com/office/word/2012/wordml">` +
+		`<w:sdt><w:sdtPr><w:alias w:val="Customer"/><w:tag w:val="customer"/><w:id w:val="12"/>` +
+		`<w:placeholder><w:docPart w:val="DefaultPlaceholder_-1854013440"/></w:placeholder><w:temporary/><w:showingPlcHdr/>` +
+		`<w15:appearance w15:val="hidden"/><w:text/></w:sdtPr><w:sdtEndPr><w:rPr><w:b/></w:rPr></w:sdtEndPr>` +
+		`<w:sdtContent><w:r><w:t>Click here</w:t></w:r></w:sdtContent></w:sdt></w:p>`
+
+	var p Paragraph
+	if err := xml.Unmarshal([]byte(inputXML), &p); err != nil {
+		t.Fatalf("Error unmarshaling XML: %v", err)
+	}
+	if len(p.Children) != 1 || p.Children[0].Sdt == nil {
+		t.Fatalf("Expected a content control, got %+v", p.Children)
+	}
+
+	sdt := p.Children[0].Sdt
+	sdt.Property.Lock = &GenSingleStrVal[stypes.Lock]{Val: stypes.LockSdtLocked}
+	output, err := xml.Marshal(sdt)
+	if err != nil {
+		t.Fatalf("Error marshaling XML: %v", err)
+	}
+
+	// The unmodeled properties keep their place in the schema order around the lock
+	expected := `<w:sdt><w:sdtPr><w:alias w:val="Customer"></w:alias><w:tag w:val="customer"></w:tag><w:id w:val="12"></w:id><w:lock w:val="sdtLocked"></w:lock>` +
+		`<w:placeholder><w:docPart w:val="DefaultPlaceholder_-1854013440"></w:docPart></w:placeholder><w:temporary></w:temporary><w:showingPlcHdr></w:showingPlcHdr>` +
+		`<w15:appearance w15:val="hidden"></w15:appearance><w:text></w:text></w:sdtPr><w:sdtEndPr><w:rPr><w:b></w:b></w:rPr></w:sdtEndPr>` +
+		`<w:sdtContent><w:r><w:t>Click here</w:t></w:r></w:sdtContent></w:sdt>`
+	if string(output) != expected {
+		t.Errorf("Expected XML:\n%s\nGot:\n%s", expected, output)
+	}
+}
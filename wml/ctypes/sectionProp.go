@@ -8,15 +8,17 @@ import (
 
 // Document Final Section Properties : w:sectPr
 type SectionProp struct {
-	HeaderReference *HeaderReference                       `xml:"headerReference,omitempty"`
-	FooterReference *FooterReference                       `xml:"footerReference,omitempty"`
+	HeaderReference []HeaderReference                      `xml:"headerReference,omitempty"`
+	FooterReference []FooterReference                      `xml:"footerReference,omitempty"`
 	PageSize        *PageSize                              `xml:"pgSz,omitempty"`
 	Type            *GenSingleStrVal[stypes.SectionMark]   `xml:"type,omitempty"`
 	PageMargin      *PageMargin                            `xml:"pgMar,omitempty"`
 	PageNum         *PageNumbering                         `xml:"pgNumType,omitempty"`
+	Columns         *Columns                               `xml:"cols,omitempty"`
 	FormProt        *GenSingleStrVal[stypes.OnOff]         `xml:"formProt,omitempty"`
 	TitlePg         *GenSingleStrVal[stypes.OnOff]         `xml:"titlePg,omitempty"`
 	TextDir         *GenSingleStrVal[stypes.TextDirection] `xml:"textDirection,omitempty"`
+	Bidi            *OnOff                                 `xml:"bidi,omitempty"`
 	DocGrid         *DocGrid                               `xml:"docGrid,omitempty"`
 }
 
@@ -32,14 +34,14 @@ func (s SectionProp) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 		return err
 	}
 
-	if s.HeaderReference != nil {
-		if err := s.HeaderReference.MarshalXML(e, xml.StartElement{}); err != nil {
+	for _, ref := range s.HeaderReference {
+		if err := ref.MarshalXML(e, xml.StartElement{}); err != nil {
 			return err
 		}
 	}
 
-	if s.FooterReference != nil {
-		if err := s.FooterReference.MarshalXML(e, xml.StartElement{}); err != nil {
+	for _, ref := range s.FooterReference {
+		if err := ref.MarshalXML(e, xml.StartElement{}); err != nil {
 			return err
 		}
 	}
@@ -70,6 +72,12 @@ func (s SectionProp) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 		}
 	}
 
+	if s.Columns != nil {
+		if err = s.Columns.MarshalXML(e, xml.StartElement{}); err != nil {
+			return err
+		}
+	}
+
 	if s.FormProt != nil {
 		if err = s.FormProt.MarshalXML(e, xml.StartElement{
 			Name: xml.Name{Local: "w:formProt"},
@@ -94,6 +102,14 @@ func (s SectionProp) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 		}
 	}
 
+	if s.Bidi != nil {
+		if err = s.Bidi.MarshalXML(e, xml.StartElement{
+			Name: xml.Name{Local: "w:bidi"},
+		}); err != nil {
+			return err
+		}
+	}
+
 	if s.DocGrid != nil {
 		if s.DocGrid.MarshalXML(e, xml.StartElement{}); err != nil {
 			return err
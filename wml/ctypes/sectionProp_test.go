@@ -18,8 +18,8 @@ func TestSectionProp_MarshalXML(t *testing.T) {
 		{
 			name: "All attributes",
 			input: SectionProp{
-				HeaderReference: &HeaderReference{Type: "default", ID: "rId1"},
-				FooterReference: &FooterReference{Type: "default", ID: "rId2"},
+				HeaderReference: []HeaderReference{{Type: "default", ID: "rId1"}},
+				FooterReference: []FooterReference{{Type: "default", ID: "rId2"}},
 				PageSize: &PageSize{
 					Width:  uint64Ptr(12240),
 					Height: uint64Ptr(15840),
@@ -34,6 +34,24 @@ func TestSectionProp_MarshalXML(t *testing.T) {
 			},
 			expected: `<w:sectPr><w:headerReference w:type="default" r:id="rId1"></w:headerReference><w:footerReference w:type="default" r:id="rId2"></w:footerReference><w:type w:val="nextPage"></w:type><w:pgSz w:w="12240" w:h="15840"></w:pgSz><w:pgMar w:left="1440" w:right="1440" w:top="1440" w:bottom="1440"></w:pgMar><w:pgNumType w:fmt="decimal"></w:pgNumType><w:formProt w:val="true"></w:formProt><w:titlePg w:val="true"></w:titlePg><w:textDirection w:val="lrTb"></w:textDirection><w:docGrid w:type="default" w:linePitch="360"></w:docGrid></w:sectPr>`,
 		},
+		{
+			name: "Multiple references",
+			input: SectionProp{
+				HeaderReference: []HeaderReference{{Type: "default", ID: "rId1"}, {Type: "first", ID: "rId3"}},
+				FooterReference: []FooterReference{{Type: "default", ID: "rId2"}},
+				TitlePg:         NewGenSingleStrVal(stypes.OnOffTrue),
+			},
+			expected: `<w:sectPr><w:headerReference w:type="default" r:id="rId1"></w:headerReference><w:headerReference w:type="first" r:id="rId3"></w:headerReference><w:footerReference w:type="default" r:id="rId2"></w:footerReference><w:titlePg w:val="true"></w:titlePg></w:sectPr>`,
+		},
+		{
+			name: "Right to left",
+			input: SectionProp{
+				TextDir: NewGenSingleStrVal(stypes.TextDirectionLrTb),
+				Bidi:    &OnOff{},
+				DocGrid: &DocGrid{Type: "default"},
+			},
+			expected: `<w:sectPr><w:textDirection w:val="lrTb"></w:textDirection><w:bidi></w:bidi><w:docGrid w:type="default"></w:docGrid></w:sectPr>`,
+		},
 		{
 			name:     "No attributes",
 			input:    SectionProp{},
@@ -109,8 +127,8 @@ func TestSectionProp_UnmarshalXML(t *testing.T) {
 				<w:docGrid w:type="default" w:linePitch="360"></w:docGrid>
 			</w:sectPr>`,
 			expected: SectionProp{
-				HeaderReference: &HeaderReference{Type: "default", ID: "rId1"},
-				FooterReference: &FooterReference{Type: "default", ID: "rId2"},
+				HeaderReference: []HeaderReference{{Type: "default", ID: "rId1"}},
+				FooterReference: []FooterReference{{Type: "default", ID: "rId2"}},
 				PageSize: &PageSize{
 					Width:  uint64Ptr(12240),
 					Height: uint64Ptr(15840),
@@ -124,6 +142,18 @@ func TestSectionProp_UnmarshalXML(t *testing.T) {
 				DocGrid:    &DocGrid{Type: "default", LinePitch: intPtr(360)},
 			},
 		},
+		{
+			name: "Multiple references",
+			inputXML: `<w:sectPr>
+				<w:headerReference w:type="default" r:id="rId1"></w:headerReference>
+				<w:headerReference w:type="first" r:id="rId3"></w:headerReference>
+				<w:footerReference w:type="default" r:id="rId2"></w:footerReference>
+			</w:sectPr>`,
+			expected: SectionProp{
+				HeaderReference: []HeaderReference{{Type: "default", ID: "rId1"}, {Type: "first", ID: "rId3"}},
+				FooterReference: []FooterReference{{Type: "default", ID: "rId2"}},
+			},
+		},
 		{
 			name:     "No attributes",
 			inputXML: `<w:sectPr></w:sectPr>`,
@@ -0,0 +1,51 @@
+package ctypes
+
+import (
+	"encoding/xml"
+	"sync"
+)
+
+// SkipFunc receives the name of an element that is not modeled and was skipped while
+// decoding, and the name of its parent element, e.g. "customXml" and "p".
+type SkipFunc func(element, parent string)
+
+// skipFuncs holds the SkipFunc registered per decoder.
+var skipFuncs sync.Map
+
+// NotifySkipped registers fn to be called for each element skipped by the decoders of the
+// package while reading from d, until the returned function is called.
+//
+// Example:
+//
+//	d := xml.NewDecoder(bytes.NewReader(content))
+//	release := ctypes.NotifySkipped(d, func(element, parent string) {
+//		log.Printf("skipped %s in %s", element, parent)
+//	})
+//	defer release()
+//	err := d.Decode(&p)
+func NotifySkipped(d *xml.Decoder, fn SkipFunc) (release func()) {
+	skipFuncs.Store(d, fn)
+	return func() {
+		skipFuncs.Delete(d)
+	}
+}
+
+// skipElement skips the element that has just been read, reporting it to the SkipFunc
+// registered for the decoder, if any.
+func skipElement(d *xml.Decoder, elem xml.StartElement, parent string) error {
+	notifySkipped(d, elem.Name, parent)
+	return d.Skip()
+}
+
+// notifySkipped reports the element to the SkipFunc registered for the decoder, if any.
+func notifySkipped(d *xml.Decoder, name xml.Name, parent string) {
+	if fn, ok := skipFuncs.Load(d); ok {
+		fn.(SkipFunc)(name.Local, parent)
+	}
+}
+
+// unknownElement is an element decoded with the ",any" option of the decoders that rely on
+// struct tags, to report the elements they do not model.
+type unknownElement struct {
+	XMLName xml.Name
+}
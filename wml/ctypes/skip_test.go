@@ -0,0 +1,44 @@
+package ctypes
+
+import (
+	"encoding/xml"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNotifySkipped(t *testing.T) {
+	inputXML := `<w:p xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main" xmlns:w14="http://schemas.microsoft.com/office/word/2010/wordml" xmlns:w15="http://schemas.microsoft.com/office/word/2012/wordml">` +
+		`<w:pPr><w15:collapsed/><w:rPr><w14:ligatures w14:val="standard"/></w:rPr></w:pPr>` +
+		`<w:proofErr w:type="spellStart"/><w:r><w:lastRenderedPageBreak/><w:t>Text</w:t></w:r><w:proofErr w:type="spellEnd"/>` +
+		`<w:hyperlink w:anchor="top"><w:customXml><w:r><w:t>Top</w:t></w:r></w:customXml></w:hyperlink></w:p>`
+
+	var skipped []string
+	d := xml.NewDecoder(strings.NewReader(inputXML))
+	release := NotifySkipped(d, func(element, parent string) {
+		skipped = append(skipped, element+" in "+parent)
+	})
+
+	var p Paragraph
+	if err := d.Decode(&p); err != nil {
+		t.Fatalf("Error unmarshaling XML: %v", err)
+	}
+	release()
+
+	expected := []string{"ligatures in rPr", "collapsed in pPr", "proofErr in p", "lastRenderedPageBreak in r", "proofErr in p", "customXml in hyperlink"}
+	if !reflect.DeepEqual(skipped, expected) {
+		t.Errorf("Expected skipped elements %v, got %v", expected, skipped)
+	}
+	if p.Property == nil || p.Property.RunProperty == nil || len(p.Children) != 2 {
+		t.Errorf("Expected the modeled content to be decoded, got %+v", p)
+	}
+
+	// Once released, skipped elements are not reported anymore
+	skipped = nil
+	if err := xml.Unmarshal([]byte(inputXML), &p); err != nil {
+		t.Fatalf("Error unmarshaling XML: %v", err)
+	}
+	if len(skipped) != 0 {
+		t.Errorf("Expected no reported elements, got %v", skipped)
+	}
+}
@@ -65,3 +65,34 @@ func TestSpacing_MarshalXML(t *testing.T) {
 		})
 	}
 }
+
+func TestSpacing_UnmarshalXML(t *testing.T) {
+	input := `<w:spacing xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main" w:before="120" w:after="240" w:line="360" w:lineRule="auto"></w:spacing>`
+
+	var spacing Spacing
+	if err := xml.Unmarshal([]byte(input), &spacing); err != nil {
+		t.Fatalf("Error unmarshaling XML: %v", err)
+	}
+
+	if spacing.Line == nil || *spacing.Line != 360 {
+		t.Errorf("Expected line 360, got %v", spacing.Line)
+	}
+	if spacing.LineRule == nil || *spacing.LineRule != stypes.LineSpacingRuleAuto {
+		t.Errorf("Expected line rule auto, got %v", spacing.LineRule)
+	}
+	if spacing.Before == nil || *spacing.Before != 120 || spacing.After == nil || *spacing.After != 240 {
+		t.Errorf("Expected spacing before 120 and after 240, got %v and %v", spacing.Before, spacing.After)
+	}
+
+	// The line spacing is kept when the paragraph spacing is written back
+	var result strings.Builder
+	encoder := xml.NewEncoder(&result)
+	if err := spacing.MarshalXML(encoder, xml.StartElement{}); err != nil {
+		t.Fatalf("Error marshaling XML: %v", err)
+	}
+	encoder.Flush()
+	expected := `<w:spacing w:before="120" w:after="240" w:line="360" w:lineRule="auto"></w:spacing>`
+	if result.String() != expected {
+		t.Errorf("Expected XML:\n%s\nGot:\n%s", expected, result.String())
+	}
+}
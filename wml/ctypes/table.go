@@ -96,9 +96,18 @@ loop:
 				t.RowContents = append(t.RowContents, RowContent{
 					Row: &row,
 				})
+			case "sdt":
+				sdt := SdtRow{}
+				if err = d.DecodeElement(&sdt, &elem); err != nil {
+					return err
+				}
+
+				t.RowContents = append(t.RowContents, RowContent{
+					Sdt: &sdt,
+				})
 
 			default:
-				if err = d.Skip(); err != nil {
+				if err = skipElement(d, elem, "tbl"); err != nil {
 					return err
 				}
 			}
@@ -0,0 +1,79 @@
+package ctypes
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/MamaShip/godocx/wml/stypes"
+)
+
+// Bits of the legacy w:val bitmask of w:tblLook, matching its on/off attributes.
+const (
+	tblLookFirstRow    = 0x0020
+	tblLookLastRow     = 0x0040
+	tblLookFirstColumn = 0x0080
+	tblLookLastColumn  = 0x0100
+	tblLookNoHBand     = 0x0200
+	tblLookNoVBand     = 0x0400
+)
+
+// TableLook represents the parts of the table style applied to a table (w:tblLook):
+// the conditional formatting of the first and last rows and columns, and the banding.
+type TableLook struct {
+	Val         string       `xml:"val,attr,omitempty"`         // Legacy hexadecimal bitmask of the other attributes
+	FirstRow    stypes.OnOff `xml:"firstRow,attr,omitempty"`    // Header row formatting
+	LastRow     stypes.OnOff `xml:"lastRow,attr,omitempty"`     // Total row formatting
+	FirstColumn stypes.OnOff `xml:"firstColumn,attr,omitempty"` // First column formatting
+	LastColumn  stypes.OnOff `xml:"lastColumn,attr,omitempty"`  // Last column formatting
+	NoHBand     stypes.OnOff `xml:"noHBand,attr,omitempty"`     // No banded rows formatting
+	NoVBand     stypes.OnOff `xml:"noVBand,attr,omitempty"`     // No banded columns formatting
+}
+
+// NewTableLook returns the table look with the given parts of the table style, along with
+// the legacy bitmask read by older versions of Word.
+func NewTableLook(firstRow, lastRow, firstCol, lastCol, noHBand, noVBand bool) *TableLook {
+	var mask int
+	onOff := func(value bool, bit int) stypes.OnOff {
+		if value {
+			mask |= bit
+			return stypes.OnOffOne
+		}
+		return stypes.OnOffZero
+	}
+
+	look := &TableLook{
+		FirstRow:    onOff(firstRow, tblLookFirstRow),
+		LastRow:     onOff(lastRow, tblLookLastRow),
+		FirstColumn: onOff(firstCol, tblLookFirstColumn),
+		LastColumn:  onOff(lastCol, tblLookLastColumn),
+		NoHBand:     onOff(noHBand, tblLookNoHBand),
+		NoVBand:     onOff(noVBand, tblLookNoVBand),
+	}
+	look.Val = fmt.Sprintf("%04X", mask)
+	return look
+}
+
+// MarshalXML implements the xml.Marshaler interface for the TableLook type.
+func (t TableLook) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name.Local = "w:tblLook"
+	start.Attr = nil
+
+	for _, attr := range []struct {
+		name  string
+		value string
+	}{
+		{"w:val", t.Val},
+		{"w:firstRow", string(t.FirstRow)},
+		{"w:lastRow", string(t.LastRow)},
+		{"w:firstColumn", string(t.FirstColumn)},
+		{"w:lastColumn", string(t.LastColumn)},
+		{"w:noHBand", string(t.NoHBand)},
+		{"w:noVBand", string(t.NoVBand)},
+	} {
+		if attr.value != "" {
+			start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: attr.name}, Value: attr.value})
+		}
+	}
+
+	return e.EncodeElement("", start)
+}
@@ -0,0 +1,66 @@
+package ctypes
+
+import (
+	"encoding/xml"
+	"reflect"
+	"testing"
+
+	"github.com/MamaShip/godocx/wml/stypes"
+)
+
+func TestTableLook_MarshalXML(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    *TableLook
+		expected string
+	}{
+		{
+			name:     "Word default",
+			input:    NewTableLook(true, false, true, false, false, true),
+			expected: `<w:tblLook w:val="04A0" w:firstRow="1" w:lastRow="0" w:firstColumn="1" w:lastColumn="0" w:noHBand="0" w:noVBand="1"></w:tblLook>`,
+		},
+		{
+			name:     "Legacy value only",
+			input:    &TableLook{Val: "0000"},
+			expected: `<w:tblLook w:val="0000"></w:tblLook>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := xml.Marshal(tt.input)
+			if err != nil {
+				t.Fatalf("Error marshaling XML: %v", err)
+			}
+
+			if string(output) != tt.expected {
+				t.Errorf("Expected XML:\n%s\n\nGot:\n%s", tt.expected, output)
+			}
+		})
+	}
+}
+
+func TestTableLook_UnmarshalXML(t *testing.T) {
+	input := `<w:tblLook w:val="0620" w:firstRow="1" w:lastRow="0" w:firstColumn="0" w:lastColumn="0" w:noHBand="1" w:noVBand="1"/>`
+
+	var result TableLook
+	if err := xml.Unmarshal([]byte(input), &result); err != nil {
+		t.Fatalf("Error unmarshaling XML: %v", err)
+	}
+
+	expected := TableLook{
+		Val:         "0620",
+		FirstRow:    stypes.OnOffOne,
+		LastRow:     stypes.OnOffZero,
+		FirstColumn: stypes.OnOffZero,
+		LastColumn:  stypes.OnOffZero,
+		NoHBand:     stypes.OnOffOne,
+		NoVBand:     stypes.OnOffOne,
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %+v but got %+v", expected, result)
+	}
+	if !reflect.DeepEqual(&result, NewTableLook(true, false, false, false, true, true)) {
+		t.Errorf("NewTableLook does not match %+v", result)
+	}
+}
@@ -0,0 +1,81 @@
+package stypes
+
+import (
+	"encoding/xml"
+	"errors"
+)
+
+// HighlightColor represents the text highlighting colors (w:highlight).
+type HighlightColor string
+
+const (
+	HighlightBlack       HighlightColor = "black"
+	HighlightBlue        HighlightColor = "blue"
+	HighlightCyan        HighlightColor = "cyan"
+	HighlightGreen       HighlightColor = "green"
+	HighlightMagenta     HighlightColor = "magenta"
+	HighlightRed         HighlightColor = "red"
+	HighlightYellow      HighlightColor = "yellow"
+	HighlightWhite       HighlightColor = "white"
+	HighlightDarkBlue    HighlightColor = "darkBlue"
+	HighlightDarkCyan    HighlightColor = "darkCyan"
+	HighlightDarkGreen   HighlightColor = "darkGreen"
+	HighlightDarkMagenta HighlightColor = "darkMagenta"
+	HighlightDarkRed     HighlightColor = "darkRed"
+	HighlightDarkYellow  HighlightColor = "darkYellow"
+	HighlightDarkGray    HighlightColor = "darkGray"
+	HighlightLightGray   HighlightColor = "lightGray"
+	HighlightNone        HighlightColor = "none"
+)
+
+func HighlightColorFromStr(value string) (HighlightColor, error) {
+	switch value {
+	case "black":
+		return HighlightBlack, nil
+	case "blue":
+		return HighlightBlue, nil
+	case "cyan":
+		return HighlightCyan, nil
+	case "green":
+		return HighlightGreen, nil
+	case "magenta":
+		return HighlightMagenta, nil
+	case "red":
+		return HighlightRed, nil
+	case "yellow":
+		return HighlightYellow, nil
+	case "white":
+		return HighlightWhite, nil
+	case "darkBlue":
+		return HighlightDarkBlue, nil
+	case "darkCyan":
+		return HighlightDarkCyan, nil
+	case "darkGreen":
+		return HighlightDarkGreen, nil
+	case "darkMagenta":
+		return HighlightDarkMagenta, nil
+	case "darkRed":
+		return HighlightDarkRed, nil
+	case "darkYellow":
+		return HighlightDarkYellow, nil
+	case "darkGray":
+		return HighlightDarkGray, nil
+	case "lightGray":
+		return HighlightLightGray, nil
+	case "none":
+		return HighlightNone, nil
+	default:
+		return "", errors.New("invalid HighlightColor value")
+	}
+}
+
+func (h *HighlightColor) UnmarshalXMLAttr(attr xml.Attr) error {
+	val, err := HighlightColorFromStr(attr.Value)
+	if err != nil {
+		return err
+	}
+
+	*h = val
+
+	return nil
+}
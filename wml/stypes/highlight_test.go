@@ -0,0 +1,74 @@
+package stypes
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+func TestHighlightColorFromStr_ValidValues(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected HighlightColor
+	}{
+		{"black", HighlightBlack},
+		{"blue", HighlightBlue},
+		{"cyan", HighlightCyan},
+		{"green", HighlightGreen},
+		{"magenta", HighlightMagenta},
+		{"red", HighlightRed},
+		{"yellow", HighlightYellow},
+		{"white", HighlightWhite},
+		{"darkBlue", HighlightDarkBlue},
+		{"darkCyan", HighlightDarkCyan},
+		{"darkGreen", HighlightDarkGreen},
+		{"darkMagenta", HighlightDarkMagenta},
+		{"darkRed", HighlightDarkRed},
+		{"darkYellow", HighlightDarkYellow},
+		{"darkGray", HighlightDarkGray},
+		{"lightGray", HighlightLightGray},
+		{"none", HighlightNone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := HighlightColorFromStr(tt.input)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if result != tt.expected {
+				t.Errorf("Expected %s but got %s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestHighlightColorFromStr_InvalidValue(t *testing.T) {
+	input := "orange"
+
+	result, err := HighlightColorFromStr(input)
+
+	if err == nil {
+		t.Fatalf("Expected error for invalid value %s, but got none. Result: %s", input, result)
+	}
+
+	expectedError := "invalid HighlightColor value"
+	if err.Error() != expectedError {
+		t.Errorf("Expected error message '%s' but got '%s'", expectedError, err.Error())
+	}
+}
+
+func TestHighlightColor_UnmarshalXMLAttr(t *testing.T) {
+	var h HighlightColor
+
+	if err := h.UnmarshalXMLAttr(xml.Attr{Value: "darkYellow"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if h != HighlightDarkYellow {
+		t.Errorf("Expected %s but got %s", HighlightDarkYellow, h)
+	}
+
+	if err := h.UnmarshalXMLAttr(xml.Attr{Value: "orange"}); err == nil {
+		t.Error("Expected error for invalid value")
+	}
+}
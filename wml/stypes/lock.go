@@ -0,0 +1,42 @@
+package stypes
+
+import (
+	"encoding/xml"
+	"errors"
+)
+
+// Locking Setting of a structured document tag (content control)
+type Lock string
+
+const (
+	LockSdtLocked        Lock = "sdtLocked"        // The content control cannot be deleted
+	LockContentLocked    Lock = "contentLocked"    // The content of the content control cannot be edited
+	LockUnlocked         Lock = "unlocked"         // No locking
+	LockSdtContentLocked Lock = "sdtContentLocked" // The content control cannot be deleted and its content cannot be edited
+)
+
+// LockFromStr converts a string to Lock type.
+func LockFromStr(value string) (Lock, error) {
+	switch value {
+	case "sdtLocked":
+		return LockSdtLocked, nil
+	case "contentLocked":
+		return LockContentLocked, nil
+	case "unlocked":
+		return LockUnlocked, nil
+	case "sdtContentLocked":
+		return LockSdtContentLocked, nil
+	default:
+		return "", errors.New("Invalid Lock value")
+	}
+}
+
+// UnmarshalXMLAttr unmarshals XML attribute into Lock.
+func (l *Lock) UnmarshalXMLAttr(attr xml.Attr) error {
+	val, err := LockFromStr(attr.Value)
+	if err != nil {
+		return err
+	}
+	*l = val
+	return nil
+}
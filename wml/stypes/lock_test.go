@@ -0,0 +1,51 @@
+package stypes
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+func TestLockFromStr_ValidValues(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Lock
+	}{
+		{"sdtLocked", LockSdtLocked},
+		{"contentLocked", LockContentLocked},
+		{"unlocked", LockUnlocked},
+		{"sdtContentLocked", LockSdtContentLocked},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := LockFromStr(tt.input)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if result != tt.expected {
+				t.Errorf("Expected %s but got %s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestLockFromStr_InvalidValue(t *testing.T) {
+	if _, err := LockFromStr("locked"); err == nil {
+		t.Fatal("Expected error for invalid value, but got none")
+	}
+}
+
+func TestLock_UnmarshalXMLAttr(t *testing.T) {
+	var lock Lock
+	if err := lock.UnmarshalXMLAttr(xml.Attr{Name: xml.Name{Local: "val"}, Value: "contentLocked"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if lock != LockContentLocked {
+		t.Errorf("Expected %s but got %s", LockContentLocked, lock)
+	}
+
+	if err := lock.UnmarshalXMLAttr(xml.Attr{Name: xml.Name{Local: "val"}, Value: "locked"}); err == nil {
+		t.Error("Expected error for invalid value, but got none")
+	}
+}
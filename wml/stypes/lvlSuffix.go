@@ -0,0 +1,39 @@
+package stypes
+
+import (
+	"encoding/xml"
+	"errors"
+)
+
+// LevelSuffix is the content between the number of a list level and the paragraph text (w:suff).
+type LevelSuffix string
+
+const (
+	LevelSuffixTab     LevelSuffix = "tab"     // Tab to the next tab stop; the default
+	LevelSuffixSpace   LevelSuffix = "space"   // Single space
+	LevelSuffixNothing LevelSuffix = "nothing" // Text follows the number directly
+)
+
+func LevelSuffixFromStr(value string) (LevelSuffix, error) {
+	switch value {
+	case "tab":
+		return LevelSuffixTab, nil
+	case "space":
+		return LevelSuffixSpace, nil
+	case "nothing":
+		return LevelSuffixNothing, nil
+	default:
+		return "", errors.New("Invalid LevelSuffix Input")
+	}
+}
+
+func (s *LevelSuffix) UnmarshalXMLAttr(attr xml.Attr) error {
+	val, err := LevelSuffixFromStr(attr.Value)
+	if err != nil {
+		return err
+	}
+
+	*s = val
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package stypes
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+func TestLevelSuffixFromStr_ValidValues(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected LevelSuffix
+	}{
+		{"tab", LevelSuffixTab},
+		{"space", LevelSuffixSpace},
+		{"nothing", LevelSuffixNothing},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := LevelSuffixFromStr(tt.input)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if result != tt.expected {
+				t.Errorf("Expected %s but got %s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestLevelSuffixFromStr_InvalidValue(t *testing.T) {
+	input := "invalidValue"
+
+	result, err := LevelSuffixFromStr(input)
+
+	if err == nil {
+		t.Fatalf("Expected error for invalid value %s, but got none. Result: %s", input, result)
+	}
+
+	expectedError := "Invalid LevelSuffix Input"
+	if err.Error() != expectedError {
+		t.Errorf("Expected error message '%s' but got '%s'", expectedError, err.Error())
+	}
+}
+
+func TestLevelSuffix_UnmarshalXMLAttr_ValidValues(t *testing.T) {
+	tests := []struct {
+		inputXML string
+		expected LevelSuffix
+	}{
+		{`<element val="tab"></element>`, LevelSuffixTab},
+		{`<element val="space"></element>`, LevelSuffixSpace},
+		{`<element val="nothing"></element>`, LevelSuffixNothing},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.inputXML, func(t *testing.T) {
+			type Element struct {
+				XMLName xml.Name    `xml:"element"`
+				Suff    LevelSuffix `xml:"val,attr"`
+			}
+
+			var elem Element
+
+			err := xml.Unmarshal([]byte(tt.inputXML), &elem)
+			if err != nil {
+				t.Fatalf("Error unmarshaling XML: %v", err)
+			}
+
+			if elem.Suff != tt.expected {
+				t.Errorf("Expected %s but got %s", tt.expected, elem.Suff)
+			}
+		})
+	}
+}
+
+func TestLevelSuffix_UnmarshalXMLAttr_InvalidValue(t *testing.T) {
+	inputXML := `<element val="invalidValue"></element>`
+
+	type Element struct {
+		XMLName xml.Name    `xml:"element"`
+		Suff    LevelSuffix `xml:"val,attr"`
+	}
+
+	var elem Element
+
+	err := xml.Unmarshal([]byte(inputXML), &elem)
+
+	if err == nil {
+		t.Fatalf("Expected error for invalid value, but got none")
+	}
+
+	expectedError := "Invalid LevelSuffix Input"
+	if err.Error() != expectedError {
+		t.Errorf("Expected error message '%s' but got '%s'", expectedError, err.Error())
+	}
+}